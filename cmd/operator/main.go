@@ -36,8 +36,13 @@ import (
 	"github.com/prometheus/prometheus/promql/parser"
 	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	eventsv1 "k8s.io/api/events/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
@@ -57,6 +62,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
 	"github.com/prometheus-operator/prometheus-operator/pkg/kubelet"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 	prometheusagentcontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/agent"
 	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
 	"github.com/prometheus-operator/prometheus-operator/pkg/server"
@@ -105,6 +111,10 @@ const (
 	defaultReloaderMemory = "50Mi"
 
 	defaultMemlimitRatio = 0.0
+
+	defaultRenderKubernetesVersion = "1.36.0"
+
+	debugWorkloadsPath = "/debug/workloads"
 )
 
 var (
@@ -120,8 +130,12 @@ var (
 
 	serverConfig = server.DefaultConfig(":8080", false)
 
+	leaderElectionConfig = operator.DefaultLeaderElectionConfig()
+
 	disableUnmanagedPrometheusConfiguration bool
 
+	enableDebugEndpoints bool
+
 	// Parameters for the kubelet endpoints controller.
 	kubeletObject        string
 	kubeletSelector      operator.LabelSelector
@@ -131,6 +145,10 @@ var (
 	kubeletSyncPeriod    time.Duration
 	kubeletHTTPMetrics   bool
 
+	// Parameters for the render command.
+	renderKubernetesVersion string
+	renderNamespace         string
+
 	featureGates = k8sflag.NewMapStringBool(new(map[string]bool{}))
 )
 
@@ -138,6 +156,14 @@ func parseFlags(fs *flag.FlagSet) {
 	// Web server settings.
 	server.RegisterFlags(fs, &serverConfig)
 
+	// Leader election settings.
+	fs.BoolVar(&leaderElectionConfig.Enabled, "leader-elect", false, "Enable leader election to run multiple replicas of the operator. Only the leader reconciles the resources while the other replicas keep their caches warm to take over quickly.")
+	fs.StringVar(&leaderElectionConfig.LeaseName, "leader-election-lease-name", leaderElectionConfig.LeaseName, "Name of the Lease object used for leader election.")
+	fs.StringVar(&leaderElectionConfig.LeaseNamespace, "leader-election-lease-namespace", "", "Namespace of the Lease object used for leader election. Defaults to the namespace of the operator's pod.")
+	fs.DurationVar(&leaderElectionConfig.LeaseDuration, "leader-election-lease-duration", leaderElectionConfig.LeaseDuration, "Duration that non-leader replicas wait before attempting to acquire the leadership.")
+	fs.DurationVar(&leaderElectionConfig.RenewDeadline, "leader-election-renew-deadline", leaderElectionConfig.RenewDeadline, "Duration that the leader retries refreshing the leadership before giving up.")
+	fs.DurationVar(&leaderElectionConfig.RetryPeriod, "leader-election-retry-period", leaderElectionConfig.RetryPeriod, "Duration between leader election actions.")
+
 	// Kubernetes client-go settings.
 	fs.StringVar(&impersonateUser, "as", "", "Username to impersonate. User could be a regular user or a service account in a namespace.")
 	fs.StringVar(&apiServer, "apiserver", "", "API Server addr, e.g. ' - NOT RECOMMENDED FOR PRODUCTION - http://127.0.0.1:8080'. Omit parameter to run in on-cluster mode and utilize the service account token.")
@@ -194,7 +220,10 @@ func parseFlags(fs *flag.FlagSet) {
 	fs.Var(&cfg.ConfigMapListWatchLabelSelector, "configmap-label-selector", "Label selector to filter ConfigMaps to watch")
 
 	fs.Float64Var(&memlimitRatio, "auto-gomemlimit-ratio", defaultMemlimitRatio, "The ratio of reserved GOMEMLIMIT memory to the detected maximum container or system memory. The value should be greater than 0.0 and less than 1.0. Default: 0.0 (disabled).")
+	fs.BoolVar(&enableDebugEndpoints, "web.enable-debug-endpoints", false, "Expose the debug endpoints under "+debugWorkloadsPath+" which describe the configuration resources selected by the Prometheus, PrometheusAgent, Alertmanager and ThanosRuler objects and the generated configuration. The requests are authenticated and authorized with the Kubernetes TokenReview and SubjectAccessReview APIs.")
 	fs.BoolVar(&disableUnmanagedPrometheusConfiguration, "disable-unmanaged-prometheus-configuration", false, "Disable support for unmanaged Prometheus configuration when all resource selectors are nil. As stated in the API documentation, unmanaged Prometheus configuration is a deprecated feature which can be avoided with '.spec.additionalScrapeConfigs' or the ScrapeConfig CRD. Default: false.")
+	fs.StringVar(&renderKubernetesVersion, "render-kubernetes-version", defaultRenderKubernetesVersion, "Kubernetes version assumed by the render command.")
+	fs.StringVar(&renderNamespace, "namespace", "default", "Namespace assigned by the render command to the objects without namespace.")
 	cfg.RegisterFeatureGatesFlags(fs, featureGates)
 
 	logging.RegisterFlags(fs, &logConfig)
@@ -267,9 +296,11 @@ func run(fs *flag.FlagSet) int {
 		return crds()
 	case "full-crds":
 		return fullCrds()
+	case "render":
+		return renderManifests(os.Stdout, fs.Args()[1:])
 	default:
 		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", cmd)
-		fmt.Fprintln(os.Stderr, "Available commands: crds, full-crds, start")
+		fmt.Fprintln(os.Stderr, "Available commands: crds, full-crds, render, start")
 		return 1
 	}
 }
@@ -328,6 +359,17 @@ func start() int {
 		return 1
 	}
 
+	var le *operator.LeaderElector
+	if leaderElectionConfig.Enabled {
+		le, err = operator.NewLeaderElector(logger, kclient, leaderElectionConfig, r)
+		if err != nil {
+			logger.Error("failed to create leader elector", "err", err)
+			cancel()
+			return 1
+		}
+		cfg.LeaderElected = le.Elected()
+	}
+
 	kubernetesVersion, err := kclient.Discovery().ServerVersion()
 	if err != nil {
 		logger.Error("failed to request Kubernetes server version", "err", err)
@@ -350,6 +392,26 @@ func start() int {
 		promControllerOptions         = []prometheuscontroller.ControllerOption{}
 		thanosControllerOptions       = []thanoscontroller.ControllerOption{}
 	)
+	// The debug information is only computed when the debug endpoints are
+	// enabled.
+	var (
+		promDebugStore         *operator.DebugStore
+		promAgentDebugStore    *operator.DebugStore
+		alertmanagerDebugStore *operator.DebugStore
+		thanosDebugStore       *operator.DebugStore
+	)
+	if enableDebugEndpoints {
+		promDebugStore = &operator.DebugStore{}
+		promAgentDebugStore = &operator.DebugStore{}
+		alertmanagerDebugStore = &operator.DebugStore{}
+		thanosDebugStore = &operator.DebugStore{}
+
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithDebugStore(promDebugStore))
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithDebugStore(promAgentDebugStore))
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithDebugStore(alertmanagerDebugStore))
+		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithDebugStore(thanosDebugStore))
+	}
+
 	if disableUnmanagedPrometheusConfiguration {
 		logger.Info("Disabling support for unmanaged Prometheus configurations")
 		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithoutUnmanagedConfiguration())
@@ -379,6 +441,196 @@ func start() int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithStorageClassValidation())
 		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithStorageClassValidation())
 		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithStorageClassValidation())
+
+		// Check if we can expand the persistent volume claims in the
+		// namespaces of each workload.
+		for _, w := range []struct {
+			kind      string
+			allowList operator.StringSet
+			enable    func()
+		}{
+			{
+				kind:      monitoringv1.AlertmanagersKind,
+				allowList: cfg.Namespaces.AlertmanagerAllowList,
+				enable: func() {
+					alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithVolumeExpansion())
+				},
+			},
+			{
+				kind:      monitoringv1.PrometheusesKind,
+				allowList: cfg.Namespaces.PrometheusAllowList,
+				enable: func() {
+					promControllerOptions = append(promControllerOptions, prometheuscontroller.WithVolumeExpansion())
+				},
+			},
+			{
+				kind:      monitoringv1.ThanosRulerKind,
+				allowList: cfg.Namespaces.ThanosRulerAllowList,
+				enable: func() {
+					thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithVolumeExpansion())
+				},
+			},
+		} {
+			canExpandVolumes, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), w.allowList.Slice(),
+				k8s.ResourceAttribute{
+					Group:    v1.GroupName,
+					Version:  v1.SchemeGroupVersion.Version,
+					Resource: v1.SchemeGroupVersion.WithResource("persistentvolumeclaims").Resource,
+					Verbs:    []string{"get", "patch"},
+				})
+			if err != nil {
+				logger.Error("failed to check PersistentVolumeClaim permissions", "kind", w.kind, "err", err)
+				cancel()
+				return 1
+			}
+
+			if canExpandVolumes {
+				w.enable()
+				continue
+			}
+
+			for _, reason := range reasons {
+				logger.Warn("missing permission to expand persistent volume claims", "kind", w.kind, "reason", reason)
+			}
+		}
+	}
+
+	// Check if we can manage the PodDisruptionBudget objects in the
+	// namespaces of each workload.
+	for _, w := range []struct {
+		kind      string
+		allowList operator.StringSet
+		enable    func()
+	}{
+		{
+			kind:      monitoringv1.AlertmanagersKind,
+			allowList: cfg.Namespaces.AlertmanagerAllowList,
+			enable: func() {
+				alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithPodDisruptionBudget())
+			},
+		},
+		{
+			kind:      monitoringv1.PrometheusesKind,
+			allowList: cfg.Namespaces.PrometheusAllowList,
+			enable: func() {
+				promControllerOptions = append(promControllerOptions, prometheuscontroller.WithPodDisruptionBudget())
+			},
+		},
+		{
+			kind:      monitoringv1.ThanosRulerKind,
+			allowList: cfg.Namespaces.ThanosRulerAllowList,
+			enable: func() {
+				thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithPodDisruptionBudget())
+			},
+		},
+	} {
+		canManagePDBs, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), w.allowList.Slice(),
+			k8s.ResourceAttribute{
+				Group:    policyv1.GroupName,
+				Version:  policyv1.SchemeGroupVersion.Version,
+				Resource: policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets").Resource,
+				Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+			})
+		if err != nil {
+			logger.Error("failed to check PodDisruptionBudget permissions", "kind", w.kind, "err", err)
+			cancel()
+			return 1
+		}
+
+		if canManagePDBs {
+			w.enable()
+			continue
+		}
+
+		for _, reason := range reasons {
+			logger.Warn("missing permission to manage PodDisruptionBudget objects", "kind", w.kind, "reason", reason)
+		}
+	}
+
+	if cfg.Gates.Enabled(operator.PrometheusRuntimeHealthFeature) {
+		// Check if we can query the HTTP API of the Prometheus pods.
+		canProxyPods, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), cfg.Namespaces.PrometheusAllowList.Slice(),
+			k8s.ResourceAttribute{
+				Group:    v1.GroupName,
+				Version:  v1.SchemeGroupVersion.Version,
+				Resource: v1.SchemeGroupVersion.WithResource("pods/proxy").Resource,
+				Verbs:    []string{"get"},
+			})
+		if err != nil {
+			logger.Error("failed to check pods/proxy permissions", "err", err)
+			cancel()
+			return 1
+		}
+
+		if canProxyPods {
+			promControllerOptions = append(promControllerOptions, prometheuscontroller.WithRuntimeHealth())
+			promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithRuntimeHealth())
+		} else {
+			for _, reason := range reasons {
+				logger.Warn("missing permission to query the Prometheus pods, the runtime health won't be reported", "reason", reason)
+			}
+		}
+	}
+
+	// Check if we can manage the objects exposing the workloads. In addition
+	// to the Ingress and HTTPRoute objects, the operator needs to list the
+	// Services to delete the ones which aren't needed anymore.
+	listServices := k8s.ResourceAttribute{
+		Group:    v1.GroupName,
+		Version:  v1.SchemeGroupVersion.Version,
+		Resource: v1.SchemeGroupVersion.WithResource("services").Resource,
+		Verbs:    []string{"list", "watch"},
+	}
+	canManageIngresses, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), cfg.Namespaces.AllowList.Slice(),
+		k8s.ResourceAttribute{
+			Group:    networkingv1.GroupName,
+			Version:  networkingv1.SchemeGroupVersion.Version,
+			Resource: networkingv1.SchemeGroupVersion.WithResource("ingresses").Resource,
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+		listServices,
+	)
+	if err != nil {
+		logger.Error("failed to check Ingress permissions", "err", err)
+		cancel()
+		return 1
+	}
+
+	if canManageIngresses {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithIngressExposure())
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithIngressExposure())
+		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithIngressExposure())
+	} else {
+		for _, reason := range reasons {
+			logger.Warn("missing permission to manage Ingress objects", "reason", reason)
+		}
+	}
+
+	canManageHTTPRoutes, err := checkPrerequisites(
+		ctx,
+		logger,
+		kclient,
+		cfg.Namespaces.AllowList.Slice(),
+		operator.HTTPRouteGVR.GroupVersion(),
+		operator.HTTPRouteGVR.Resource,
+		k8s.ResourceAttribute{
+			Group:    operator.HTTPRouteGVR.Group,
+			Version:  operator.HTTPRouteGVR.Version,
+			Resource: operator.HTTPRouteGVR.Resource,
+			Verbs:    []string{"get", "list", "watch", "create", "update", "delete"},
+		},
+		listServices,
+	)
+	if err != nil {
+		logger.Error("failed to check HTTPRoute permissions", "err", err)
+		cancel()
+		return 1
+	}
+
+	if canManageHTTPRoutes {
+		alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithHTTPRouteExposure())
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithHTTPRouteExposure())
+		thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithHTTPRouteExposure())
 	}
 
 	canEmitEvents, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), nil,
@@ -425,6 +677,61 @@ func start() int {
 		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithScrapeConfig())
 	}
 
+	if cfg.Gates.Enabled(operator.RemoteWriteCustomResourceDefinitionFeature) {
+		remoteWriteSupported, err := checkPrerequisites(
+			ctx,
+			logger,
+			kclient,
+			cfg.Namespaces.AllowList.Slice(),
+			monitoringv1alpha1.SchemeGroupVersion,
+			monitoringv1alpha1.RemoteWriteName,
+			k8s.ResourceAttribute{
+				Group:    monitoring.GroupName,
+				Version:  monitoringv1alpha1.Version,
+				Resource: monitoringv1alpha1.RemoteWriteName,
+				Verbs:    []string{"get", "list", "watch"},
+			},
+		)
+		if err != nil {
+			logger.Error("failed to check RemoteWrite support", "err", err)
+			cancel()
+			return 1
+		}
+		if remoteWriteSupported {
+			promControllerOptions = append(promControllerOptions, prometheuscontroller.WithRemoteWrite())
+			promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithRemoteWrite())
+		}
+	}
+
+	httpRouteSupported := true
+	for _, gvr := range []schema.GroupVersionResource{prompkg.HTTPRouteGVR, prompkg.GatewayGVR} {
+		supported, err := checkPrerequisites(
+			ctx,
+			logger,
+			kclient,
+			cfg.Namespaces.AllowList.Slice(),
+			gvr.GroupVersion(),
+			gvr.Resource,
+			k8s.ResourceAttribute{
+				Group:    gvr.Group,
+				Version:  gvr.Version,
+				Resource: gvr.Resource,
+				Verbs:    []string{"get", "list", "watch"},
+			},
+		)
+		if err != nil {
+			logger.Error("failed to check HTTPRoute support", "err", err)
+			cancel()
+			return 1
+		}
+
+		httpRouteSupported = httpRouteSupported && supported
+	}
+	if httpRouteSupported {
+		promControllerOptions = append(promControllerOptions, prometheuscontroller.WithHTTPRoute())
+		promAgentControllerOptions = append(promAgentControllerOptions, prometheusagentcontroller.WithHTTPRoute())
+	}
+
 	// EndpointSlice v1 became available with Kubernetes v1.21.0.
 	endpointSliceSupported := cfg.KubernetesVersion.GTE(semver.MustParse("1.21.0"))
 	logger.Info("Kubernetes API capabilities", "endpointslices", endpointSliceSupported)
@@ -607,10 +914,80 @@ func start() int {
 	var ao *alertmanagercontroller.Operator
 	if alertmanagerSupported {
 		if cfg.Gates.Enabled(operator.StatusForConfigurationResourcesFeature) {
-			// TODO: check permissions when implementing the AlertmanagerConfig status subresource.
+			if !checkStatusSubresourcePermissions(
+				ctx,
+				logger,
+				kclient,
+				[]schema.GroupVersionResource{
+					monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName),
+				},
+			) {
+				cancel()
+				return 1
+			}
+
 			alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithConfigResourceStatus())
 		}
 
+		if cfg.Gates.Enabled(operator.SilenceCustomResourceDefinitionFeature) {
+			silenceSupported, err := checkPrerequisites(
+				ctx,
+				logger,
+				kclient,
+				cfg.Namespaces.AlertmanagerConfigAllowList.Slice(),
+				monitoringv1alpha1.SchemeGroupVersion,
+				monitoringv1alpha1.SilenceName,
+				k8s.ResourceAttribute{
+					Group:    monitoring.GroupName,
+					Version:  monitoringv1alpha1.Version,
+					Resource: monitoringv1alpha1.SilenceName,
+					Verbs:    []string{"get", "list", "watch", "patch"},
+				},
+				k8s.ResourceAttribute{
+					Group:    monitoring.GroupName,
+					Version:  monitoringv1alpha1.Version,
+					Resource: fmt.Sprintf("%s/status", monitoringv1alpha1.SilenceName),
+					Verbs:    []string{"patch"},
+				},
+			)
+			if err != nil {
+				logger.Error("failed to check Silence support", "err", err)
+				cancel()
+				return 1
+			}
+
+			if silenceSupported {
+				// Check if we can send requests to the HTTP API of the
+				// Alertmanager pods.
+				canProxyPods, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), cfg.Namespaces.AlertmanagerAllowList.Slice(),
+					k8s.ResourceAttribute{
+						Group:    v1.GroupName,
+						Version:  v1.SchemeGroupVersion.Version,
+						Resource: v1.SchemeGroupVersion.WithResource("pods").Resource,
+						Verbs:    []string{"list"},
+					},
+					k8s.ResourceAttribute{
+						Group:    v1.GroupName,
+						Version:  v1.SchemeGroupVersion.Version,
+						Resource: v1.SchemeGroupVersion.WithResource("pods/proxy").Resource,
+						Verbs:    []string{"get", "create", "delete"},
+					})
+				if err != nil {
+					logger.Error("failed to check pods/proxy permissions", "err", err)
+					cancel()
+					return 1
+				}
+
+				if canProxyPods {
+					alertmanagerControllerOptions = append(alertmanagerControllerOptions, alertmanagercontroller.WithSilence())
+				} else {
+					for _, reason := range reasons {
+						logger.Warn("missing permission to query the Alertmanager pods, the Silence objects won't be reconciled", "reason", reason)
+					}
+				}
+			}
+		}
+
 		ao, err = alertmanagercontroller.New(ctx, restConfig, cfg, logger, r, alertmanagerControllerOptions...)
 		if err != nil {
 			logger.Error("instantiating alertmanager controller failed", "err", err)
@@ -663,6 +1040,11 @@ func start() int {
 			thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithConfigResourceStatus())
 		}
 
+		if alertmanagerSupported {
+			// ThanosRuler objects can select Alertmanager objects.
+			thanosControllerOptions = append(thanosControllerOptions, thanoscontroller.WithAlertmanagerSelection())
+		}
+
 		to, err = thanoscontroller.New(ctx, restConfig, cfg, logger, r, thanosControllerOptions...)
 		if err != nil {
 			logger.Error("instantiating thanos controller failed", "err", err)
@@ -752,8 +1134,65 @@ func start() int {
 	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
 	mux.Handle("/debug/pprof/trace", http.HandlerFunc(pprof.Trace))
-	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+
+	if enableDebugEndpoints {
+		allowed, reasons, err := k8s.IsAllowed(ctx, kclient.AuthorizationV1().SelfSubjectAccessReviews(), nil,
+			k8s.ResourceAttribute{
+				Group:    authenticationv1.GroupName,
+				Version:  authenticationv1.SchemeGroupVersion.Version,
+				Resource: "tokenreviews",
+				Verbs:    []string{"create"},
+			},
+			k8s.ResourceAttribute{
+				Group:    authorizationv1.GroupName,
+				Version:  authorizationv1.SchemeGroupVersion.Version,
+				Resource: "subjectaccessreviews",
+				Verbs:    []string{"create"},
+			},
+		)
+		if err != nil {
+			logger.Error("failed to check permissions for the debug endpoints", "err", err)
+			cancel()
+			return 1
+		}
+
+		if !allowed {
+			for _, reason := range reasons {
+				logger.Error("missing permission to authenticate requests to the debug endpoints", "reason", reason)
+			}
+			cancel()
+			return 1
+		}
+
+		stores := map[string]*operator.DebugStore{}
+		if po != nil {
+			stores[monitoringv1.PrometheusName] = promDebugStore
+		}
+		if pao != nil {
+			stores[monitoringv1alpha1.PrometheusAgentName] = promAgentDebugStore
+		}
+		if ao != nil {
+			stores[monitoringv1.AlertmanagerName] = alertmanagerDebugStore
+		}
+		if to != nil {
+			stores[monitoringv1.ThanosRulerName] = thanosDebugStore
+		}
+
+		mux.Handle(debugWorkloadsPath+"/", server.WithKubernetesAuth(logger, kclient, operator.NewDebugHandler(debugWorkloadsPath, stores)))
+	}
+	mux.Handle("/healthz", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if le == nil {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		if err := le.Check(req); err != nil {
+			http.Error(w, fmt.Sprintf("leader election: %s", err), http.StatusInternalServerError)
+			return
+		}
+
 		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, "leader: %t\n", le.IsLeader())
 	}))
 
 	srv, err := server.NewServer(logger, &serverConfig, mux)
@@ -766,6 +1205,11 @@ func start() int {
 	// Start the web server.
 	wg.Go(func() error { return srv.Serve(ctx) })
 
+	// Start the leader election.
+	if le != nil {
+		wg.Go(func() error { return le.Run(ctx) })
+	}
+
 	// Start the controllers.
 	if po != nil {
 		wg.Go(func() error { return po.Run(ctx) })
@@ -780,7 +1224,12 @@ func start() int {
 		wg.Go(func() error { return to.Run(ctx) })
 	}
 	if kec != nil {
-		wg.Go(func() error { return kec.Run(ctx) })
+		wg.Go(func() error {
+			if !operator.WaitForLeadership(ctx, cfg.LeaderElected) {
+				return nil
+			}
+			return kec.Run(ctx)
+		})
 	}
 
 	term := make(chan os.Signal, 1)
@@ -809,11 +1258,13 @@ func main() {
 	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 	fs.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage of %s:\n\n", os.Args[0])
-		fmt.Fprintf(os.Stderr, "  %s [arguments] [<command>]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s [arguments] [<command>]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "  %s [arguments] render [<file>...]\n\n", os.Args[0])
 		fmt.Fprintln(os.Stderr, "Commands:")
 		fmt.Fprintln(os.Stderr, "  start      Run the operator (default)")
 		fmt.Fprintln(os.Stderr, "  crds       Print the CRDs in YAML format to standard output")
 		fmt.Fprintln(os.Stderr, "  full-crds  Print the full CRDs (with all fields) in YAML format to standard output")
+		fmt.Fprintln(os.Stderr, "  render     Print the configuration and statefulsets generated from the manifests read from files (or standard input) without connecting to a cluster")
 		fmt.Fprintln(os.Stderr, "")
 		fmt.Fprintln(os.Stderr, "Arguments:")
 		fs.PrintDefaults()
@@ -0,0 +1,226 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+
+	crd "github.com/prometheus-operator/prometheus-operator/example"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/render"
+)
+
+// renderManifests reads Kubernetes manifests from the given files (or from the
+// standard input if no file or "-" is given) and writes to w the configuration
+// files, rule files and statefulsets that the operator would generate for the
+// Prometheus, Alertmanager and ThanosRuler resources.
+func renderManifests(w io.Writer, files []string) int {
+	// The standard output is reserved for the rendered resources.
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelWarn}))
+	klog.SetSlogLogger(logger)
+
+	if err := cfg.Gates.UpdateFeatureGates(*featureGates.Map); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating feature gates: %v\n", err)
+		return 1
+	}
+
+	var err error
+	cfg.KubernetesVersion, err = semver.ParseTolerant(renderKubernetesVersion)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing Kubernetes version %q: %v\n", renderKubernetesVersion, err)
+		return 1
+	}
+	cfg.EventRecorderFactory = operator.NewEventRecorderFactory(false)
+
+	crds, err := crd.CRDs()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading CRDs: %v\n", err)
+		return 1
+	}
+
+	defaulter, err := render.NewDefaulter(crds)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading CRD schemas: %v\n", err)
+		return 1
+	}
+
+	objects, err := readObjects(files, defaulter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading manifests: %v\n", err)
+		return 1
+	}
+
+	if err := setDefaultNamespace(objects, renderNamespace); err != nil {
+		fmt.Fprintf(os.Stderr, "Error setting the default namespace: %v\n", err)
+		return 1
+	}
+
+	ctx := context.Background()
+	inMemory, err := render.NewInMemoryObjects(objects)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading objects: %v\n", err)
+		return 1
+	}
+
+	var (
+		proms []*monitoringv1.Prometheus
+		ams   []*monitoringv1.Alertmanager
+		trs   []*monitoringv1.ThanosRuler
+	)
+	for _, obj := range objects {
+		switch o := obj.(type) {
+		case *monitoringv1.Prometheus:
+			proms = append(proms, o)
+		case *monitoringv1.Alertmanager:
+			ams = append(ams, o)
+		case *monitoringv1.ThanosRuler:
+			trs = append(trs, o)
+		}
+	}
+
+	if len(proms)+len(ams)+len(trs) == 0 {
+		fmt.Fprintln(os.Stderr, "No Prometheus, Alertmanager or ThanosRuler object found")
+		return 1
+	}
+
+	var failed bool
+	for _, p := range sortedByKey(proms) {
+		rendered, err := render.Prometheus(ctx, logger, cfg, p, inMemory)
+		if !printRendered(w, monitoringv1.PrometheusesKind, p, rendered, err) {
+			failed = true
+		}
+	}
+
+	for _, am := range sortedByKey(ams) {
+		rendered, err := render.Alertmanager(ctx, logger, cfg, am, inMemory)
+		if !printRendered(w, monitoringv1.AlertmanagersKind, am, rendered, err) {
+			failed = true
+		}
+	}
+
+	for _, tr := range sortedByKey(trs) {
+		rendered, err := render.ThanosRuler(ctx, logger, cfg, tr, inMemory)
+		if !printRendered(w, monitoringv1.ThanosRulerKind, tr, rendered, err) {
+			failed = true
+		}
+	}
+
+	if failed {
+		return 1
+	}
+
+	return 0
+}
+
+func readObjects(files []string, defaulter *render.Defaulter) ([]runtime.Object, error) {
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	var objects []runtime.Object
+	for _, f := range files {
+		var (
+			r   io.ReadCloser = os.Stdin
+			err error
+		)
+		if f != "-" {
+			r, err = os.Open(f)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		objs, err := render.DecodeObjects(r, defaulter)
+		r.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+
+		objects = append(objects, objs...)
+	}
+
+	return objects, nil
+}
+
+// setDefaultNamespace sets the namespace of the namespaced objects which
+// don't define one, the same way as "kubectl apply" does with the namespace of
+// the current context.
+func setDefaultNamespace(objects []runtime.Object, ns string) error {
+	for _, obj := range objects {
+		// Namespaces are the only cluster-scoped objects used by the render
+		// command.
+		if _, ok := obj.(*v1.Namespace); ok {
+			continue
+		}
+
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+
+		if o.GetNamespace() == "" {
+			o.SetNamespace(ns)
+		}
+	}
+
+	return nil
+}
+
+func sortedByKey[T interface {
+	GetNamespace() string
+	GetName() string
+}](objs []T) []T {
+	slices.SortFunc(objs, func(a, b T) int {
+		if c := strings.Compare(a.GetNamespace(), b.GetNamespace()); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+
+	return objs
+}
+
+// printRendered writes the rendered resources as a stream of YAML documents.
+// It returns false if the resources couldn't be rendered.
+func printRendered(w io.Writer, kind string, obj interface {
+	GetNamespace() string
+	GetName() string
+}, rendered *render.RenderedResources, err error) bool {
+	source := fmt.Sprintf("%s %s/%s", kind, obj.GetNamespace(), obj.GetName())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error rendering %s: %v\n", source, err)
+		return false
+	}
+
+	if err := rendered.Write(w, source); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", source, err)
+		return false
+	}
+
+	return true
+}
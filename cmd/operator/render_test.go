@@ -0,0 +1,70 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+)
+
+func TestRender(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		file       string
+		goldenFile string
+	}{
+		{
+			name:       "RemoteWrite CRD enabled",
+			args:       []string{"--feature-gates=RemoteWriteCustomResourceDefinition=true", "--prometheus-config-reloader=quay.io/prometheus-operator/prometheus-config-reloader:latest"},
+			file:       "testdata/render.yaml",
+			goldenFile: "render_remote_write_enabled.golden",
+		},
+		{
+			name:       "RemoteWrite CRD disabled",
+			args:       []string{"--feature-gates=RemoteWriteCustomResourceDefinition=false", "--prometheus-config-reloader=quay.io/prometheus-operator/prometheus-config-reloader:latest"},
+			file:       "testdata/render.yaml",
+			goldenFile: "render_remote_write_disabled.golden",
+		},
+		{
+			name:       "objects without namespace",
+			args:       []string{"--prometheus-config-reloader=quay.io/prometheus-operator/prometheus-config-reloader:latest"},
+			file:       "testdata/render_without_namespace.yaml",
+			goldenFile: "render_without_namespace.golden",
+		},
+		{
+			name:       "objects without namespace and custom namespace",
+			args:       []string{"--namespace=monitoring", "--prometheus-config-reloader=quay.io/prometheus-operator/prometheus-config-reloader:latest"},
+			file:       "testdata/render_without_namespace.yaml",
+			goldenFile: "render_without_namespace_custom.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			args := os.Args
+			t.Cleanup(func() { os.Args = args })
+			os.Args = append([]string{"operator"}, tc.args...)
+			parseFlags(flag.NewFlagSet("operator", flag.ContinueOnError))
+
+			var b bytes.Buffer
+			require.Equal(t, 0, renderManifests(&b, []string{tc.file}))
+			golden.Assert(t, b.String(), tc.goldenFile)
+		})
+	}
+}
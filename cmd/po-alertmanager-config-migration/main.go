@@ -0,0 +1,116 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	fs := flag.CommandLine
+	versionutil.RegisterFlags(fs)
+
+	var configFile = flag.String("alertmanager-config", "", "path to the Alertmanager configuration file")
+	var destination = flag.String("destination", "", "directory where the generated manifests are written")
+	var namespace = flag.String("namespace", "default", "namespace of the generated objects")
+	var splitByLabel = flag.String("split-by-label", "", "generate one AlertmanagerConfig object per value of the equality matcher on this label instead of one object per top-level route")
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-alertmanager-config-migration")
+		os.Exit(0)
+	}
+
+	if *configFile == "" {
+		log.Print("please specify 'alertmanager-config' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if *destination == "" {
+		log.Print("please specify 'destination' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	destPath, err := filepath.Abs(*destination)
+	if err != nil {
+		log.Fatalf("failed to get absolute path of '%v': %v", *destination, err.Error())
+	}
+
+	data, err := os.ReadFile(*configFile)
+	if err != nil {
+		log.Fatalf("failed to read file '%v': %v", *configFile, err.Error())
+	}
+
+	res, err := alertmanager.MigrateConfiguration(data, alertmanager.MigrationOptions{
+		Namespace:    *namespace,
+		SplitByLabel: *splitByLabel,
+	})
+	if err != nil {
+		log.Fatalf("failed to convert the Alertmanager configuration: %v", err.Error())
+	}
+
+	for _, mc := range res.Configs {
+		var buf bytes.Buffer
+		if mc.Secret != nil {
+			b, err := yaml.Marshal(mc.Secret)
+			if err != nil {
+				log.Fatalf("failed to encode the secret '%v': %v", mc.Secret.Name, err.Error())
+			}
+			buf.Write(b)
+			buf.WriteString("---\n")
+		}
+
+		b, err := yaml.Marshal(mc.AlertmanagerConfig)
+		if err != nil {
+			log.Fatalf("failed to encode the alertmanagerconfig '%v': %v", mc.AlertmanagerConfig.Name, err.Error())
+		}
+		buf.Write(b)
+
+		err = os.WriteFile(filepath.Join(destPath, mc.AlertmanagerConfig.Name+".yaml"), buf.Bytes(), 0644)
+		if err != nil {
+			log.Fatalf("failed to write yaml manifest for alertmanagerconfig '%v': %v", mc.AlertmanagerConfig.Name, err.Error())
+		}
+	}
+
+	printReport(os.Stderr, res)
+}
+
+// printReport writes the list of items which couldn't be translated.
+func printReport(w io.Writer, res *alertmanager.MigrationResult) {
+	for _, warning := range res.Warnings {
+		fmt.Fprintf(w, "%s\n", warning)
+	}
+
+	for _, mc := range res.Configs {
+		for _, warning := range mc.Warnings {
+			fmt.Fprintf(w, "alertmanagerconfig %q: %s\n", mc.AlertmanagerConfig.Name, warning)
+		}
+	}
+}
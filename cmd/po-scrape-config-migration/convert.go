@@ -0,0 +1,765 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+var (
+	secretKeySelectorType = reflect.TypeFor[corev1.SecretKeySelector]()
+	secretOrConfigMapType = reflect.TypeFor[monitoringv1.SecretOrConfigMap]()
+	jsonUnmarshalerType   = reflect.TypeFor[json.Unmarshaler]()
+	dockerSwarmSDType     = reflect.TypeFor[monitoringv1alpha1.DockerSwarmSDConfig]()
+
+	invalidSecretKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]`)
+	invalidNameChars      = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+	// fieldAliases maps the Prometheus fields (normalized) to the
+	// ScrapeConfig fields (normalized) when their names differ.
+	fieldAliases = map[string]string{
+		"relabelconfigs":                "relabelings",
+		"metricrelabelconfigs":          "metricrelabelings",
+		"metricnamevalidationscheme":    "namevalidationscheme",
+		"metricnameescapingscheme":      "nameescapingscheme",
+		"alwaysscrapeclassichistograms": "scrapeclassichistograms",
+		"token":                         "tokenref",
+		"key":                           "keysecret",
+	}
+
+	// enumValues lists the accepted values of the ScrapeConfig enum types
+	// whose case differs from the Prometheus configuration.
+	enumValues = map[reflect.Type][]string{
+		reflect.TypeFor[monitoringv1alpha1.KubernetesRole](): {
+			string(monitoringv1alpha1.KubernetesRolePod),
+			string(monitoringv1alpha1.KubernetesRoleEndpoint),
+			string(monitoringv1alpha1.KubernetesRoleIngress),
+			string(monitoringv1alpha1.KubernetesRoleService),
+			string(monitoringv1alpha1.KubernetesRoleNode),
+			string(monitoringv1alpha1.KubernetesRoleEndpointSlice),
+		},
+		reflect.TypeFor[monitoringv1alpha1.OpenStackRole](): {
+			string(monitoringv1alpha1.OpenStackRoleInstance),
+			string(monitoringv1alpha1.OpenStackRoleHypervisor),
+			string(monitoringv1alpha1.OpenStackRoleLoadBalancer),
+		},
+		reflect.TypeFor[monitoringv1alpha1.OVHService](): {
+			string(monitoringv1alpha1.OVHServiceVPS),
+			string(monitoringv1alpha1.OVHServiceDedicatedServer),
+		},
+		reflect.TypeFor[monitoringv1alpha1.ScalewayRole](): {
+			string(monitoringv1alpha1.ScalewayRoleInstance),
+			string(monitoringv1alpha1.ScalewayRoleBaremetal),
+		},
+	}
+
+	// requiredFields lists the ScrapeConfig types which can't be generated
+	// without the given field (e.g. when the value is read from a file).
+	requiredFields = map[reflect.Type]string{
+		reflect.TypeFor[monitoringv1.SafeAuthorization](): "credentials",
+		reflect.TypeFor[monitoringv1.OAuth2]():            "clientSecret",
+	}
+
+	dockerSwarmRoles = []string{"Services", "Tasks", "Nodes"}
+
+	// monitorFields lists the ScrapeConfig fields which have an equivalent
+	// in the ServiceMonitor and PodMonitor resources.
+	monitorFields = []string{
+		"jobName",
+		"kubernetesSDConfigs",
+		"relabelings",
+		"metricRelabelings",
+		"metricsPath",
+		"scrapeInterval",
+		"scrapeTimeout",
+		"honorTimestamps",
+		"trackTimestampsStaleness",
+		"honorLabels",
+		"params",
+		"scheme",
+		"enableHTTP2",
+		"basicAuth",
+		"authorization",
+		"oauth2",
+		"tlsConfig",
+		"proxyUrl",
+		"noProxy",
+		"proxyFromEnvironment",
+		"proxyConnectHeader",
+		"sampleLimit",
+		"targetLimit",
+		"labelLimit",
+		"labelNameLengthLimit",
+		"labelValueLengthLimit",
+		"keepDroppedTargets",
+		"bodySizeLimit",
+		"scrapeProtocols",
+		"fallbackScrapeProtocol",
+		"scrapeNativeHistograms",
+		"scrapeClassicHistograms",
+		"nativeHistogramBucketLimit",
+		"nativeHistogramMinBucketFactor",
+		"convertClassicHistogramsToNHCB",
+	}
+
+	// monitorSDFields lists the Kubernetes service discovery fields which
+	// have an equivalent in the ServiceMonitor and PodMonitor resources.
+	monitorSDFields = []string{"role", "namespaces", "selectors", "attachMetadata"}
+)
+
+// Job holds the result of the conversion of a single scrape configuration.
+type Job struct {
+	// JobName is the value of the `job_name` field.
+	JobName string
+	// Name is the name of the generated objects.
+	Name string
+	// Objects contains the generated objects. It is empty when the scrape
+	// configuration can't be converted.
+	Objects []runtime.Object
+	// Issues lists what couldn't be translated.
+	Issues []string
+}
+
+// Result holds the result of the conversion of scrape configurations.
+type Result struct {
+	Jobs []Job
+	// Issues lists what couldn't be translated outside of the scrape
+	// configurations.
+	Issues []string
+}
+
+// Converter converts Prometheus scrape configurations to ScrapeConfig,
+// ServiceMonitor and PodMonitor objects.
+type Converter struct {
+	// Namespace is the namespace of the generated objects.
+	Namespace string
+	// PreferScrapeConfig disables the conversion to ServiceMonitor and
+	// PodMonitor objects.
+	PreferScrapeConfig bool
+
+	names map[string]struct{}
+}
+
+// Convert converts the scrape configurations from data. The input is either a
+// list of scrape configurations (e.g. the content of the additional scrape
+// configs Secret) or a Prometheus configuration file.
+func (c *Converter) Convert(data []byte) (*Result, error) {
+	b, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	var in any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&in); err != nil {
+		return nil, fmt.Errorf("failed to decode scrape configurations: %w", err)
+	}
+
+	res := &Result{}
+	switch v := in.(type) {
+	case nil, []any:
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			if k != "scrape_configs" {
+				res.Issues = append(res.Issues, fmt.Sprintf("%s: field ignored, only scrape_configs is converted", k))
+			}
+		}
+		in = v["scrape_configs"]
+	default:
+		return nil, fmt.Errorf("expected a list of scrape configurations or a Prometheus configuration, got %T", in)
+	}
+
+	configs, ok := in.([]any)
+	if in != nil && !ok {
+		return nil, fmt.Errorf("scrape_configs: expected a list, got %T", in)
+	}
+
+	c.names = map[string]struct{}{}
+	for i, sc := range configs {
+		res.Jobs = append(res.Jobs, c.convertJob(i, sc))
+	}
+
+	return res, nil
+}
+
+func (c *Converter) convertJob(i int, in any) Job {
+	job := Job{JobName: fmt.Sprintf("scrape_configs[%d]", i)}
+
+	raw, ok := in.(map[string]any)
+	if !ok {
+		job.Issues = append(job.Issues, fmt.Sprintf("expected an object, got %T: not converted", in))
+		return job
+	}
+
+	jobName, ok := raw["job_name"].(string)
+	if !ok || jobName == "" {
+		job.Issues = append(job.Issues, "job_name: missing or invalid job name: not converted")
+		return job
+	}
+	job.JobName = jobName
+	job.Name = c.objectName(jobName)
+
+	jc := &jobConverter{
+		secretName: job.Name + "-credentials",
+		secrets:    map[string]string{},
+	}
+	out := jc.convert(raw, reflect.TypeFor[monitoringv1alpha1.ScrapeConfigSpec](), "", nil)
+	job.Issues = jc.issues
+	if jc.skip {
+		job.Issues = append(job.Issues, "not converted")
+		return job
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		job.Issues = append(job.Issues, fmt.Sprintf("failed to encode the configuration: %v: not converted", err))
+		return job
+	}
+
+	var spec monitoringv1alpha1.ScrapeConfigSpec
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&spec); err != nil {
+		job.Issues = append(job.Issues, fmt.Sprintf("invalid configuration: %v: not converted", err))
+		return job
+	}
+
+	if len(jc.secrets) > 0 {
+		job.Objects = append(job.Objects, &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: corev1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      jc.secretName,
+				Namespace: c.Namespace,
+			},
+			Type:       corev1.SecretTypeOpaque,
+			StringData: jc.secrets,
+		})
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      job.Name,
+		Namespace: c.Namespace,
+	}
+
+	if !c.PreferScrapeConfig {
+		if obj, issues := c.monitor(objectMeta, jobName, &spec); obj != nil {
+			job.Objects = append(job.Objects, obj)
+			job.Issues = append(job.Issues, issues...)
+			return job
+		}
+	}
+
+	job.Objects = append(job.Objects, &monitoringv1alpha1.ScrapeConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       monitoringv1alpha1.ScrapeConfigsKind,
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: objectMeta,
+		Spec:       spec,
+	})
+
+	return job
+}
+
+// objectName returns a unique and valid object name for the job.
+func (c *Converter) objectName(jobName string) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(jobName), "-")
+	name = strings.Trim(name, "-.")
+	// Leave room for the suffixes.
+	if len(name) > 200 {
+		name = strings.TrimRight(name[:200], "-.")
+	}
+	if name == "" {
+		name = "scrape-config"
+	}
+
+	unique := name
+	for i := 2; ; i++ {
+		if _, found := c.names[unique]; !found {
+			break
+		}
+		unique = name + "-" + strconv.Itoa(i)
+	}
+	c.names[unique] = struct{}{}
+
+	return unique
+}
+
+// monitor returns a ServiceMonitor or a PodMonitor object equivalent to the
+// scrape configuration. It returns nil if there's no equivalent.
+func (c *Converter) monitor(objectMeta metav1.ObjectMeta, jobName string, spec *monitoringv1alpha1.ScrapeConfigSpec) (runtime.Object, []string) {
+	if len(spec.KubernetesSDConfigs) != 1 {
+		return nil, nil
+	}
+
+	if !onlyFields(spec, monitorFields) {
+		return nil, nil
+	}
+
+	sd := spec.KubernetesSDConfigs[0]
+	if !onlyFields(&sd, monitorSDFields) {
+		return nil, nil
+	}
+
+	var selectorRole monitoringv1alpha1.KubernetesRole
+	switch sd.Role {
+	case monitoringv1alpha1.KubernetesRoleEndpoint, monitoringv1alpha1.KubernetesRoleEndpointSlice:
+		selectorRole = monitoringv1alpha1.KubernetesRoleService
+	case monitoringv1alpha1.KubernetesRolePod:
+		selectorRole = monitoringv1alpha1.KubernetesRolePod
+	default:
+		return nil, nil
+	}
+
+	var selector metav1.LabelSelector
+	switch len(sd.Selectors) {
+	case 0:
+	case 1:
+		s := sd.Selectors[0]
+		if s.Role != selectorRole || s.Field != nil || s.Label == nil {
+			return nil, nil
+		}
+
+		ls, err := metav1.ParseToLabelSelector(*s.Label)
+		if err != nil {
+			return nil, nil
+		}
+		selector = *ls
+		if len(selector.MatchExpressions) == 0 {
+			selector.MatchExpressions = nil
+		}
+	default:
+		return nil, nil
+	}
+
+	var namespaceSelector monitoringv1.NamespaceSelector
+	switch {
+	case sd.Namespaces == nil || (len(sd.Namespaces.Names) == 0 && !ptr.Deref(sd.Namespaces.IncludeOwnNamespace, false)):
+		namespaceSelector.Any = true
+	case len(sd.Namespaces.Names) > 0:
+		namespaceSelector.MatchNames = sd.Namespaces.Names
+		if ptr.Deref(sd.Namespaces.IncludeOwnNamespace, false) && !slices.Contains(sd.Namespaces.Names, c.Namespace) {
+			namespaceSelector.MatchNames = append(namespaceSelector.MatchNames, c.Namespace)
+		}
+	}
+
+	var attachMetadata *monitoringv1.AttachMetadata
+	if sd.AttachMetadata != nil {
+		attachMetadata = &monitoringv1.AttachMetadata{Node: sd.AttachMetadata.Node}
+	}
+
+	// Preserve the value of the job label.
+	relabelings := append([]monitoringv1.RelabelConfig{{
+		TargetLabel: "job",
+		Replacement: ptr.To(jobName),
+	}}, spec.RelabelConfigs...)
+
+	httpConfig := monitoringv1.HTTPConfigWithoutTLS{
+		Authorization: spec.Authorization,
+		BasicAuth:     spec.BasicAuth,
+		OAuth2:        spec.OAuth2,
+		EnableHTTP2:   spec.EnableHTTP2,
+	}
+
+	if sd.Role == monitoringv1alpha1.KubernetesRolePod {
+		pm := &monitoringv1.PodMonitor{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       monitoringv1.PodMonitorsKind,
+				APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: objectMeta,
+			Spec: monitoringv1.PodMonitorSpec{
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{
+					Path:                     ptr.Deref(spec.MetricsPath, ""),
+					Scheme:                   spec.Scheme,
+					Params:                   spec.Params,
+					Interval:                 ptr.Deref(spec.ScrapeInterval, ""),
+					ScrapeTimeout:            ptr.Deref(spec.ScrapeTimeout, ""),
+					HonorLabels:              ptr.Deref(spec.HonorLabels, false),
+					HonorTimestamps:          spec.HonorTimestamps,
+					TrackTimestampsStaleness: spec.TrackTimestampsStaleness,
+					MetricRelabelConfigs:     spec.MetricRelabelConfigs,
+					RelabelConfigs:           relabelings,
+					// Prometheus doesn't filter out the terminated pods.
+					FilterRunning: ptr.To(false),
+					HTTPConfigWithProxy: monitoringv1.HTTPConfigWithProxy{
+						HTTPConfig: monitoringv1.HTTPConfig{
+							HTTPConfigWithoutTLS: httpConfig,
+							TLSConfig:            spec.TLSConfig,
+						},
+						ProxyConfig: spec.ProxyConfig,
+					},
+				}},
+				Selector:               selector,
+				NamespaceSelector:      namespaceSelector,
+				SampleLimit:            spec.SampleLimit,
+				TargetLimit:            spec.TargetLimit,
+				ScrapeProtocols:        spec.ScrapeProtocols,
+				FallbackScrapeProtocol: spec.FallbackScrapeProtocol,
+				LabelLimit:             spec.LabelLimit,
+				LabelNameLengthLimit:   spec.LabelNameLengthLimit,
+				LabelValueLengthLimit:  spec.LabelValueLengthLimit,
+				NativeHistogramConfig:  spec.NativeHistogramConfig,
+				KeepDroppedTargets:     spec.KeepDroppedTargets,
+				AttachMetadata:         attachMetadata,
+				BodySizeLimit:          spec.BodySizeLimit,
+			},
+		}
+
+		return pm, []string{
+			"converted to a PodMonitor: the operator adds the namespace, pod, container and endpoint labels to the targets",
+		}
+	}
+
+	var tlsConfig *monitoringv1.TLSConfig
+	if spec.TLSConfig != nil {
+		tlsConfig = &monitoringv1.TLSConfig{SafeTLSConfig: *spec.TLSConfig}
+	}
+
+	var serviceDiscoveryRole *monitoringv1.ServiceDiscoveryRole
+	if sd.Role == monitoringv1alpha1.KubernetesRoleEndpointSlice {
+		serviceDiscoveryRole = ptr.To(monitoringv1.EndpointSliceRole)
+	}
+
+	sm := &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       monitoringv1.ServiceMonitorsKind,
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: objectMeta,
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Endpoints: []monitoringv1.Endpoint{{
+				Path:                     ptr.Deref(spec.MetricsPath, ""),
+				Scheme:                   spec.Scheme,
+				Params:                   spec.Params,
+				Interval:                 ptr.Deref(spec.ScrapeInterval, ""),
+				ScrapeTimeout:            ptr.Deref(spec.ScrapeTimeout, ""),
+				HonorLabels:              ptr.Deref(spec.HonorLabels, false),
+				HonorTimestamps:          spec.HonorTimestamps,
+				TrackTimestampsStaleness: spec.TrackTimestampsStaleness,
+				MetricRelabelConfigs:     spec.MetricRelabelConfigs,
+				RelabelConfigs:           relabelings,
+				HTTPConfigWithProxyAndTLSFiles: monitoringv1.HTTPConfigWithProxyAndTLSFiles{
+					HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
+						HTTPConfigWithoutTLS: httpConfig,
+						TLSConfig:            tlsConfig,
+					},
+					ProxyConfig: spec.ProxyConfig,
+				},
+			}},
+			Selector:               selector,
+			NamespaceSelector:      namespaceSelector,
+			SampleLimit:            spec.SampleLimit,
+			ScrapeProtocols:        spec.ScrapeProtocols,
+			FallbackScrapeProtocol: spec.FallbackScrapeProtocol,
+			TargetLimit:            spec.TargetLimit,
+			LabelLimit:             spec.LabelLimit,
+			LabelNameLengthLimit:   spec.LabelNameLengthLimit,
+			LabelValueLengthLimit:  spec.LabelValueLengthLimit,
+			NativeHistogramConfig:  spec.NativeHistogramConfig,
+			KeepDroppedTargets:     spec.KeepDroppedTargets,
+			AttachMetadata:         attachMetadata,
+			BodySizeLimit:          spec.BodySizeLimit,
+			ServiceDiscoveryRole:   serviceDiscoveryRole,
+		},
+	}
+
+	return sm, []string{
+		"converted to a ServiceMonitor: the operator adds the namespace, service, pod, container and endpoint labels to the targets",
+	}
+}
+
+// onlyFields returns true if v has no other fields set than the given ones.
+func onlyFields(v any, fields []string) bool {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return false
+	}
+
+	for k := range m {
+		if !slices.Contains(fields, k) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// jobConverter translates the fields of a scrape configuration to the
+// ScrapeConfig API.
+type jobConverter struct {
+	secretName string
+	secrets    map[string]string
+	issues     []string
+	// skip is true when the scrape configuration can't be converted.
+	skip bool
+}
+
+func (jc *jobConverter) reportf(path string, format string, args ...any) {
+	jc.issues = append(jc.issues, path+": "+fmt.Sprintf(format, args...))
+}
+
+// convert returns the value of in translated for the t type. src is the
+// path of the value in the Prometheus configuration and dst the path in the
+// ScrapeConfig object. It returns nil if the value can't be translated.
+func (jc *jobConverter) convert(in any, t reflect.Type, src string, dst []string) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case secretKeySelectorType:
+		return jc.secretKeySelector(in, src, dst)
+	case secretOrConfigMapType:
+		if sel := jc.secretKeySelector(in, src, dst); sel != nil {
+			return map[string]any{"secret": sel}
+		}
+		return nil
+	}
+
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) {
+		return in
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := in.(map[string]any)
+		if !ok {
+			return in
+		}
+
+		secrets := maps.Clone(jc.secrets)
+		out := jc.convertStruct(m, t, src, dst)
+		if f, found := requiredFields[t]; found {
+			if _, found := out[f]; !found {
+				jc.reportf(src, "section ignored because the %s field can't be converted", f)
+				// Discard the secret values of the ignored section.
+				jc.secrets = secrets
+				return nil
+			}
+		}
+		return out
+
+	case reflect.Slice:
+		l, ok := in.([]any)
+		if !ok {
+			return in
+		}
+
+		out := make([]any, 0, len(l))
+		for i, v := range l {
+			if v = jc.convert(v, t.Elem(), fmt.Sprintf("%s[%d]", src, i), append(slices.Clone(dst), strconv.Itoa(i))); v != nil {
+				out = append(out, v)
+			}
+		}
+		return out
+
+	case reflect.Map:
+		m, ok := in.(map[string]any)
+		if !ok {
+			return in
+		}
+
+		out := make(map[string]any, len(m))
+		for _, k := range slices.Sorted(maps.Keys(m)) {
+			if v := jc.convert(m[k], t.Elem(), src+"."+k, append(slices.Clone(dst), k)); v != nil {
+				out[k] = v
+			}
+		}
+		return out
+
+	case reflect.String:
+		if s, ok := in.(string); ok {
+			return enumValue(t, s)
+		}
+	}
+
+	return in
+}
+
+func (jc *jobConverter) convertStruct(m map[string]any, t reflect.Type, src string, dst []string) map[string]any {
+	fields := structFields(t)
+
+	// Prometheus supports bearer tokens outside of the authorization
+	// section.
+	if token, found := m["bearer_token"]; found {
+		if _, hasAuthz := fields["authorization"]; hasAuthz {
+			m = maps.Clone(m)
+			delete(m, "bearer_token")
+			if _, found := m["authorization"]; found {
+				jc.reportf(join(src, "bearer_token"), "both bearer_token and authorization are defined, bearer_token is ignored")
+			} else {
+				m["authorization"] = map[string]any{
+					"type":        "Bearer",
+					"credentials": token,
+				}
+			}
+		}
+	}
+
+	out := make(map[string]any, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		path := join(src, k)
+
+		f, found := fields[normalize(k)]
+		if !found {
+			f, found = fields[fieldAliases[normalize(k)]]
+		}
+
+		if !found {
+			switch {
+			case strings.HasSuffix(k, "_sd_configs"):
+				jc.reportf(path, "service discovery mechanism not supported by the ScrapeConfig resource")
+				jc.skip = true
+			case strings.HasSuffix(k, "_file"):
+				jc.reportf(path, "references a file from the Prometheus filesystem, store the content in a Secret and use the equivalent field instead")
+			case strings.HasSuffix(k, "_ref"):
+				jc.reportf(path, "references the Prometheus secret manager, store the content in a Secret and use the equivalent field instead")
+			default:
+				jc.reportf(path, "field not supported by the ScrapeConfig resource")
+			}
+			continue
+		}
+
+		v := jc.convert(m[k], f.typ, path, append(slices.Clone(dst), f.name))
+		if s, ok := v.(string); ok && t == dockerSwarmSDType && f.name == "role" {
+			v = matchEnum(dockerSwarmRoles, s)
+		}
+
+		if v != nil {
+			out[f.name] = v
+		}
+	}
+
+	return out
+}
+
+// secretKeySelector stores the value in the generated Secret and returns a
+// reference to it.
+func (jc *jobConverter) secretKeySelector(in any, src string, dst []string) any {
+	var value string
+	switch v := in.(type) {
+	case string:
+		value = v
+	case json.Number, bool:
+		value = fmt.Sprint(v)
+	default:
+		jc.reportf(src, "expected a string, got %T", in)
+		return nil
+	}
+
+	key := invalidSecretKeyChars.ReplaceAllString(strings.Join(dst, "-"), "_")
+	jc.secrets[key] = value
+
+	return map[string]any{
+		"name": jc.secretName,
+		"key":  key,
+	}
+}
+
+type field struct {
+	name string
+	typ  reflect.Type
+}
+
+// structFields returns the JSON fields of t indexed by their normalized name.
+func structFields(t reflect.Type) map[string]field {
+	fields := map[string]field{}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if name == "" && (f.Anonymous || strings.Contains(opts, "inline")) {
+			maps.Copy(fields, structFields(f.Type))
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		fields[normalize(name)] = field{name: name, typ: f.Type}
+	}
+
+	return fields
+}
+
+// normalize returns a name which can be compared between the snake case
+// (Prometheus) and the camel case (ScrapeConfig) conventions.
+func normalize(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+func enumValue(t reflect.Type, s string) string {
+	values, found := enumValues[t]
+	if !found {
+		return s
+	}
+
+	return matchEnum(values, s)
+}
+
+// matchEnum returns the enum value matching s (e.g. "endpointslice" for
+// "EndpointSlice"). It returns s if there's no match.
+func matchEnum(values []string, s string) string {
+	for _, v := range values {
+		if normalize(v) == normalize(s) {
+			return v
+		}
+	}
+
+	return s
+}
+
+func join(path, k string) string {
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
@@ -0,0 +1,391 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestConvert(t *testing.T) {
+	for _, tc := range []struct {
+		name               string
+		input              string
+		preferScrapeConfig bool
+
+		jobs   []Job
+		issues []string
+	}{
+		{
+			name: "static config with credentials",
+			input: `
+- job_name: node
+  scrape_interval: 30s
+  bearer_token: s3cr3t
+  static_configs:
+  - targets: ["node:9100"]
+    labels:
+      env: prod
+  relabel_configs:
+  - source_labels: [__address__]
+    target_label: instance
+  tls_config:
+    ca_file: /etc/ca.pem
+    server_name: node
+`,
+			jobs: []Job{
+				{
+					JobName: "node",
+					Name:    "node",
+					Objects: []runtime.Object{
+						credentialsSecret("node", map[string]string{"authorization-credentials": "s3cr3t"}),
+						scrapeConfig("node", monitoringv1alpha1.ScrapeConfigSpec{
+							JobName:        ptr.To("node"),
+							ScrapeInterval: ptr.To(monitoringv1.Duration("30s")),
+							StaticConfigs: []monitoringv1alpha1.StaticConfig{{
+								Targets: []monitoringv1alpha1.Target{"node:9100"},
+								Labels:  map[string]string{"env": "prod"},
+							}},
+							RelabelConfigs: []monitoringv1.RelabelConfig{{
+								SourceLabels: []monitoringv1.LabelName{"__address__"},
+								TargetLabel:  "instance",
+							}},
+							Authorization: &monitoringv1.SafeAuthorization{
+								Type:        "Bearer",
+								Credentials: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "node-credentials"}, Key: "authorization-credentials"},
+							},
+							TLSConfig: &monitoringv1.SafeTLSConfig{
+								ServerName: ptr.To("node"),
+							},
+						}),
+					},
+					Issues: []string{
+						"tls_config.ca_file: references a file from the Prometheus filesystem, store the content in a Secret and use the equivalent field instead",
+					},
+				},
+			},
+		},
+		{
+			name: "service discovery",
+			input: `
+scrape_configs:
+- job_name: consul
+  consul_sd_configs:
+  - server: consul:8500
+    token: tok
+  openstack_sd_configs:
+  - role: loadbalancer
+    region: eu
+    password: pass
+  http_headers:
+    X-Foo:
+      values: [bar]
+`,
+			jobs: []Job{
+				{
+					JobName: "consul",
+					Name:    "consul",
+					Objects: []runtime.Object{
+						credentialsSecret("consul", map[string]string{
+							"consulSDConfigs-0-tokenRef":    "tok",
+							"openstackSDConfigs-0-password": "pass",
+						}),
+						scrapeConfig("consul", monitoringv1alpha1.ScrapeConfigSpec{
+							JobName: ptr.To("consul"),
+							ConsulSDConfigs: []monitoringv1alpha1.ConsulSDConfig{{
+								Server:   "consul:8500",
+								TokenRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "consul-credentials"}, Key: "consulSDConfigs-0-tokenRef"},
+							}},
+							OpenStackSDConfigs: []monitoringv1alpha1.OpenStackSDConfig{{
+								Role:     monitoringv1alpha1.OpenStackRoleLoadBalancer,
+								Region:   "eu",
+								Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "consul-credentials"}, Key: "openstackSDConfigs-0-password"},
+							}},
+						}),
+					},
+					Issues: []string{
+						"http_headers: field not supported by the ScrapeConfig resource",
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported service discovery",
+			input: `
+global:
+  scrape_interval: 1m
+scrape_configs:
+- job_name: marathon
+  marathon_sd_configs:
+  - servers: ["http://marathon:8080"]
+- static_configs:
+  - targets: ["localhost:9090"]
+`,
+			issues: []string{"global: field ignored, only scrape_configs is converted"},
+			jobs: []Job{
+				{
+					JobName: "marathon",
+					Name:    "marathon",
+					Issues: []string{
+						"marathon_sd_configs: service discovery mechanism not supported by the ScrapeConfig resource",
+						"not converted",
+					},
+				},
+				{
+					JobName: "scrape_configs[1]",
+					Issues: []string{
+						"job_name: missing or invalid job name: not converted",
+					},
+				},
+			},
+		},
+		{
+			name: "credentials from files",
+			input: `
+- job_name: node
+  static_configs:
+  - targets: ["node:9100"]
+  authorization:
+    credentials_file: /etc/token
+  oauth2:
+    client_id: id
+    client_secret_file: /etc/secret
+    token_url: https://auth.example.com/token
+`,
+			jobs: []Job{
+				{
+					JobName: "node",
+					Name:    "node",
+					Objects: []runtime.Object{
+						scrapeConfig("node", monitoringv1alpha1.ScrapeConfigSpec{
+							JobName: ptr.To("node"),
+							StaticConfigs: []monitoringv1alpha1.StaticConfig{{
+								Targets: []monitoringv1alpha1.Target{"node:9100"},
+							}},
+						}),
+					},
+					Issues: []string{
+						"authorization.credentials_file: references a file from the Prometheus filesystem, store the content in a Secret and use the equivalent field instead",
+						"authorization: section ignored because the credentials field can't be converted",
+						"oauth2.client_secret_file: references a file from the Prometheus filesystem, store the content in a Secret and use the equivalent field instead",
+						"oauth2: section ignored because the clientSecret field can't be converted",
+					},
+				},
+			},
+		},
+		{
+			name: "pod monitor",
+			input: `
+- job_name: My_Pods
+  metrics_path: /metrics/pods
+  kubernetes_sd_configs:
+  - role: pod
+    namespaces:
+      names: [a, b]
+    selectors:
+    - role: pod
+      label: app=foo
+  basic_auth:
+    username: user
+    password: pass
+`,
+			jobs: []Job{
+				{
+					JobName: "My_Pods",
+					Name:    "my-pods",
+					Objects: []runtime.Object{
+						credentialsSecret("my-pods", map[string]string{
+							"basicAuth-username": "user",
+							"basicAuth-password": "pass",
+						}),
+						&monitoringv1.PodMonitor{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       monitoringv1.PodMonitorsKind,
+								APIVersion: monitoringv1.SchemeGroupVersion.String(),
+							},
+							ObjectMeta: metav1.ObjectMeta{Name: "my-pods", Namespace: "monitoring"},
+							Spec: monitoringv1.PodMonitorSpec{
+								PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{
+									Path: "/metrics/pods",
+									RelabelConfigs: []monitoringv1.RelabelConfig{{
+										TargetLabel: "job",
+										Replacement: ptr.To("My_Pods"),
+									}},
+									FilterRunning: ptr.To(false),
+									HTTPConfigWithProxy: monitoringv1.HTTPConfigWithProxy{
+										HTTPConfig: monitoringv1.HTTPConfig{
+											HTTPConfigWithoutTLS: monitoringv1.HTTPConfigWithoutTLS{
+												BasicAuth: &monitoringv1.BasicAuth{
+													Username: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-pods-credentials"}, Key: "basicAuth-username"},
+													Password: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-pods-credentials"}, Key: "basicAuth-password"},
+												},
+											},
+										},
+									},
+								}},
+								Selector: metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "foo"},
+								},
+								NamespaceSelector: monitoringv1.NamespaceSelector{
+									MatchNames: []string{"a", "b"},
+								},
+							},
+						},
+					},
+					Issues: []string{
+						"converted to a PodMonitor: the operator adds the namespace, pod, container and endpoint labels to the targets",
+					},
+				},
+			},
+		},
+		{
+			name: "service monitor",
+			input: `
+- job_name: services
+  scrape_interval: 10s
+  kubernetes_sd_configs:
+  - role: endpointslice
+`,
+			jobs: []Job{
+				{
+					JobName: "services",
+					Name:    "services",
+					Objects: []runtime.Object{
+						&monitoringv1.ServiceMonitor{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       monitoringv1.ServiceMonitorsKind,
+								APIVersion: monitoringv1.SchemeGroupVersion.String(),
+							},
+							ObjectMeta: metav1.ObjectMeta{Name: "services", Namespace: "monitoring"},
+							Spec: monitoringv1.ServiceMonitorSpec{
+								Endpoints: []monitoringv1.Endpoint{{
+									Interval: "10s",
+									RelabelConfigs: []monitoringv1.RelabelConfig{{
+										TargetLabel: "job",
+										Replacement: ptr.To("services"),
+									}},
+								}},
+								NamespaceSelector:    monitoringv1.NamespaceSelector{Any: true},
+								ServiceDiscoveryRole: ptr.To(monitoringv1.EndpointSliceRole),
+							},
+						},
+					},
+					Issues: []string{
+						"converted to a ServiceMonitor: the operator adds the namespace, service, pod, container and endpoint labels to the targets",
+					},
+				},
+			},
+		},
+		{
+			name: "kubernetes service discovery without equivalent monitor",
+			input: `
+- job_name: services
+  kubernetes_sd_configs:
+  - role: endpoints
+    api_server: https://example.com
+- job_name: services
+  kubernetes_sd_configs:
+  - role: endpoints
+`,
+			preferScrapeConfig: true,
+			jobs: []Job{
+				{
+					JobName: "services",
+					Name:    "services",
+					Objects: []runtime.Object{
+						scrapeConfig("services", monitoringv1alpha1.ScrapeConfigSpec{
+							JobName: ptr.To("services"),
+							KubernetesSDConfigs: []monitoringv1alpha1.KubernetesSDConfig{{
+								Role:      monitoringv1alpha1.KubernetesRoleEndpoint,
+								APIServer: ptr.To("https://example.com"),
+							}},
+						}),
+					},
+				},
+				{
+					JobName: "services",
+					Name:    "services-2",
+					Objects: []runtime.Object{
+						scrapeConfig("services-2", monitoringv1alpha1.ScrapeConfigSpec{
+							JobName: ptr.To("services"),
+							KubernetesSDConfigs: []monitoringv1alpha1.KubernetesSDConfig{{
+								Role: monitoringv1alpha1.KubernetesRoleEndpoint,
+							}},
+						}),
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Converter{
+				Namespace:          "monitoring",
+				PreferScrapeConfig: tc.preferScrapeConfig,
+			}
+
+			res, err := c.Convert([]byte(tc.input))
+			require.NoError(t, err)
+			require.Equal(t, tc.issues, res.Issues)
+			require.Equal(t, tc.jobs, res.Jobs)
+		})
+	}
+}
+
+func TestConvertInvalidInput(t *testing.T) {
+	for _, input := range []string{
+		"foo",
+		"scrape_configs: foo",
+		"- job_name: [",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := (&Converter{}).Convert([]byte(input))
+			require.Error(t, err)
+		})
+	}
+}
+
+func credentialsSecret(name string, data map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-credentials",
+			Namespace: "monitoring",
+		},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: data,
+	}
+}
+
+func scrapeConfig(name string, spec monitoringv1alpha1.ScrapeConfigSpec) *monitoringv1alpha1.ScrapeConfig {
+	return &monitoringv1alpha1.ScrapeConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       monitoringv1alpha1.ScrapeConfigsKind,
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring"},
+		Spec:       spec,
+	}
+}
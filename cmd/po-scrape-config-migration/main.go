@@ -0,0 +1,117 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/versionutil"
+)
+
+func main() {
+	fs := flag.CommandLine
+	versionutil.RegisterFlags(fs)
+
+	var scrapeConfigsFile = flag.String("scrape-configs", "", "path to the scrape configurations (a list of scrape configurations or a Prometheus configuration file)")
+	var destination = flag.String("destination", "", "directory where the generated manifests are written")
+	var namespace = flag.String("namespace", "default", "namespace of the generated objects")
+	var preferScrapeConfig = flag.Bool("prefer-scrape-config", false, "generate ScrapeConfig objects only, even when a ServiceMonitor or PodMonitor object is equivalent")
+
+	// No need to check for errors because Parse would exit on error.
+	_ = fs.Parse(os.Args[1:])
+
+	if versionutil.ShouldPrintVersion() {
+		versionutil.Print(os.Stdout, "po-scrape-config-migration")
+		os.Exit(0)
+	}
+
+	if *scrapeConfigsFile == "" {
+		log.Print("please specify 'scrape-configs' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	if *destination == "" {
+		log.Print("please specify 'destination' flag")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	destPath, err := filepath.Abs(*destination)
+	if err != nil {
+		log.Fatalf("failed to get absolute path of '%v': %v", *destination, err.Error())
+	}
+
+	data, err := os.ReadFile(*scrapeConfigsFile)
+	if err != nil {
+		log.Fatalf("failed to read file '%v': %v", *scrapeConfigsFile, err.Error())
+	}
+
+	c := &Converter{
+		Namespace:          *namespace,
+		PreferScrapeConfig: *preferScrapeConfig,
+	}
+	res, err := c.Convert(data)
+	if err != nil {
+		log.Fatalf("failed to convert scrape configurations: %v", err.Error())
+	}
+
+	for _, job := range res.Jobs {
+		if len(job.Objects) == 0 {
+			continue
+		}
+
+		var buf bytes.Buffer
+		for i, obj := range job.Objects {
+			b, err := yaml.Marshal(obj)
+			if err != nil {
+				log.Fatalf("failed to encode the manifests of job '%v': %v", job.JobName, err.Error())
+			}
+
+			if i > 0 {
+				buf.WriteString("---\n")
+			}
+			buf.Write(b)
+		}
+
+		err = os.WriteFile(filepath.Join(destPath, job.Name+".yaml"), buf.Bytes(), 0644)
+		if err != nil {
+			log.Fatalf("failed to write yaml manifest for job '%v': %v", job.JobName, err.Error())
+		}
+	}
+
+	printReport(os.Stderr, res)
+}
+
+// printReport writes the list of items which couldn't be translated.
+func printReport(w io.Writer, res *Result) {
+	for _, issue := range res.Issues {
+		fmt.Fprintf(w, "%s\n", issue)
+	}
+
+	for _, job := range res.Jobs {
+		for _, issue := range job.Issues {
+			fmt.Fprintf(w, "job %q: %s\n", job.JobName, issue)
+		}
+	}
+}
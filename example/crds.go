@@ -22,6 +22,9 @@ import (
 	"io/fs"
 	"slices"
 	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/yaml"
 )
 
 //go:embed prometheus-operator-crd/*.yaml
@@ -35,6 +38,35 @@ const (
 	fullCRDsDir = "prometheus-operator-crd-full"
 )
 
+// CRDs returns the standard CRDs.
+func CRDs() ([]apiextensionsv1.CustomResourceDefinition, error) {
+	files, err := embeddedCRDs.ReadDir(crdsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded CRDs directory: %w", err)
+	}
+
+	var crds []apiextensionsv1.CustomResourceDefinition
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".yaml") {
+			continue
+		}
+
+		content, err := embeddedCRDs.ReadFile(crdsDir + "/" + file.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CRD %s: %w", file.Name(), err)
+		}
+
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(content, &crd); err != nil {
+			return nil, fmt.Errorf("failed to decode CRD %s: %w", file.Name(), err)
+		}
+
+		crds = append(crds, crd)
+	}
+
+	return crds, nil
+}
+
 // PrintAll prints all standard CRDs to the given writer.
 func PrintAll(w io.Writer) error {
 	return printCRDs(w, embeddedCRDs, crdsDir)
@@ -81,6 +81,11 @@ function(params) {
           name: 'tls-certificates',
           readOnly: true,
         },
+        // The rule tests store the input series in a temporary directory.
+        {
+          mountPath: '/tmp',
+          name: 'tmp',
+        },
       ],
     };
     {
@@ -118,6 +123,9 @@ function(params) {
                   path: 'tls.key',
                 }],
               },
+            }, {
+              name: 'tmp',
+              emptyDir: {},
             }],
           },
         },
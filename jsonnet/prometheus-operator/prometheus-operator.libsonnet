@@ -51,6 +51,8 @@ function(params) {
   '0prometheusruleCustomResourceDefinition': import 'prometheusrules-crd.json',
   '0thanosrulerCustomResourceDefinition': import 'thanosrulers-crd.json',
   '0scrapeconfigCustomResourceDefinition': import 'scrapeconfigs-crd.json',
+  '0remotewriteCustomResourceDefinition': import 'remotewrites-crd.json',
+  '0silenceCustomResourceDefinition': import 'silences-crd.json',
 
   clusterRoleBinding: {
     apiVersion: 'rbac.authorization.k8s.io/v1',
@@ -86,6 +88,7 @@ function(params) {
                  'alertmanagers/finalizers',
                  'alertmanagers/status',
                  'alertmanagerconfigs',
+                 'alertmanagerconfigs/status',
                  'prometheuses',
                  'prometheuses/finalizers',
                  'prometheuses/status',
@@ -97,6 +100,11 @@ function(params) {
                  'thanosrulers/status',
                  'scrapeconfigs',
                  'scrapeconfigs/status',
+                 'remotewrites',
+                 'remotewrites/status',
+                 'silences',
+                 'silences/finalizers',
+                 'silences/status',
                  'servicemonitors',
                  'servicemonitors/status',
                  'podmonitors',
@@ -129,7 +137,7 @@ function(params) {
                  'services',
                  'services/finalizers',
                ],
-               verbs: ['get', 'create', 'update', 'delete'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'delete'],
              },
              {
                apiGroups: [''],
@@ -149,13 +157,48 @@ function(params) {
              {
                apiGroups: ['networking.k8s.io'],
                resources: ['ingresses'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'delete'],
+             },
+             {
+               apiGroups: ['gateway.networking.k8s.io'],
+               resources: ['httproutes', 'gateways'],
                verbs: ['get', 'list', 'watch'],
              },
+             {
+               apiGroups: ['gateway.networking.k8s.io'],
+               resources: ['httproutes'],
+               verbs: ['create', 'update', 'delete'],
+             },
              {
                apiGroups: ['storage.k8s.io'],
                resources: ['storageclasses'],
                verbs: ['get'],
              },
+             {
+               apiGroups: [''],
+               resources: ['persistentvolumeclaims'],
+               verbs: ['get', 'patch'],
+             },
+             {
+               apiGroups: ['policy'],
+               resources: ['poddisruptionbudgets'],
+               verbs: ['get', 'list', 'watch', 'create', 'update', 'delete'],
+             },
+             {
+               apiGroups: ['coordination.k8s.io'],
+               resources: ['leases'],
+               verbs: ['get', 'create', 'update'],
+             },
+             {
+               apiGroups: ['authentication.k8s.io'],
+               resources: ['tokenreviews'],
+               verbs: ['create'],
+             },
+             {
+               apiGroups: ['authorization.k8s.io'],
+               resources: ['subjectaccessreviews'],
+               verbs: ['create'],
+             },
            ] + (
              if po.config.kubeletEndpointsEnabled then
                [
@@ -15,6 +15,7 @@
 package admission
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -23,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/rulefmt"
 	"github.com/prometheus/prometheus/promql/parser"
 	v1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,6 +39,7 @@ import (
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
 	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
 )
 
 const (
@@ -56,6 +59,10 @@ const (
 	prometheusRuleValidatePath     = "/admission-prometheusrules/validate"
 	prometheusRuleMutatePath       = "/admission-prometheusrules/mutate"
 	alertmanagerConfigValidatePath = "/admission-alertmanagerconfigs/validate"
+	serviceMonitorValidatePath     = "/admission-servicemonitors/validate"
+	podMonitorValidatePath         = "/admission-podmonitors/validate"
+	probeValidatePath              = "/admission-probes/validate"
+	scrapeConfigValidatePath       = "/admission-scrapeconfigs/validate"
 	convertPath                    = "/convert"
 )
 
@@ -70,35 +77,86 @@ var (
 		Group:    group,
 		Resource: alertManagerConfigResource,
 	}
+	serviceMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ServiceMonitorName,
+	}
+	podMonitorGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.PodMonitorName,
+	}
+	probeGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1.Version,
+		Resource: monitoringv1.ProbeName,
+	}
+	scrapeConfigGVR = metav1.GroupVersionResource{
+		Group:    group,
+		Version:  monitoringv1alpha1.Version,
+		Resource: monitoringv1alpha1.ScrapeConfigName,
+	}
 )
 
 // Admission control for:
 // 1. PrometheusRules (validation, mutation) - ensuring created resources can be loaded by Prometheus
 // 2. monitoringv1alpha1.AlertmanagerConfig (validation) - ensuring.
+// 3. ServiceMonitors, PodMonitors, Probes and ScrapeConfigs (validation) -
+// ensuring that the resources pass the checks done by the operator which
+// don't depend on the selecting Prometheus objects.
 type Admission struct {
 	logger           *slog.Logger
 	wh               http.Handler
+	rv               *prometheus.ResourceValidator
 	validationScheme model.ValidationScheme
 	parserOptions    parser.Options
+	ruleTests        RuleTestsFunc
+}
+
+// RuleTestsFunc evaluates the unit tests defined in the annotations of a
+// PrometheusRule object against its rule groups. It returns an error if the
+// tests aren't valid, and the failed tests otherwise.
+type RuleTestsFunc func(ctx context.Context, annotations map[string]string, groups *rulefmt.RuleGroups, validationScheme model.ValidationScheme, parserOptions parser.Options) ([]error, error)
+
+// Option configures the Admission.
+type Option func(*Admission)
+
+// WithRuleTests enables the evaluation of the PrometheusRule unit tests with
+// the given function. Without it, the rule tests annotation is ignored.
+func WithRuleTests(f RuleTestsFunc) Option {
+	return func(a *Admission) {
+		a.ruleTests = f
+	}
 }
 
-func New(logger *slog.Logger, validationScheme model.ValidationScheme, parserOptions parser.Options) *Admission {
+func New(logger *slog.Logger, validationScheme model.ValidationScheme, parserOptions parser.Options, opts ...Option) *Admission {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(monitoringv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(monitoringv1beta1.AddToScheme(scheme))
 
-	return &Admission{
+	a := &Admission{
 		logger:           logger,
 		wh:               conversion.NewWebhookHandler(scheme, conversion.NewRegistry()),
+		rv:               prometheus.NewResourceValidator(logger),
 		validationScheme: validationScheme,
 		parserOptions:    parserOptions,
 	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
 }
 
 func (a *Admission) Register(mux *http.ServeMux) {
 	mux.HandleFunc(prometheusRuleValidatePath, a.servePrometheusRulesValidate)
 	mux.HandleFunc(prometheusRuleMutatePath, a.servePrometheusRulesMutate)
 	mux.HandleFunc(alertmanagerConfigValidatePath, a.serveAlertmanagerConfigValidate)
+	mux.HandleFunc(serviceMonitorValidatePath, a.serveServiceMonitorValidate)
+	mux.HandleFunc(podMonitorValidatePath, a.servePodMonitorValidate)
+	mux.HandleFunc(probeValidatePath, a.serveProbeValidate)
+	mux.HandleFunc(scrapeConfigValidatePath, a.serveScrapeConfigValidate)
 	mux.HandleFunc(convertPath, a.serveConvert)
 }
 
@@ -109,13 +167,39 @@ func (a *Admission) servePrometheusRulesMutate(w http.ResponseWriter, r *http.Re
 }
 
 func (a *Admission) servePrometheusRulesValidate(w http.ResponseWriter, r *http.Request) {
-	a.serveAdmission(w, r, a.validatePrometheusRules)
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return a.validatePrometheusRules(r.Context(), ar)
+	})
 }
 
 func (a *Admission) serveAlertmanagerConfigValidate(w http.ResponseWriter, r *http.Request) {
 	a.serveAdmission(w, r, a.validateAlertmanagerConfig)
 }
 
+func (a *Admission) serveServiceMonitorValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return validateScrapeResource(r.Context(), a, ar, serviceMonitorGVR, &monitoringv1.ServiceMonitor{}, a.rv.ValidateServiceMonitor)
+	})
+}
+
+func (a *Admission) servePodMonitorValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return validateScrapeResource(r.Context(), a, ar, podMonitorGVR, &monitoringv1.PodMonitor{}, a.rv.ValidatePodMonitor)
+	})
+}
+
+func (a *Admission) serveProbeValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return validateScrapeResource(r.Context(), a, ar, probeGVR, &monitoringv1.Probe{}, a.rv.ValidateProbe)
+	})
+}
+
+func (a *Admission) serveScrapeConfigValidate(w http.ResponseWriter, r *http.Request) {
+	a.serveAdmission(w, r, func(ar v1.AdmissionReview) *v1.AdmissionResponse {
+		return validateScrapeResource(r.Context(), a, ar, scrapeConfigGVR, &monitoringv1alpha1.ScrapeConfig{}, a.rv.ValidateScrapeConfig)
+	})
+}
+
 func (a *Admission) serveConvert(w http.ResponseWriter, r *http.Request) {
 	a.wh.ServeHTTP(w, r)
 }
@@ -228,7 +312,7 @@ func (a *Admission) mutatePrometheusRules(ar v1.AdmissionReview) *v1.AdmissionRe
 	return reviewResponse
 }
 
-func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.AdmissionResponse {
+func (a *Admission) validatePrometheusRules(ctx context.Context, ar v1.AdmissionReview) *v1.AdmissionResponse {
 	a.logger.Debug("Validating prometheusrules")
 
 	if ar.Request.Resource != prometheusRuleGVR {
@@ -243,7 +327,7 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 		return toAdmissionResponseFailure(errUnmarshalRules, prometheusRuleResource, []error{err})
 	}
 
-	errors := promoperator.ValidateRule(promRule.Spec, a.validationScheme, a.parserOptions)
+	groups, errors := promoperator.ParseRule(promRule.Spec, a.validationScheme, a.parserOptions)
 	if len(errors) != 0 {
 		const m = "Invalid rule"
 		a.logger.Debug(m, "content", promRule.Spec)
@@ -254,6 +338,25 @@ func (a *Admission) validatePrometheusRules(ar v1.AdmissionReview) *v1.Admission
 		return toAdmissionResponseFailure("Rules are not valid", prometheusRuleResource, errors)
 	}
 
+	if a.ruleTests == nil {
+		return &v1.AdmissionResponse{Allowed: true}
+	}
+
+	errors, err := a.ruleTests(ctx, promRule.Annotations, groups, a.validationScheme, a.parserOptions)
+	if err != nil {
+		a.logger.Info("Invalid rule tests", "err", err)
+		return toAdmissionResponseFailure("Rule tests are not valid", prometheusRuleResource, []error{err})
+	}
+
+	if len(errors) != 0 {
+		const m = "Failed rule test"
+		for _, err := range errors {
+			a.logger.Info(m, "err", err)
+		}
+
+		return toAdmissionResponseFailure("Rule tests failed", prometheusRuleResource, errors)
+	}
+
 	return &v1.AdmissionResponse{Allowed: true}
 }
 
@@ -301,3 +404,46 @@ func (a *Admission) validateAlertmanagerConfig(ar v1.AdmissionReview) *v1.Admiss
 	}
 	return &v1.AdmissionResponse{Allowed: true}
 }
+
+// validateScrapeResource validates a ServiceMonitor, PodMonitor, Probe or
+// ScrapeConfig object. The checks which can only be done by the operator are
+// returned as admission warnings.
+func validateScrapeResource[T metav1.Object](
+	ctx context.Context,
+	a *Admission,
+	ar v1.AdmissionReview,
+	gvr metav1.GroupVersionResource,
+	obj T,
+	validate func(context.Context, T) ([]string, error),
+) *v1.AdmissionResponse {
+	a.logger.Debug("Validating " + gvr.Resource)
+
+	if ar.Request.Resource != gvr {
+		err := fmt.Errorf("expected resource to be %v, but received %v", gvr, ar.Request.Resource)
+		a.logger.Warn("", "err", err)
+		return toAdmissionResponseFailure("Unexpected resource kind", gvr.Resource, []error{err})
+	}
+
+	if err := json.Unmarshal(ar.Request.Object.Raw, obj); err != nil {
+		a.logger.Info(errUnmarshalAdmission, "err", err)
+		return toAdmissionResponseFailure(errUnmarshalAdmission, gvr.Resource, []error{err})
+	}
+
+	// The namespace may be omitted from the object on creation.
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(ar.Request.Namespace)
+	}
+
+	warnings, err := validate(ctx, obj)
+	if err != nil {
+		msg := "invalid " + gvr.Resource
+		a.logger.Debug(msg, "content", string(ar.Request.Object.Raw))
+		a.logger.Info(msg, "err", err)
+
+		resp := toAdmissionResponseFailure(fmt.Sprintf("%s %s/%s is invalid", gvr.Resource, obj.GetNamespace(), obj.GetName()), gvr.Resource, []error{err})
+		resp.Warnings = warnings
+		return resp
+	}
+
+	return &v1.AdmissionResponse{Allowed: true, Warnings: warnings}
+}
@@ -32,7 +32,9 @@ import (
 	"gotest.tools/v3/golden"
 	v1 "k8s.io/api/admission/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
 )
@@ -233,6 +235,90 @@ func TestAlertmanagerConfigAdmission(t *testing.T) {
 	}
 }
 
+func TestScrapeResourceAdmission(t *testing.T) {
+	a := api()
+
+	for _, tc := range []struct {
+		name     string
+		handler  http.HandlerFunc
+		gvr      metav1.GroupVersionResource
+		kind     string
+		spec     string
+		allowed  bool
+		warnings []string
+	}{
+		{
+			name:    "valid ServiceMonitor",
+			handler: a.serveServiceMonitorValidate,
+			gvr:     serviceMonitorGVR,
+			kind:    monitoringv1.ServiceMonitorsKind,
+			spec:    `{"endpoints": [{"port": "web"}]}`,
+			allowed: true,
+		},
+		{
+			name:    "ServiceMonitor with invalid relabel config",
+			handler: a.serveServiceMonitorValidate,
+			gvr:     serviceMonitorGVR,
+			kind:    monitoringv1.ServiceMonitorsKind,
+			spec:    `{"endpoints": [{"port": "web", "relabelings": [{"action": "replace", "regex": "("}]}]}`,
+			allowed: false,
+		},
+		{
+			name:    "ServiceMonitor with scrape class and secret reference",
+			handler: a.serveServiceMonitorValidate,
+			gvr:     serviceMonitorGVR,
+			kind:    monitoringv1.ServiceMonitorsKind,
+			spec:    `{"scrapeClass": "default", "endpoints": [{"port": "web", "authorization": {"credentials": {"name": "token", "key": "token"}}}]}`,
+			allowed: true,
+			warnings: []string{
+				`scrapeClassName: the scrape class "default" must be defined by the selecting Prometheus objects`,
+				`secret monitoring/token (key "token") must exist`,
+			},
+		},
+		{
+			name:    "PodMonitor with invalid scrape timeout",
+			handler: a.servePodMonitorValidate,
+			gvr:     podMonitorGVR,
+			kind:    monitoringv1.PodMonitorsKind,
+			spec:    `{"podMetricsEndpoints": [{"port": "web", "interval": "10s", "scrapeTimeout": "20s"}]}`,
+			allowed: false,
+		},
+		{
+			name:    "Probe with invalid prober URL",
+			handler: a.serveProbeValidate,
+			gvr:     probeGVR,
+			kind:    monitoringv1.ProbesKind,
+			spec:    `{"prober": {"url": "http://blackbox-exporter:9115"}, "targets": {"staticConfig": {"static": ["example.com"]}}}`,
+			allowed: false,
+		},
+		{
+			name:    "valid ScrapeConfig",
+			handler: a.serveScrapeConfigValidate,
+			gvr:     scrapeConfigGVR,
+			kind:    v1alpha1.ScrapeConfigsKind,
+			spec:    `{"staticConfigs": [{"targets": ["example.com:9100"]}]}`,
+			allowed: true,
+		},
+		{
+			name:    "unexpected resource",
+			handler: a.serveScrapeConfigValidate,
+			gvr:     probeGVR,
+			kind:    monitoringv1.ProbesKind,
+			spec:    `{}`,
+			allowed: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := server(tc.handler)
+			t.Cleanup(ts.Close)
+
+			resp := sendAdmissionReview(t, ts, buildAdmissionReview(t, tc.gvr, tc.kind, tc.spec))
+			require.Equal(t, tc.allowed, resp.Response.Allowed, "result: %v", resp.Response.Result)
+			require.Equal(t, tc.warnings, resp.Response.Warnings)
+		})
+	}
+}
+
 func TestAlertmanagerConfigConversion(t *testing.T) {
 	ts := server(api().serveConvert)
 	t.Cleanup(ts.Close)
@@ -417,6 +503,46 @@ func buildAdmissionReviewFromAlertmanagerConfigSpec(t *testing.T, version, spec
 	return []byte(tmpl)
 }
 
+func buildAdmissionReview(t *testing.T, gvr metav1.GroupVersionResource, kind, spec string) []byte {
+	t.Helper()
+	return fmt.Appendf(nil, `
+{
+  "kind": "AdmissionReview",
+  "apiVersion": "admission.k8s.io/v1",
+  "request": {
+    "uid": "87c5df7f-5090-11e9-b9b4-02425473f309",
+    "kind": {
+      "group": "%[1]s",
+      "version": "%[2]s",
+      "kind": "%[4]s"
+    },
+    "resource": {
+      "group": "%[1]s",
+      "version": "%[2]s",
+      "resource": "%[3]s"
+    },
+    "namespace": "monitoring",
+    "operation": "CREATE",
+    "object": {
+      "apiVersion": "%[1]s/%[2]s",
+      "kind": "%[4]s",
+      "metadata": {
+        "name": "test"
+      },
+      "spec": %[5]s
+    },
+    "oldObject": null,
+    "dryRun": false
+  }
+}
+`,
+		gvr.Group,
+		gvr.Version,
+		gvr.Resource,
+		kind,
+		spec)
+}
+
 func buildConversionReviewFromAlertmanagerConfigSpec(t *testing.T, from, to, spec string) []byte {
 	t.Helper()
 	tmpl := fmt.Sprintf(`
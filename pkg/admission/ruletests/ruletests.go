@@ -0,0 +1,583 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ruletests evaluates the unit tests of PrometheusRule objects like
+// "promtool test rules" does.
+//
+// It is a separate package because the Prometheus rule manager depends on
+// the service discovery SDKs: only the admission webhook's binary links it.
+package ruletests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/model/rulefmt"
+	"github.com/prometheus/prometheus/promql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/prometheus/prometheus/promql/promqltest"
+	"github.com/prometheus/prometheus/rules"
+	"gopkg.in/yaml.v2"
+)
+
+// Annotation is the annotation of PrometheusRule objects holding the rule
+// unit tests evaluated by the admission webhook.
+const Annotation = "operator.prometheus.io/rule-tests"
+
+// The rule tests are evaluated synchronously while the API server waits for
+// the admission response. The following limits bound the resources that a
+// single PrometheusRule object can consume.
+const (
+	// ruleTestsTimeout is the maximum duration of the evaluation.
+	ruleTestsTimeout = 5 * time.Second
+	// maxRuleTestSeries is the maximum number of input series.
+	maxRuleTestSeries = 1000
+	// maxRuleTestSamples is the maximum number of input samples.
+	maxRuleTestSamples = 100_000
+	// maxRuleTestSteps is the maximum number of evaluation steps.
+	maxRuleTestSteps = 20_000
+	// maxRuleTestEvalTime is the maximum evaluation time of a test case.
+	maxRuleTestEvalTime = model.Duration(7 * 24 * time.Hour)
+)
+
+// repeatedValuesRe matches the expanding notation of the input series values
+// (e.g. "1+2x10" or "_x3").
+var repeatedValuesRe = regexp.MustCompile(`x(\d+)$`)
+
+// ruleTests is the content of the rule tests annotation. It follows the
+// format of promtool's unit test files except that the rules under test are
+// always the PrometheusRule's groups.
+// See https://prometheus.io/docs/prometheus/latest/configuration/unit_testing_rules/
+type ruleTests struct {
+	EvaluationInterval model.Duration  `yaml:"evaluation_interval,omitempty"`
+	GroupEvalOrder     []string        `yaml:"group_eval_order,omitempty"`
+	FuzzyCompare       bool            `yaml:"fuzzy_compare,omitempty"`
+	Tests              []ruleTestGroup `yaml:"tests"`
+}
+
+// ruleTestGroup is a group of input series and test cases associated with it.
+type ruleTestGroup struct {
+	Name            string            `yaml:"name,omitempty"`
+	Interval        model.Duration    `yaml:"interval"`
+	InputSeries     []inputSeries     `yaml:"input_series"`
+	AlertRuleTests  []alertTestCase   `yaml:"alert_rule_test,omitempty"`
+	PromqlExprTests []promqlTestCase  `yaml:"promql_expr_test,omitempty"`
+	ExternalLabels  map[string]string `yaml:"external_labels,omitempty"`
+	ExternalURL     string            `yaml:"external_url,omitempty"`
+}
+
+type inputSeries struct {
+	Series string `yaml:"series"`
+	Values string `yaml:"values"`
+}
+
+type alertTestCase struct {
+	EvalTime  model.Duration `yaml:"eval_time"`
+	Alertname string         `yaml:"alertname"`
+	ExpAlerts []expAlert     `yaml:"exp_alerts"`
+}
+
+type expAlert struct {
+	ExpLabels      map[string]string `yaml:"exp_labels"`
+	ExpAnnotations map[string]string `yaml:"exp_annotations"`
+}
+
+type promqlTestCase struct {
+	Expr       string         `yaml:"expr"`
+	EvalTime   model.Duration `yaml:"eval_time"`
+	ExpSamples []expSample    `yaml:"exp_samples"`
+}
+
+type expSample struct {
+	Labels string  `yaml:"labels"`
+	Value  float64 `yaml:"value"`
+	// A non-empty string means that Value is ignored.
+	Histogram string `yaml:"histogram"`
+}
+
+// parseRuleTests returns the rule tests from the PrometheusRule's
+// annotations. It returns nil if the annotation isn't present.
+func parseRuleTests(annotations map[string]string) (*ruleTests, error) {
+	content, found := annotations[Annotation]
+	if !found {
+		return nil, nil
+	}
+
+	rt := &ruleTests{}
+	if err := yaml.UnmarshalStrict([]byte(content), rt); err != nil {
+		return nil, fmt.Errorf("failed to parse the %q annotation: %w", Annotation, err)
+	}
+
+	if rt.EvaluationInterval == 0 {
+		rt.EvaluationInterval = model.Duration(time.Minute)
+	}
+
+	if err := rt.checkLimits(); err != nil {
+		return nil, fmt.Errorf("invalid %q annotation: %w", Annotation, err)
+	}
+
+	return rt, nil
+}
+
+// Evaluate runs the rule unit tests defined in the annotations of a
+// PrometheusRule object against its rule groups. It returns an error if the
+// tests aren't valid, and the failed expectations otherwise. Nothing is
+// evaluated if the annotation isn't present.
+//
+// The input series are stored in a temporary directory under os.TempDir()
+// while the tests run.
+func Evaluate(ctx context.Context, annotations map[string]string, groups *rulefmt.RuleGroups, validationScheme model.ValidationScheme, parserOptions parser.Options) ([]error, error) {
+	rt, err := parseRuleTests(annotations)
+	if err != nil || rt == nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ruleTestsTimeout)
+	defer cancel()
+
+	return rt.run(ctx, groups, validationScheme, parserOptions), nil
+}
+
+// checkLimits returns an error if the tests exceed the limits on the number
+// of input series, input samples and evaluation steps.
+func (rt *ruleTests) checkLimits() error {
+	var series, samples, steps int
+	for i, tg := range rt.Tests {
+		name := strconv.Itoa(i)
+		if tg.Name != "" {
+			name = tg.Name
+		}
+
+		if maxEvalTime := tg.maxEvalTime(); maxEvalTime > time.Duration(maxRuleTestEvalTime) {
+			return fmt.Errorf("test %q: eval_time %s exceeds the limit of %s", name, model.Duration(maxEvalTime), maxRuleTestEvalTime)
+		}
+
+		series += len(tg.InputSeries)
+		for _, is := range tg.InputSeries {
+			samples += countSamples(is.Values)
+		}
+		steps += int(tg.maxEvalTime()/time.Duration(rt.EvaluationInterval)) + 1
+	}
+
+	switch {
+	case series > maxRuleTestSeries:
+		return fmt.Errorf("%d input series exceed the limit of %d", series, maxRuleTestSeries)
+	case samples > maxRuleTestSamples:
+		return fmt.Errorf("%d input samples exceed the limit of %d", samples, maxRuleTestSamples)
+	case steps > maxRuleTestSteps:
+		return fmt.Errorf("%d evaluation steps exceed the limit of %d (decrease eval_time or increase evaluation_interval)", steps, maxRuleTestSteps)
+	}
+
+	return nil
+}
+
+// countSamples returns an upper bound of the number of samples described by
+// the values of an input series. It doesn't expand the values since it would
+// allocate all the samples.
+func countSamples(values string) int {
+	var n int
+	for f := range strings.FieldsSeq(values) {
+		m := repeatedValuesRe.FindStringSubmatch(f)
+		if m == nil {
+			n++
+			continue
+		}
+
+		repeat, err := strconv.Atoi(m[1])
+		if err != nil || repeat >= maxRuleTestSamples {
+			return maxRuleTestSamples + 1
+		}
+		n += repeat + 1
+	}
+
+	return n
+}
+
+// staticGroupLoader implements rules.GroupLoader for rule groups already
+// parsed from a PrometheusRule object.
+type staticGroupLoader struct {
+	groups *rulefmt.RuleGroups
+	parser parser.Parser
+}
+
+func (l *staticGroupLoader) Load(string, bool, model.ValidationScheme) (*rulefmt.RuleGroups, []error) {
+	return l.groups, nil
+}
+
+func (l *staticGroupLoader) Parse(query string) (parser.Expr, error) {
+	return l.parser.ParseExpr(query)
+}
+
+// run evaluates the rule groups against the tests and returns the failed
+// expectations.
+func (rt *ruleTests) run(ctx context.Context, groups *rulefmt.RuleGroups, validationScheme model.ValidationScheme, parserOptions parser.Options) []error {
+	groupOrder := make(map[string]int, len(rt.GroupEvalOrder))
+	for i, name := range rt.GroupEvalOrder {
+		groupOrder[name] = i
+	}
+
+	var errs []error
+	for i, tg := range rt.Tests {
+		name := strconv.Itoa(i)
+		if tg.Name != "" {
+			name = tg.Name
+		}
+
+		if tg.Interval == 0 {
+			tg.Interval = rt.EvaluationInterval
+		}
+
+		for _, err := range tg.run(ctx, groups, time.Duration(rt.EvaluationInterval), groupOrder, rt.FuzzyCompare, validationScheme, parserOptions) {
+			errs = append(errs, fmt.Errorf("test %q: %w", name, err))
+		}
+	}
+
+	return errs
+}
+
+func (tg *ruleTestGroup) run(
+	ctx context.Context,
+	ruleGroups *rulefmt.RuleGroups,
+	evalInterval time.Duration,
+	groupOrder map[string]int,
+	fuzzyCompare bool,
+	validationScheme model.ValidationScheme,
+	parserOptions parser.Options,
+) []error {
+	p := parser.NewParser(parserOptions)
+
+	// The lazy loader is the one used by "promtool test rules" which
+	// guarantees that the tests behave the same in both cases. Note that it
+	// stores the samples in a temporary TSDB directory under os.TempDir().
+	suite, err := promqltest.NewLazyLoader(tg.seriesLoadingString(), promqltest.LazyLoaderOpts{
+		EnableAtModifier:     true,
+		EnableNegativeOffset: true,
+	})
+	if err != nil {
+		return []error{fmt.Errorf("input_series: %w", err)}
+	}
+	defer suite.Close()
+	suite.SubqueryInterval = evalInterval
+
+	m := rules.NewManager(&rules.ManagerOptions{
+		NameValidationScheme: validationScheme,
+		QueryFunc:            rules.EngineQueryFunc(suite.QueryEngine(), suite.Storage()),
+		Appendable:           suite.Storage(),
+		Context:              ctx,
+		NotifyFunc:           func(context.Context, string, ...*rules.Alert) {},
+		Logger:               slog.New(slog.DiscardHandler),
+		GroupLoader:          &staticGroupLoader{groups: ruleGroups, parser: p},
+		Parser:               p,
+	})
+
+	groupsMap, errs := m.LoadGroups(time.Duration(tg.Interval), labels.FromMap(tg.ExternalLabels), tg.ExternalURL, nil, false, "")
+	if errs != nil {
+		return errs
+	}
+
+	groups := slices.Collect(maps.Values(groupsMap))
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groupOrder[groups[i].Name()] < groupOrder[groups[j].Name()]
+	})
+
+	for _, g := range groups {
+		for _, r := range g.Rules() {
+			if ar, ok := r.(*rules.AlertingRule); ok {
+				// Mark the alerting rules as restored to ensure that the
+				// ALERTS series are created.
+				ar.SetRestored(true)
+			}
+		}
+	}
+
+	compareFloat64 := cmp.Options{}
+	if fuzzyCompare {
+		compareFloat64 = append(compareFloat64, cmp.Comparer(func(x, y float64) bool {
+			return x == y || math.Nextafter(x, math.Inf(-1)) == y || math.Nextafter(x, math.Inf(1)) == y
+		}))
+	}
+
+	// Index the alert test cases by evaluation time.
+	alertTests := make(map[model.Duration][]alertTestCase)
+	for _, tc := range tg.AlertRuleTests {
+		if tc.Alertname == "" {
+			return []error{fmt.Errorf("alert_rule_test: missing alertname at eval_time %v", tc.EvalTime)}
+		}
+		alertTests[tc.EvalTime] = append(alertTests[tc.EvalTime], tc)
+	}
+	alertEvalTimes := slices.Sorted(maps.Keys(alertTests))
+
+	var (
+		mint = time.Unix(0, 0).UTC()
+		maxt = mint.Add(tg.maxEvalTime())
+		curr int
+	)
+	for ts := mint; !ts.After(maxt); ts = ts.Add(evalInterval) {
+		if err := ctx.Err(); err != nil {
+			return append(errs, fmt.Errorf("evaluation aborted at time %s: %w", ts.Sub(mint), err))
+		}
+
+		var evalErrs []error
+		suite.WithSamplesTill(ts, func(err error) {
+			if err != nil {
+				evalErrs = append(evalErrs, err)
+				return
+			}
+
+			for _, g := range groups {
+				g.Eval(ctx, ts)
+				for _, r := range g.Rules() {
+					if r.LastError() != nil {
+						evalErrs = append(evalErrs, fmt.Errorf("rule %q, time %s: %w", r.Name(), ts.Sub(mint), r.LastError()))
+					}
+				}
+			}
+		})
+		if len(evalErrs) > 0 {
+			return append(errs, evalErrs...)
+		}
+
+		// The alerts expected at eval_time are compared with the alerts
+		// firing after the evaluation at ts if
+		// ts <= eval_time < ts + evalInterval.
+		for curr < len(alertEvalTimes) && ts.Sub(mint) <= time.Duration(alertEvalTimes[curr]) &&
+			time.Duration(alertEvalTimes[curr]) < ts.Add(evalInterval).Sub(mint) {
+			for _, tc := range alertTests[alertEvalTimes[curr]] {
+				if err := tc.check(groups, compareFloat64); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			curr++
+		}
+	}
+
+	for _, tc := range tg.PromqlExprTests {
+		if err := tc.check(ctx, suite, p, mint, compareFloat64); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// seriesLoadingString returns the input series in the PromQL test notation.
+func (tg *ruleTestGroup) seriesLoadingString() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "load %v\n", tg.Interval)
+	for _, is := range tg.InputSeries {
+		fmt.Fprintf(&b, "  %v %v\n", is.Series, is.Values)
+	}
+
+	return b.String()
+}
+
+// maxEvalTime returns the maximum evaluation time of the test cases.
+func (tg *ruleTestGroup) maxEvalTime() time.Duration {
+	var maxd model.Duration
+	for _, tc := range tg.AlertRuleTests {
+		maxd = max(maxd, tc.EvalTime)
+	}
+
+	for _, tc := range tg.PromqlExprTests {
+		maxd = max(maxd, tc.EvalTime)
+	}
+
+	return time.Duration(maxd)
+}
+
+type firingAlert struct {
+	Labels      labels.Labels
+	Annotations labels.Labels
+}
+
+func (fa firingAlert) String() string {
+	return fa.Labels.String() + " " + fa.Annotations.String()
+}
+
+func sortFiringAlerts(alerts []firingAlert) {
+	slices.SortFunc(alerts, func(a, b firingAlert) int {
+		if c := labels.Compare(a.Labels, b.Labels); c != 0 {
+			return c
+		}
+		return labels.Compare(a.Annotations, b.Annotations)
+	})
+}
+
+func firingAlertsString(alerts []firingAlert) string {
+	s := make([]string, 0, len(alerts))
+	for _, a := range alerts {
+		s = append(s, a.String())
+	}
+
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// check compares the expected alerts with the alerts firing after the last
+// evaluation of the groups.
+func (tc *alertTestCase) check(groups []*rules.Group, opts cmp.Options) error {
+	var got []firingAlert
+	for _, g := range groups {
+		for _, r := range g.Rules() {
+			ar, ok := r.(*rules.AlertingRule)
+			if !ok || ar.Name() != tc.Alertname {
+				continue
+			}
+
+			for _, a := range ar.ActiveAlerts() {
+				if a.State == rules.StateFiring {
+					got = append(got, firingAlert{
+						Labels:      a.Labels.Copy(),
+						Annotations: a.Annotations.Copy(),
+					})
+				}
+			}
+		}
+	}
+
+	var exp []firingAlert
+	for _, a := range tc.ExpAlerts {
+		// The alertname label is added by Prometheus during the evaluation.
+		lbls := labels.NewBuilder(labels.FromMap(a.ExpLabels))
+		lbls.Set(labels.AlertName, tc.Alertname)
+
+		exp = append(exp, firingAlert{
+			Labels:      lbls.Labels(),
+			Annotations: labels.FromMap(a.ExpAnnotations),
+		})
+	}
+
+	sortFiringAlerts(got)
+	sortFiringAlerts(exp)
+
+	if !cmp.Equal(exp, got, cmp.Comparer(labels.Equal), opts) {
+		return fmt.Errorf("alertname %q, time %s: expected %s, got %s", tc.Alertname, tc.EvalTime, firingAlertsString(exp), firingAlertsString(got))
+	}
+
+	return nil
+}
+
+type parsedSample struct {
+	Labels    labels.Labels
+	Value     float64
+	Histogram string
+}
+
+func (ps parsedSample) String() string {
+	if ps.Histogram != "" {
+		return ps.Labels.String() + " " + ps.Histogram
+	}
+
+	return ps.Labels.String() + " " + strconv.FormatFloat(ps.Value, 'E', -1, 64)
+}
+
+func parsedSamplesString(samples []parsedSample) string {
+	s := make([]string, 0, len(samples))
+	for _, ps := range samples {
+		s = append(s, ps.String())
+	}
+
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+// check compares the expected samples with the result of the PromQL
+// expression.
+func (tc *promqlTestCase) check(ctx context.Context, suite *promqltest.LazyLoader, p parser.Parser, mint time.Time, opts cmp.Options) error {
+	errPrefix := fmt.Sprintf("expr %q, time %s", tc.Expr, tc.EvalTime)
+
+	got, err := query(ctx, suite, tc.Expr, mint.Add(time.Duration(tc.EvalTime)))
+	if err != nil {
+		return fmt.Errorf("%s: %w", errPrefix, err)
+	}
+
+	gotSamples := make([]parsedSample, 0, len(got))
+	for _, s := range got {
+		gotSamples = append(gotSamples, parsedSample{
+			Labels:    s.Metric.Copy(),
+			Value:     s.F,
+			Histogram: promqltest.HistogramTestExpression(s.H),
+		})
+	}
+
+	expSamples := make([]parsedSample, 0, len(tc.ExpSamples))
+	for _, s := range tc.ExpSamples {
+		lbls, err := p.ParseMetric(s.Labels)
+		if err != nil {
+			return fmt.Errorf("%s: labels %q: %w", errPrefix, s.Labels, err)
+		}
+
+		var h *histogram.FloatHistogram
+		if s.Histogram != "" {
+			_, values, err := p.ParseSeriesDesc("{} " + s.Histogram)
+			switch {
+			case err != nil:
+				return fmt.Errorf("%s: histogram %q: %w", errPrefix, s.Histogram, err)
+			case len(values) != 1 || values[0].Histogram == nil:
+				return fmt.Errorf("%s: histogram %q: expected exactly 1 histogram value", errPrefix, s.Histogram)
+			}
+			h = values[0].Histogram
+		}
+
+		expSamples = append(expSamples, parsedSample{
+			Labels:    lbls,
+			Value:     s.Value,
+			Histogram: promqltest.HistogramTestExpression(h),
+		})
+	}
+
+	sortSamples := func(a, b parsedSample) int { return labels.Compare(a.Labels, b.Labels) }
+	slices.SortFunc(gotSamples, sortSamples)
+	slices.SortFunc(expSamples, sortSamples)
+
+	if !cmp.Equal(expSamples, gotSamples, cmp.Comparer(labels.Equal), opts) {
+		return fmt.Errorf("%s: expected %s, got %s", errPrefix, parsedSamplesString(expSamples), parsedSamplesString(gotSamples))
+	}
+
+	return nil
+}
+
+func query(ctx context.Context, suite *promqltest.LazyLoader, qs string, t time.Time) (promql.Vector, error) {
+	q, err := suite.QueryEngine().NewInstantQuery(ctx, suite.Queryable(), nil, qs, t)
+	if err != nil {
+		return nil, err
+	}
+
+	res := q.Exec(ctx)
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	switch v := res.Value.(type) {
+	case promql.Vector:
+		return v, nil
+	case promql.Scalar:
+		return promql.Vector{promql.Sample{T: v.T, F: v.V, Metric: labels.Labels{}}}, nil
+	default:
+		return nil, errors.New("result is not a vector or a scalar")
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ruletests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	promoperator "github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+var testRuleSpec = monitoringv1.PrometheusRuleSpec{
+	Groups: []monitoringv1.RuleGroup{
+		{
+			Name: "test",
+			Rules: []monitoringv1.Rule{
+				{
+					Record: "job:up:sum",
+					Expr:   intstr.FromString("sum by (job) (up)"),
+				},
+				{
+					Alert: "InstanceDown",
+					Expr:  intstr.FromString("up == 0"),
+					For:   ptr.To(monitoringv1.Duration("5m")),
+					Labels: map[string]string{
+						"severity": "critical",
+					},
+					Annotations: map[string]string{
+						"summary": "Instance {{ $labels.instance }} down",
+					},
+				},
+			},
+		},
+	},
+}
+
+const passingRuleTests = `
+tests:
+- name: instance down
+  interval: 1m
+  input_series:
+  - series: 'up{job="prometheus", instance="localhost:9090"}'
+    values: '0x10'
+  - series: 'up{job="prometheus", instance="localhost:9091"}'
+    values: '1x10'
+  alert_rule_test:
+  - eval_time: 2m
+    alertname: InstanceDown
+  - eval_time: 10m
+    alertname: InstanceDown
+    exp_alerts:
+    - exp_labels:
+        severity: critical
+        job: prometheus
+        instance: localhost:9090
+      exp_annotations:
+        summary: Instance localhost:9090 down
+  promql_expr_test:
+  - expr: job:up:sum
+    eval_time: 4m
+    exp_samples:
+    - labels: 'job:up:sum{job="prometheus"}'
+      value: 1
+`
+
+func TestRuleTests(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		tests string
+
+		parseErr bool
+		errs     []string
+	}{
+		{
+			name:  "passing tests",
+			tests: passingRuleTests,
+		},
+		{
+			name: "failing alert test",
+			tests: `
+tests:
+- interval: 1m
+  input_series:
+  - series: 'up{job="prometheus", instance="localhost:9090"}'
+    values: '0x10'
+  alert_rule_test:
+  - eval_time: 3m
+    alertname: InstanceDown
+    exp_alerts:
+    - exp_labels:
+        severity: critical
+        job: prometheus
+        instance: localhost:9090
+`,
+			errs: []string{
+				`test "0": alertname "InstanceDown", time 3m: expected [{alertname="InstanceDown", instance="localhost:9090", job="prometheus", severity="critical"} {}], got []`,
+			},
+		},
+		{
+			name: "failing promql test",
+			tests: `
+evaluation_interval: 30s
+tests:
+- name: sum
+  input_series:
+  - series: 'up{job="prometheus", instance="localhost:9090"}'
+    values: '1+0x10'
+  promql_expr_test:
+  - expr: job:up:sum
+    eval_time: 1m
+    exp_samples:
+    - labels: 'job:up:sum{job="prometheus"}'
+      value: 2
+`,
+			errs: []string{
+				`test "sum": expr "job:up:sum", time 1m: expected [{__name__="job:up:sum", job="prometheus"} 2E+00], got [{__name__="job:up:sum", job="prometheus"} 1E+00]`,
+			},
+		},
+		{
+			name: "histograms and missing samples",
+			tests: `
+tests:
+- interval: 1m
+  input_series:
+  - series: 'http_request_duration_seconds{job="api"}'
+    values: '{{schema:0 sum:5 count:4 buckets:[1 2 1]}}x4'
+  - series: 'up{job="api", instance="localhost:8080"}'
+    values: '1 _x6 0'
+  promql_expr_test:
+  - expr: http_request_duration_seconds
+    eval_time: 2m
+    exp_samples:
+    - labels: 'http_request_duration_seconds{job="api"}'
+      histogram: '{{schema:0 sum:5 count:4 counter_reset_hint:not_reset buckets:[1 2 1]}}'
+  - expr: histogram_count(http_request_duration_seconds)
+    eval_time: 4m
+    exp_samples:
+    - labels: '{job="api"}'
+      value: 4
+  - expr: up
+    eval_time: 4m
+    exp_samples:
+    - labels: 'up{job="api", instance="localhost:8080"}'
+      value: 1
+  - expr: up
+    eval_time: 6m
+  - expr: up @ 7m
+    eval_time: 7m
+    exp_samples:
+    - labels: 'up{job="api", instance="localhost:8080"}'
+      value: 0
+`,
+		},
+		{
+			name: "invalid input series",
+			tests: `
+tests:
+- interval: 1m
+  input_series:
+  - series: 'up{'
+    values: '1'
+`,
+			errs: []string{`test "0": input_series:`},
+		},
+		{
+			name:     "unknown field",
+			tests:    "tests: []\nrule_files: [foo.yaml]\n",
+			parseErr: true,
+		},
+		{
+			name: "eval_time over the limit",
+			tests: `
+tests:
+- interval: 1m
+  input_series:
+  - series: 'up'
+    values: '1'
+  promql_expr_test:
+  - expr: up
+    eval_time: 30d
+`,
+			parseErr: true,
+		},
+		{
+			name: "evaluation steps over the limit",
+			tests: `
+evaluation_interval: 1s
+tests:
+- interval: 1m
+  input_series:
+  - series: 'up'
+    values: '1'
+  alert_rule_test:
+  - eval_time: 1d
+    alertname: InstanceDown
+`,
+			parseErr: true,
+		},
+		{
+			name: "input samples over the limit",
+			tests: `
+tests:
+- interval: 1m
+  input_series:
+  - series: 'up'
+    values: '1+1x1000000000'
+`,
+			parseErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rt, err := parseRuleTests(map[string]string{Annotation: tc.tests})
+			if tc.parseErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			groups, errs := promoperator.ParseRule(*testRuleSpec.DeepCopy(), model.UTF8Validation, parser.Options{})
+			require.Empty(t, errs)
+
+			errs = rt.run(context.Background(), groups, model.UTF8Validation, parser.Options{})
+			require.Len(t, errs, len(tc.errs), "%v", errs)
+			for i := range errs {
+				require.Contains(t, errs[i].Error(), tc.errs[i])
+			}
+		})
+	}
+}
+
+func TestCountSamples(t *testing.T) {
+	for _, tc := range []struct {
+		values string
+		exp    int
+	}{
+		{values: "", exp: 0},
+		{values: "1 2 _ stale", exp: 4},
+		{values: "1+1x10", exp: 11},
+		{values: "0x5 _x2 1-1x3", exp: 13},
+		{values: "{{schema:0 sum:5 count:4 buckets:[1 2 1]}}x2", exp: 8},
+		{values: "1x99999999999999999999", exp: maxRuleTestSamples + 1},
+	} {
+		t.Run(tc.values, func(t *testing.T) {
+			require.Equal(t, tc.exp, countSamples(tc.values))
+		})
+	}
+}
+
+func TestRuleTestsCanceled(t *testing.T) {
+	rt, err := parseRuleTests(map[string]string{Annotation: passingRuleTests})
+	require.NoError(t, err)
+
+	groups, errs := promoperator.ParseRule(*testRuleSpec.DeepCopy(), model.UTF8Validation, parser.Options{})
+	require.Empty(t, errs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs = rt.run(ctx, groups, model.UTF8Validation, parser.Options{})
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], context.Canceled)
+}
+
+func TestParseRuleTestsWithoutAnnotation(t *testing.T) {
+	rt, err := parseRuleTests(map[string]string{"foo": "bar"})
+	require.NoError(t, err)
+	require.Nil(t, rt)
+}
+
+func TestEvaluate(t *testing.T) {
+	groups, errs := promoperator.ParseRule(*testRuleSpec.DeepCopy(), model.UTF8Validation, parser.Options{})
+	require.Empty(t, errs)
+
+	errs, err := Evaluate(context.Background(), map[string]string{Annotation: passingRuleTests}, groups, model.UTF8Validation, parser.Options{})
+	require.NoError(t, err)
+	require.Empty(t, errs)
+
+	_, err = Evaluate(context.Background(), map[string]string{Annotation: "tests: {}"}, groups, model.UTF8Validation, parser.Options{})
+	require.Error(t, err)
+
+	errs, err = Evaluate(context.Background(), nil, groups, model.UTF8Validation, parser.Options{})
+	require.NoError(t, err)
+	require.Empty(t, errs)
+}
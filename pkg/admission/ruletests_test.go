@@ -0,0 +1,130 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/rulefmt"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestAdmitRuleWithTests(t *testing.T) {
+	const testsAnnotation = "operator.prometheus.io/rule-tests"
+
+	for _, tc := range []struct {
+		name      string
+		ruleTests RuleTestsFunc
+		allowed   bool
+	}{
+		{
+			name:    "rule tests disabled",
+			allowed: true,
+		},
+		{
+			name: "passing tests",
+			ruleTests: func(_ context.Context, annotations map[string]string, groups *rulefmt.RuleGroups, _ model.ValidationScheme, _ parser.Options) ([]error, error) {
+				if annotations[testsAnnotation] != "tests" || len(groups.Groups) != 1 {
+					return nil, errors.New("unexpected arguments")
+				}
+				return nil, nil
+			},
+			allowed: true,
+		},
+		{
+			name: "invalid tests",
+			ruleTests: func(context.Context, map[string]string, *rulefmt.RuleGroups, model.ValidationScheme, parser.Options) ([]error, error) {
+				return nil, errors.New("invalid")
+			},
+			allowed: false,
+		},
+		{
+			name: "failing tests",
+			ruleTests: func(context.Context, map[string]string, *rulefmt.RuleGroups, model.ValidationScheme, parser.Options) ([]error, error) {
+				return []error{errors.New("failed")}, nil
+			},
+			allowed: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var opts []Option
+			if tc.ruleTests != nil {
+				opts = append(opts, WithRuleTests(tc.ruleTests))
+			}
+
+			ts := server(New(slog.New(slog.DiscardHandler), model.UTF8Validation, parser.Options{}, opts...).servePrometheusRulesValidate)
+			t.Cleanup(ts.Close)
+
+			resp := sendAdmissionReview(t, ts, buildAdmissionReviewFromPrometheusRule(t, &monitoringv1.PrometheusRule{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: monitoringv1.SchemeGroupVersion.String(),
+					Kind:       monitoringv1.PrometheusRuleKind,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test",
+					Namespace:   "monitoring",
+					Annotations: map[string]string{testsAnnotation: "tests"},
+				},
+				Spec: monitoringv1.PrometheusRuleSpec{
+					Groups: []monitoringv1.RuleGroup{{
+						Name: "test",
+						Rules: []monitoringv1.Rule{{
+							Record: "job:up:sum",
+							Expr:   intstr.FromString("sum by (job) (up)"),
+						}},
+					}},
+				},
+			}))
+
+			require.Equal(t, tc.allowed, resp.Response.Allowed, "result: %v", resp.Response.Result)
+		})
+	}
+}
+
+func buildAdmissionReviewFromPrometheusRule(t *testing.T, rule *monitoringv1.PrometheusRule) []byte {
+	t.Helper()
+
+	raw, err := json.Marshal(rule)
+	require.NoError(t, err)
+
+	b, err := json.Marshal(v1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       "AdmissionReview",
+		},
+		Request: &v1.AdmissionRequest{
+			UID:       "87c5df7f-5090-11e9-b9b4-02425473f309",
+			Resource:  prometheusRuleGVR,
+			Namespace: rule.Namespace,
+			Operation: v1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	})
+	require.NoError(t, err)
+
+	return b
+}
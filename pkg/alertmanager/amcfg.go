@@ -17,6 +17,7 @@ package alertmanager
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -45,6 +46,24 @@ import (
 
 const inhibitRuleNamespaceKey = "namespace"
 
+// redactedConfig returns the Alertmanager configuration with the secret
+// values replaced by "<secret>", the same way as Alertmanager does when it
+// exposes its configuration. It returns an empty string if the configuration
+// is empty or can't be loaded.
+func redactedConfig(logger *slog.Logger, b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+
+	cfg, err := config.Load(string(b))
+	if err != nil {
+		logger.Warn("failed to redact the configuration", "err", err)
+		return ""
+	}
+
+	return cfg.String()
+}
+
 // alertmanagerConfigFrom returns a valid alertmanagerConfig from b
 // or returns an error if
 // 1. s fails validation provided by upstream
@@ -109,6 +128,7 @@ func (c *alertmanagerConfig) String() string {
 type enforcer interface {
 	processRoute(types.NamespacedName, *route) *route
 	processInhibitRule(types.NamespacedName, *inhibitRule) *inhibitRule
+	processSilenceMatchers(types.NamespacedName, []monitoringv1alpha1.Matcher) []monitoringv1alpha1.Matcher
 }
 
 // continueToNextRoute is an enforcer that always sets `continue: true` for the
@@ -130,6 +150,10 @@ func (cte *continueToNextRoute) processInhibitRule(crKey types.NamespacedName, i
 	return cte.e.processInhibitRule(crKey, ir)
 }
 
+func (cte *continueToNextRoute) processSilenceMatchers(crKey types.NamespacedName, matchers []monitoringv1alpha1.Matcher) []monitoringv1alpha1.Matcher {
+	return cte.e.processSilenceMatchers(crKey, matchers)
+}
+
 // noopEnforcer is a passthrough enforcer.
 type noopEnforcer struct{}
 
@@ -143,6 +167,10 @@ func (ne *noopEnforcer) processRoute(_ types.NamespacedName, r *route) *route {
 	return r
 }
 
+func (ne *noopEnforcer) processSilenceMatchers(_ types.NamespacedName, matchers []monitoringv1alpha1.Matcher) []monitoringv1alpha1.Matcher {
+	return matchers
+}
+
 // namespaceEnforcer enforces a namespace label matcher.
 type namespaceEnforcer struct {
 	matchersV2Allowed bool
@@ -202,6 +230,16 @@ func (ne *namespaceEnforcer) processRoute(crKey types.NamespacedName, r *route)
 	return r
 }
 
+// processSilenceMatchers on namespaceEnforcer adds a matcher to the silence
+// matchers to silence only alerts originating from the given namespace.
+func (ne *namespaceEnforcer) processSilenceMatchers(crKey types.NamespacedName, matchers []monitoringv1alpha1.Matcher) []monitoringv1alpha1.Matcher {
+	return append(matchers, monitoringv1alpha1.Matcher{
+		Name:      "namespace",
+		Value:     crKey.Namespace,
+		MatchType: monitoringv1alpha1.MatchEqual,
+	})
+}
+
 type otherNamespaceEnforcer struct {
 	alertmanagerNamespace string
 	namespaceEnforcer
@@ -223,6 +261,13 @@ func (one *otherNamespaceEnforcer) processRoute(crKey types.NamespacedName, r *r
 	return one.namespaceEnforcer.processRoute(crKey, r)
 }
 
+func (one *otherNamespaceEnforcer) processSilenceMatchers(crKey types.NamespacedName, matchers []monitoringv1alpha1.Matcher) []monitoringv1alpha1.Matcher {
+	if crKey.Namespace == one.alertmanagerNamespace {
+		return matchers
+	}
+	return one.namespaceEnforcer.processSilenceMatchers(crKey, matchers)
+}
+
 // ConfigBuilder knows how to build an Alertmanager configuration from a raw
 // configuration and/or AlertmanagerConfig objects.
 // The API is public because it's used by Grafana Alloy (https://github.com/grafana/alloy).
@@ -233,6 +278,8 @@ type ConfigBuilder struct {
 	amVersion semver.Version
 	store     *assets.StoreBuilder
 	enforcer  enforcer
+
+	templateFiles map[string][]byte
 }
 
 func NewConfigBuilder(logger *slog.Logger, amVersion semver.Version, store *assets.StoreBuilder, am *monitoringv1.Alertmanager) *ConfigBuilder {
@@ -383,6 +430,10 @@ func (cb *ConfigBuilder) AddAlertmanagerConfigs(ctx context.Context, amConfigs m
 			),
 		)
 
+		if err := cb.convertTemplates(ctx, amConfigs[amConfigIdentifier].Spec.Templates, crKey); err != nil {
+			return fmt.Errorf("AlertmanagerConfig %s: %w", crKey.String(), err)
+		}
+
 		for _, receiver := range amConfigs[amConfigIdentifier].Spec.Receivers {
 			receivers, err := cb.convertReceiver(ctx, &receiver, crKey)
 			if err != nil {
@@ -782,6 +833,42 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		}
 	}
 
+	var jiraConfigs []*jiraConfig
+	if l := len(in.JiraConfigs); l > 0 {
+		jiraConfigs = make([]*jiraConfig, l)
+		for i := range in.JiraConfigs {
+			receiver, err := cb.convertJiraConfig(ctx, in.JiraConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("JiraConfig[%d]: %w", i, err)
+			}
+			jiraConfigs[i] = receiver
+		}
+	}
+
+	var mattermostConfigs []*mattermostConfig
+	if l := len(in.MattermostConfigs); l > 0 {
+		mattermostConfigs = make([]*mattermostConfig, l)
+		for i := range in.MattermostConfigs {
+			receiver, err := cb.convertMattermostConfig(ctx, in.MattermostConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("MattermostConfig[%d]: %w", i, err)
+			}
+			mattermostConfigs[i] = receiver
+		}
+	}
+
+	var incidentioConfigs []*incidentioConfig
+	if l := len(in.IncidentioConfigs); l > 0 {
+		incidentioConfigs = make([]*incidentioConfig, l)
+		for i := range in.IncidentioConfigs {
+			receiver, err := cb.convertIncidentioConfig(ctx, in.IncidentioConfigs[i], crKey)
+			if err != nil {
+				return nil, fmt.Errorf("IncidentioConfig[%d]: %w", i, err)
+			}
+			incidentioConfigs[i] = receiver
+		}
+	}
+
 	return &receiver{
 		Name:              makeNamespacedString(in.Name, crKey),
 		OpsgenieConfigs:   opsgenieConfigs,
@@ -799,9 +886,168 @@ func (cb *ConfigBuilder) convertReceiver(ctx context.Context, in *monitoringv1al
 		MSTeamsConfigs:    msTeamsConfigs,
 		MSTeamsV2Configs:  msTeamsV2Configs,
 		RocketChatConfigs: rocketchatConfigs,
+		JiraConfigs:       jiraConfigs,
+		MattermostConfigs: mattermostConfigs,
+		IncidentioConfigs: incidentioConfigs,
 	}, nil
 }
 
+func (cb *ConfigBuilder) convertIncidentioConfig(ctx context.Context, in monitoringv1alpha1.IncidentioConfig, crKey types.NamespacedName) (*incidentioConfig, error) {
+	out := &incidentioConfig{
+		VSendResolved: in.SendResolved,
+		URL:           string(in.URL),
+	}
+
+	if in.AlertSourceToken != nil {
+		token, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.AlertSourceToken)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get incident.io alert source token: %w", err)
+		}
+		out.AlertSourceToken = token
+	}
+
+	if in.MaxAlerts > 0 {
+		out.MaxAlerts = &in.MaxAlerts
+	}
+
+	if in.Timeout != nil && *in.Timeout != "" {
+		timeout, err := model.ParseDuration(string(*in.Timeout))
+		if err != nil {
+			return nil, err
+		}
+		out.Timeout = &timeout
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertMattermostConfig(ctx context.Context, in monitoringv1alpha1.MattermostConfig, crKey types.NamespacedName) (*mattermostConfig, error) {
+	out := &mattermostConfig{
+		SendResolved: in.SendResolved,
+		Channel:      ptr.Deref(in.Channel, ""),
+		Username:     ptr.Deref(in.Username, ""),
+		Text:         ptr.Deref(in.Text, ""),
+		IconURL:      string(ptr.Deref(in.IconURL, "")),
+		IconEmoji:    ptr.Deref(in.IconEmoji, ""),
+	}
+
+	if in.WebhookURL != nil {
+		webhookURL, err := cb.store.GetSecretKey(ctx, crKey.Namespace, *in.WebhookURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Mattermost webhook URL: %w", err)
+		}
+		out.WebhookURL = webhookURL
+	}
+
+	if l := len(in.Attachments); l > 0 {
+		attachments := make([]*mattermostAttachmentConfig, l)
+		for i, a := range in.Attachments {
+			attachment := &mattermostAttachmentConfig{
+				Fallback:   ptr.Deref(a.Fallback, ""),
+				Color:      ptr.Deref(a.Color, ""),
+				Pretext:    ptr.Deref(a.Pretext, ""),
+				Text:       ptr.Deref(a.Text, ""),
+				AuthorName: ptr.Deref(a.AuthorName, ""),
+				AuthorLink: string(ptr.Deref(a.AuthorLink, "")),
+				AuthorIcon: string(ptr.Deref(a.AuthorIcon, "")),
+				Title:      ptr.Deref(a.Title, ""),
+				TitleLink:  string(ptr.Deref(a.TitleLink, "")),
+				ThumbURL:   string(ptr.Deref(a.ThumbURL, "")),
+				Footer:     ptr.Deref(a.Footer, ""),
+				FooterIcon: string(ptr.Deref(a.FooterIcon, "")),
+				ImageURL:   string(ptr.Deref(a.ImageURL, "")),
+			}
+
+			for _, f := range a.Fields {
+				attachment.Fields = append(attachment.Fields, mattermostField{
+					Title: f.Title,
+					Value: f.Value,
+					Short: ptr.Deref(f.Short, false),
+				})
+			}
+
+			attachments[i] = attachment
+		}
+		out.Attachments = attachments
+	}
+
+	if in.Props != nil {
+		out.Props = &mattermostPropsConfig{
+			Card: in.Props.Card,
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &mattermostPriorityConfig{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
+func (cb *ConfigBuilder) convertJiraConfig(ctx context.Context, in monitoringv1alpha1.JiraConfig, crKey types.NamespacedName) (*jiraConfig, error) {
+	out := &jiraConfig{
+		SendResolved:      in.SendResolved,
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           ptr.Deref(in.Summary, ""),
+		Description:       ptr.Deref(in.Description, ""),
+		Priority:          ptr.Deref(in.Priority, ""),
+		Labels:            in.Labels,
+		ReopenTransition:  ptr.Deref(in.ReopenTransition, ""),
+		ResolveTransition: ptr.Deref(in.ResolveTransition, ""),
+		WontFixResolution: ptr.Deref(in.WontFixResolution, ""),
+		APIType:           ptr.Deref(in.APIType, ""),
+	}
+
+	if in.APIURL != nil && *in.APIURL != "" {
+		out.APIURL = string(*in.APIURL)
+	}
+
+	if in.ReopenDuration != nil {
+		reopenDuration, err := model.ParseDuration(string(*in.ReopenDuration))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse reopen duration: %w", err)
+		}
+		out.ReopenDuration = reopenDuration
+	}
+
+	if l := len(in.Fields); l > 0 {
+		fields := make(map[string]any, l)
+		for _, f := range in.Fields {
+			var v any
+			if err := json.Unmarshal(f.Value.Raw, &v); err != nil {
+				return nil, fmt.Errorf("failed to decode value of field %q: %w", f.Key, err)
+			}
+			fields[f.Key] = v
+		}
+		out.Fields = fields
+	}
+
+	httpConfig, err := cb.convertHTTPConfig(ctx, in.HTTPConfig, crKey)
+	if err != nil {
+		return nil, err
+	}
+	out.HTTPConfig = httpConfig
+
+	return out, nil
+}
+
 func (cb *ConfigBuilder) convertRocketChatConfig(ctx context.Context, in monitoringv1alpha1.RocketChatConfig, crKey types.NamespacedName) (*rocketChatConfig, error) {
 	out := &rocketChatConfig{
 		SendResolved: in.SendResolved,
@@ -0,0 +1,1419 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/alertmanager/pkg/labels"
+	"github.com/prometheus/alertmanager/timeinterval"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	sortutil "github.com/prometheus-operator/prometheus-operator/internal/sortutil"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+)
+
+// MigrationOptions defines how MigrateConfiguration splits an Alertmanager
+// configuration into AlertmanagerConfig resources.
+type MigrationOptions struct {
+	// Namespace of the generated objects.
+	Namespace string
+	// SplitByLabel is the name of the label used to group the top-level
+	// routes. When empty, one AlertmanagerConfig resource is generated for
+	// each top-level route.
+	SplitByLabel string
+}
+
+// MigratedConfig holds the objects generated for one AlertmanagerConfig
+// resource.
+type MigratedConfig struct {
+	AlertmanagerConfig *monitoringv1beta1.AlertmanagerConfig
+	// Secret holds the credentials referenced by the AlertmanagerConfig
+	// resource. It is nil when the receivers have no credentials.
+	Secret *corev1.Secret
+	// Warnings lists the settings which couldn't be converted.
+	Warnings []string
+}
+
+// MigrationResult is the outcome of MigrateConfiguration.
+type MigrationResult struct {
+	Configs []MigratedConfig
+	// Warnings lists the settings which couldn't be converted and which don't
+	// belong to a specific AlertmanagerConfig resource.
+	Warnings []string
+}
+
+// MigrateConfiguration converts an Alertmanager configuration file into
+// AlertmanagerConfig resources. It is the inverse operation of
+// ConfigBuilder.AddAlertmanagerConfigs: the top-level routes (grouped
+// according to the options) become the routes of the AlertmanagerConfig
+// resources with the receivers, time intervals and inhibition rules that they
+// depend on. Credentials are moved to Secrets.
+func MigrateConfiguration(b []byte, opts MigrationOptions) (*MigrationResult, error) {
+	cfg, err := alertmanagerConfigFromBytes(b)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &migrator{
+		cfg:   cfg,
+		opts:  opts,
+		names: map[string]int{},
+	}
+
+	return m.migrate()
+}
+
+// routeGroup is a set of top-level routes which end up in the same
+// AlertmanagerConfig resource.
+type routeGroup struct {
+	name         string
+	value        string
+	routes       []int
+	inhibitRules []*inhibitRule
+}
+
+type migrator struct {
+	cfg   *alertmanagerConfig
+	opts  MigrationOptions
+	names map[string]int
+	res   MigrationResult
+}
+
+func (m *migrator) warnf(format string, args ...any) {
+	m.res.Warnings = append(m.res.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (m *migrator) migrate() (*MigrationResult, error) {
+	if m.cfg.Global != nil {
+		m.warnf("global: not converted, configure the global settings in the Alertmanager resource (spec.alertmanagerConfiguration.global)")
+	}
+
+	if len(m.cfg.Templates) > 0 {
+		m.warnf("templates: not converted, store the template files in ConfigMaps or Secrets and reference them in spec.templates")
+	}
+
+	root := m.cfg.Route
+	if len(root.Match) > 0 || len(root.MatchRE) > 0 || len(root.Matchers) > 0 || len(root.MuteTimeIntervals) > 0 || len(root.ActiveTimeIntervals) > 0 {
+		m.warnf("route: the matchers and time intervals of the root route are not converted")
+	}
+
+	groups, err := m.groupRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, ir := range m.cfg.InhibitRules {
+		if m.assignInhibitRule(groups, ir) {
+			continue
+		}
+		m.warnf("inhibit_rules[%d]: not converted, the rule can't be assigned to a single AlertmanagerConfig resource", i)
+	}
+
+	referenced := map[string]struct{}{}
+	for _, g := range groups {
+		mc, err := m.migrateGroup(g)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range mc.AlertmanagerConfig.Spec.Receivers {
+			referenced[r.Name] = struct{}{}
+		}
+		m.res.Configs = append(m.res.Configs, *mc)
+	}
+
+	for _, r := range m.cfg.Receivers {
+		if _, found := referenced[r.Name]; !found {
+			m.warnf("receivers[%q]: not converted, the receiver isn't referenced by a top-level route", r.Name)
+		}
+	}
+
+	if len(m.res.Configs) > 0 {
+		m.warnf("the operator adds a namespace=%q matcher to the routes and inhibition rules of AlertmanagerConfig resources, set spec.alertmanagerConfigMatcherStrategy.type to %q in the Alertmanager resource to match all alerts", m.opts.Namespace, monitoringv1.NoneConfigMatcherStrategyType)
+	}
+
+	return &m.res, nil
+}
+
+// groupRoutes returns the groups of top-level routes.
+func (m *migrator) groupRoutes() ([]*routeGroup, error) {
+	var groups []*routeGroup
+
+	if m.opts.SplitByLabel == "" {
+		for i, r := range m.cfg.Route.Routes {
+			name := r.Receiver
+			if name == "" {
+				name = m.cfg.Route.Receiver
+			}
+			groups = append(groups, &routeGroup{
+				name:   m.uniqueName(name),
+				routes: []int{i},
+			})
+		}
+
+		return groups, nil
+	}
+
+	byValue := map[string]*routeGroup{}
+	for i, r := range m.cfg.Route.Routes {
+		v, found, err := equalityMatcher(m.opts.SplitByLabel, r.Match, r.Matchers)
+		if err != nil {
+			return nil, fmt.Errorf("route.routes[%d]: %w", i, err)
+		}
+
+		if !found {
+			m.warnf("route.routes[%d]: not converted, no equality matcher on label %q", i, m.opts.SplitByLabel)
+			continue
+		}
+
+		g, found := byValue[v]
+		if !found {
+			g = &routeGroup{
+				name:  m.uniqueName(v),
+				value: v,
+			}
+			byValue[v] = g
+			groups = append(groups, g)
+		}
+		g.routes = append(g.routes, i)
+	}
+
+	return groups, nil
+}
+
+// assignInhibitRule attaches the inhibition rule to the group whose label
+// value is matched by both the source and target matchers. It returns false
+// if no group matches.
+func (m *migrator) assignInhibitRule(groups []*routeGroup, ir *inhibitRule) bool {
+	if m.opts.SplitByLabel == "" {
+		return false
+	}
+
+	source, found, err := equalityMatcher(m.opts.SplitByLabel, ir.SourceMatch, ir.SourceMatchers)
+	if err != nil || !found {
+		return false
+	}
+
+	target, found, err := equalityMatcher(m.opts.SplitByLabel, ir.TargetMatch, ir.TargetMatchers)
+	if err != nil || !found || source != target {
+		return false
+	}
+
+	for _, g := range groups {
+		if g.value == source {
+			g.inhibitRules = append(g.inhibitRules, ir)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *migrator) migrateGroup(g *routeGroup) (*MigratedConfig, error) {
+	cm := &configMigrator{
+		secretName: g.name + "-credentials",
+		secretData: map[string]string{},
+	}
+
+	root := m.cfg.Route
+	routes := make([]*route, 0, len(g.routes))
+	for _, i := range g.routes {
+		r := *root.Routes[i]
+		// A route without receiver inherits the receiver of its parent.
+		if r.Receiver == "" {
+			r.Receiver = root.Receiver
+		}
+		routes = append(routes, &r)
+
+		if !r.Continue && i < len(root.Routes)-1 {
+			cm.warnings = append(cm.warnings, fmt.Sprintf("route.routes[%d]: the operator enforces 'continue: true' for the top-level route, alerts will also be evaluated by the next routes", i))
+		}
+	}
+
+	receivers := []*receiver{}
+	top := routes[0]
+	if len(routes) > 1 {
+		// The routes are nested under a route which only matches the label
+		// value. It needs a receiver which doesn't send any notification.
+		nullReceiver := m.unusedReceiverName("null")
+		receivers = append(receivers, &receiver{Name: nullReceiver})
+		top = &route{
+			Receiver: nullReceiver,
+			Matchers: []string{(&labels.Matcher{Type: labels.MatchEqual, Name: m.opts.SplitByLabel, Value: g.value}).String()},
+			Routes:   routes,
+		}
+	}
+
+	// The root route of the Alertmanager resource has no grouping settings
+	// unless spec.alertmanagerConfiguration is set: propagate the settings
+	// from the root route of the configuration.
+	if len(top.GroupByStr) == 0 {
+		top.GroupByStr = root.GroupByStr
+	}
+	if top.GroupWait == "" {
+		top.GroupWait = root.GroupWait
+	}
+	if top.GroupInterval == "" {
+		top.GroupInterval = root.GroupInterval
+	}
+	if top.RepeatInterval == "" {
+		top.RepeatInterval = root.RepeatInterval
+	}
+
+	rt, err := cm.convertRoute(top)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := monitoringv1beta1.AlertmanagerConfigSpec{
+		Route: rt,
+	}
+
+	receiverNames := map[string]struct{}{}
+	timeIntervalNames := map[string]struct{}{}
+	walkRoutes(top, func(r *route) {
+		receiverNames[r.Receiver] = struct{}{}
+		for _, ti := range r.MuteTimeIntervals {
+			timeIntervalNames[ti] = struct{}{}
+		}
+		for _, ti := range r.ActiveTimeIntervals {
+			timeIntervalNames[ti] = struct{}{}
+		}
+	})
+
+	for _, r := range m.cfg.Receivers {
+		if _, found := receiverNames[r.Name]; found {
+			receivers = append(receivers, r)
+		}
+	}
+
+	for _, r := range receivers {
+		spec.Receivers = append(spec.Receivers, cm.convertReceiver(r))
+	}
+
+	for _, ti := range append(m.cfg.MuteTimeIntervals, m.cfg.TimeIntervals...) {
+		if _, found := timeIntervalNames[ti.Name]; found {
+			spec.TimeIntervals = append(spec.TimeIntervals, cm.convertTimeInterval(ti))
+		}
+	}
+
+	for _, ir := range g.inhibitRules {
+		rule, err := convertInhibitRuleToV1beta1(ir)
+		if err != nil {
+			return nil, err
+		}
+		spec.InhibitRules = append(spec.InhibitRules, rule)
+	}
+
+	mc := &MigratedConfig{
+		AlertmanagerConfig: &monitoringv1beta1.AlertmanagerConfig{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       monitoringv1beta1.AlertmanagerConfigKind,
+				APIVersion: monitoringv1beta1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      g.name,
+				Namespace: m.opts.Namespace,
+			},
+			Spec: spec,
+		},
+		Warnings: cm.warnings,
+	}
+
+	if len(cm.secretData) > 0 {
+		mc.Secret = &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cm.secretName,
+				Namespace: m.opts.Namespace,
+			},
+			Type:       corev1.SecretTypeOpaque,
+			StringData: cm.secretData,
+		}
+	}
+
+	return mc, nil
+}
+
+var invalidResourceNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// uniqueName returns a valid Kubernetes object name derived from s which
+// hasn't been returned before.
+func (m *migrator) uniqueName(s string) string {
+	name := invalidResourceNameChars.ReplaceAllString(strings.ToLower(s), "-")
+	// Leave room for the "-credentials" suffix of the Secret.
+	if len(name) > 200 {
+		name = name[:200]
+	}
+	name = strings.Trim(name, "-.")
+	if name == "" {
+		name = "alertmanager-config"
+	}
+
+	m.names[name]++
+	if n := m.names[name]; n > 1 {
+		return fmt.Sprintf("%s-%d", name, n)
+	}
+
+	return name
+}
+
+// unusedReceiverName returns a receiver name derived from s which isn't
+// defined in the configuration.
+func (m *migrator) unusedReceiverName(s string) string {
+	name := s
+	for i := 2; ; i++ {
+		if !m.cfg.hasReceiver(name) {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d", s, i)
+	}
+}
+
+func (c *alertmanagerConfig) hasReceiver(name string) bool {
+	for _, r := range c.Receivers {
+		if r.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func walkRoutes(r *route, fn func(*route)) {
+	fn(r)
+	for _, child := range r.Routes {
+		walkRoutes(child, fn)
+	}
+}
+
+// equalityMatcher returns the value of the equality matcher on the given
+// label if any.
+func equalityMatcher(name string, match map[string]string, matchers []string) (string, bool, error) {
+	if v, found := match[name]; found {
+		return v, true, nil
+	}
+
+	for _, s := range matchers {
+		ms, err := labels.ParseMatchers(s)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, lm := range ms {
+			if lm.Name == name && lm.Type == labels.MatchEqual {
+				return lm.Value, true, nil
+			}
+		}
+	}
+
+	return "", false, nil
+}
+
+func convertMatchersToV1beta1(match, matchRE map[string]string, matchers []string) ([]monitoringv1beta1.Matcher, error) {
+	var out []monitoringv1beta1.Matcher
+
+	for _, k := range sortutil.SortedKeys(match) {
+		out = append(out, monitoringv1beta1.Matcher{Name: k, Value: match[k], MatchType: monitoringv1beta1.MatchEqual})
+	}
+
+	for _, k := range sortutil.SortedKeys(matchRE) {
+		out = append(out, monitoringv1beta1.Matcher{Name: k, Value: matchRE[k], MatchType: monitoringv1beta1.MatchRegexp})
+	}
+
+	for _, s := range matchers {
+		ms, err := labels.ParseMatchers(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid matcher %q: %w", s, err)
+		}
+
+		for _, lm := range ms {
+			out = append(out, monitoringv1beta1.Matcher{
+				Name:      lm.Name,
+				Value:     lm.Value,
+				MatchType: monitoringv1beta1.MatchType(lm.Type.String()),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func convertInhibitRuleToV1beta1(in *inhibitRule) (monitoringv1beta1.InhibitRule, error) {
+	source, err := convertMatchersToV1beta1(in.SourceMatch, in.SourceMatchRE, in.SourceMatchers)
+	if err != nil {
+		return monitoringv1beta1.InhibitRule{}, err
+	}
+
+	target, err := convertMatchersToV1beta1(in.TargetMatch, in.TargetMatchRE, in.TargetMatchers)
+	if err != nil {
+		return monitoringv1beta1.InhibitRule{}, err
+	}
+
+	return monitoringv1beta1.InhibitRule{
+		SourceMatch: source,
+		TargetMatch: target,
+		Equal:       in.Equal,
+	}, nil
+}
+
+// migrationLocation identifies a configuration block in the warnings and in
+// the keys of the generated Secret.
+type migrationLocation struct {
+	path string
+	key  string
+}
+
+func (l migrationLocation) child(path, key string) migrationLocation {
+	return migrationLocation{
+		path: l.path + "." + path,
+		key:  l.key + "-" + key,
+	}
+}
+
+var invalidSecretKeyChars = regexp.MustCompile(`[^-._a-zA-Z0-9]+`)
+
+// configMigrator converts the objects belonging to a single
+// AlertmanagerConfig resource.
+type configMigrator struct {
+	secretName string
+	secretData map[string]string
+	warnings   []string
+}
+
+func (cm *configMigrator) warnf(l migrationLocation, field string, format string, args ...any) {
+	cm.warnings = append(cm.warnings, fmt.Sprintf("%s.%s: %s", l.path, field, fmt.Sprintf(format, args...)))
+}
+
+// unsupported records a warning if the field is set.
+func (cm *configMigrator) unsupported(l migrationLocation, field string, set bool) {
+	if set {
+		cm.warnf(l, field, "not converted, the field isn't supported by the AlertmanagerConfig resource")
+	}
+}
+
+// file records a warning if the field referencing a file is set.
+func (cm *configMigrator) file(l migrationLocation, field string, file string) {
+	if file != "" {
+		cm.warnf(l, field, "not converted, the file should be mounted in the Alertmanager pods or its content stored in a Secret")
+	}
+}
+
+// secretKey stores the value in the Secret and returns the selector
+// referencing it.
+func (cm *configMigrator) secretKey(l migrationLocation, field string, value string) *corev1.SecretKeySelector {
+	key := invalidSecretKeyChars.ReplaceAllString(l.key+"-"+field, "-")
+	cm.secretData[key] = value
+
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: cm.secretName},
+		Key:                  key,
+	}
+}
+
+// optionalSecretKey is similar to secretKey but returns nil if the value is
+// empty.
+func (cm *configMigrator) optionalSecretKey(l migrationLocation, field string, value string) *corev1.SecretKeySelector {
+	if value == "" {
+		return nil
+	}
+
+	return cm.secretKey(l, field, value)
+}
+
+func (cm *configMigrator) optionalV1beta1SecretKey(l migrationLocation, field string, value string) *monitoringv1beta1.SecretKeySelector {
+	sks := cm.optionalSecretKey(l, field, value)
+	if sks == nil {
+		return nil
+	}
+
+	return &monitoringv1beta1.SecretKeySelector{Name: sks.Name, Key: sks.Key}
+}
+
+func (cm *configMigrator) convertRoute(in *route) (*monitoringv1beta1.Route, error) {
+	matchers, err := convertMatchersToV1beta1(in.Match, in.MatchRE, in.Matchers)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &monitoringv1beta1.Route{
+		Receiver:            in.Receiver,
+		GroupBy:             in.GroupByStr,
+		GroupWait:           nonEmptyDuration(in.GroupWait),
+		GroupInterval:       nonEmptyDuration(in.GroupInterval),
+		RepeatInterval:      nonEmptyDuration(in.RepeatInterval),
+		Matchers:            matchers,
+		Continue:            in.Continue,
+		MuteTimeIntervals:   in.MuteTimeIntervals,
+		ActiveTimeIntervals: in.ActiveTimeIntervals,
+	}
+
+	for _, r := range in.Routes {
+		child, err := cm.convertRoute(r)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(child)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Routes = append(out.Routes, apiextensionsv1.JSON{Raw: b})
+	}
+
+	return out, nil
+}
+
+var weekdayNames = []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+
+var monthNames = []string{"", "january", "february", "march", "april", "may", "june", "july", "august", "september", "october", "november", "december"}
+
+func (cm *configMigrator) convertTimeInterval(in *timeInterval) monitoringv1beta1.TimeInterval {
+	out := monitoringv1beta1.TimeInterval{Name: in.Name}
+	l := migrationLocation{path: fmt.Sprintf("time_intervals[%q]", in.Name)}
+
+	for i, ti := range in.TimeIntervals {
+		var tp monitoringv1beta1.TimePeriod
+
+		for _, tr := range ti.Times {
+			tp.Times = append(tp.Times, monitoringv1beta1.TimeRange{
+				StartTime: monitoringv1beta1.Time(fmt.Sprintf("%02d:%02d", tr.StartMinute/60, tr.StartMinute%60)),
+				EndTime:   monitoringv1beta1.Time(fmt.Sprintf("%02d:%02d", tr.EndMinute/60, tr.EndMinute%60)),
+			})
+		}
+
+		for _, wd := range ti.Weekdays {
+			tp.Weekdays = append(tp.Weekdays, monitoringv1beta1.WeekdayRange(formatRange(wd.InclusiveRange, func(i int) string { return weekdayNames[i] })))
+		}
+
+		for _, dom := range ti.DaysOfMonth {
+			tp.DaysOfMonth = append(tp.DaysOfMonth, monitoringv1beta1.DayOfMonthRange{
+				Start: dom.Begin,
+				End:   dom.End,
+			})
+		}
+
+		for _, mr := range ti.Months {
+			tp.Months = append(tp.Months, monitoringv1beta1.MonthRange(formatRange(mr.InclusiveRange, func(i int) string { return monthNames[i] })))
+		}
+
+		for _, yr := range ti.Years {
+			tp.Years = append(tp.Years, monitoringv1beta1.YearRange(formatRange(yr.InclusiveRange, func(i int) string { return fmt.Sprint(i) })))
+		}
+
+		if ti.Location != nil && ti.Location.Location != nil && ti.Location.String() != "UTC" {
+			cm.warnf(l, fmt.Sprintf("time_intervals[%d].location", i), "not converted, the AlertmanagerConfig resource only supports UTC")
+		}
+
+		out.TimeIntervals = append(out.TimeIntervals, tp)
+	}
+
+	return out
+}
+
+func formatRange(r timeinterval.InclusiveRange, format func(int) string) string {
+	if r.Begin == r.End {
+		return format(r.Begin)
+	}
+
+	return format(r.Begin) + ":" + format(r.End)
+}
+
+func (cm *configMigrator) convertReceiver(in *receiver) monitoringv1beta1.Receiver {
+	l := migrationLocation{
+		path: fmt.Sprintf("receivers[%q]", in.Name),
+		key:  in.Name,
+	}
+
+	return monitoringv1beta1.Receiver{
+		Name:              in.Name,
+		OpsGenieConfigs:   convertIntegrations(l, "opsgenie", in.OpsgenieConfigs, cm.convertOpsgenieConfig),
+		PagerDutyConfigs:  convertIntegrations(l, "pagerduty", in.PagerdutyConfigs, cm.convertPagerdutyConfig),
+		DiscordConfigs:    convertIntegrations(l, "discord", in.DiscordConfigs, cm.convertDiscordConfig),
+		SlackConfigs:      convertIntegrations(l, "slack", in.SlackConfigs, cm.convertSlackConfig),
+		WebhookConfigs:    convertIntegrations(l, "webhook", in.WebhookConfigs, cm.convertWebhookConfig),
+		WeChatConfigs:     convertIntegrations(l, "wechat", in.WeChatConfigs, cm.convertWeChatConfig),
+		EmailConfigs:      convertIntegrations(l, "email", in.EmailConfigs, cm.convertEmailConfig),
+		VictorOpsConfigs:  convertIntegrations(l, "victorops", in.VictorOpsConfigs, cm.convertVictorOpsConfig),
+		PushoverConfigs:   convertIntegrations(l, "pushover", in.PushoverConfigs, cm.convertPushoverConfig),
+		SNSConfigs:        convertIntegrations(l, "sns", in.SNSConfigs, cm.convertSNSConfig),
+		TelegramConfigs:   convertIntegrations(l, "telegram", in.TelegramConfigs, cm.convertTelegramConfig),
+		WebexConfigs:      convertIntegrations(l, "webex", in.WebexConfigs, cm.convertWebexConfig),
+		MSTeamsConfigs:    convertIntegrations(l, "msteams", in.MSTeamsConfigs, cm.convertMSTeamsConfig),
+		MSTeamsV2Configs:  convertIntegrations(l, "msteamsv2", in.MSTeamsV2Configs, cm.convertMSTeamsV2Config),
+		RocketChatConfigs: convertIntegrations(l, "rocketchat", in.RocketChatConfigs, cm.convertRocketChatConfig),
+		JiraConfigs:       convertIntegrations(l, "jira", in.JiraConfigs, cm.convertJiraConfig),
+		MattermostConfigs: convertIntegrations(l, "mattermost", in.MattermostConfigs, cm.convertMattermostConfig),
+		IncidentioConfigs: convertIntegrations(l, "incidentio", in.IncidentioConfigs, cm.convertIncidentioConfig),
+	}
+}
+
+func convertIntegrations[T, U any](l migrationLocation, name string, in []*T, convert func(migrationLocation, *T) U) []U {
+	var out []U
+	for i, c := range in {
+		out = append(out, convert(l.child(fmt.Sprintf("%s_configs[%d]", name, i), fmt.Sprintf("%s-%d", name, i)), c))
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertWebhookConfig(l migrationLocation, in *webhookConfig) monitoringv1beta1.WebhookConfig {
+	cm.file(l, "url_file", in.URLFile)
+
+	out := monitoringv1beta1.WebhookConfig{
+		SendResolved: in.VSendResolved,
+		URLSecret:    cm.optionalV1beta1SecretKey(l, "url", in.URL),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+		MaxAlerts:    in.MaxAlerts,
+		Timeout:      durationOrNil(in.Timeout),
+	}
+
+	switch p := in.Payload.(type) {
+	case nil:
+	case string:
+		out.Payload = &p
+	default:
+		cm.warnf(l, "payload", "not converted, only string values are supported by the AlertmanagerConfig resource")
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertDiscordConfig(l migrationLocation, in *discordConfig) monitoringv1beta1.DiscordConfig {
+	return monitoringv1beta1.DiscordConfig{
+		SendResolved: in.VSendResolved,
+		APIURL:       *cm.secretKey(l, "apiURL", in.WebhookURL),
+		Title:        stringOrNil(in.Title),
+		Message:      stringOrNil(in.Message),
+		Content:      stringOrNil(in.Content),
+		Username:     stringOrNil(in.Username),
+		AvatarURL:    urlOrNil(in.AvatarURL),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+}
+
+func (cm *configMigrator) convertSlackConfig(l migrationLocation, in *slackConfig) monitoringv1beta1.SlackConfig {
+	cm.file(l, "api_url_file", in.APIURLFile)
+	cm.unsupported(l, "app_token", in.AppToken != "")
+	cm.unsupported(l, "app_token_file", in.AppTokenFile != "")
+	cm.unsupported(l, "app_url", in.AppURL != "")
+
+	out := monitoringv1beta1.SlackConfig{
+		SendResolved:  in.VSendResolved,
+		APIURL:        cm.optionalV1beta1SecretKey(l, "apiURL", in.APIURL),
+		Channel:       stringOrNil(in.Channel),
+		Username:      stringOrNil(in.Username),
+		Color:         stringOrNil(in.Color),
+		Title:         stringOrNil(in.Title),
+		TitleLink:     in.TitleLink,
+		Pretext:       stringOrNil(in.Pretext),
+		Text:          stringOrNil(in.Text),
+		ShortFields:   trueOrNil(in.ShortFields),
+		Footer:        stringOrNil(in.Footer),
+		Fallback:      stringOrNil(in.Fallback),
+		CallbackID:    stringOrNil(in.CallbackID),
+		IconEmoji:     stringOrNil(in.IconEmoji),
+		IconURL:       in.IconURL,
+		ImageURL:      in.ImageURL,
+		ThumbURL:      in.ThumbURL,
+		LinkNames:     trueOrNil(in.LinkNames),
+		MrkdwnIn:      in.MrkdwnIn,
+		HTTPConfig:    cm.convertHTTPConfig(l, in.HTTPConfig),
+		Timeout:       durationOrNil(in.Timeout),
+		MessageText:   stringOrNil(in.MessageText),
+		UpdateMessage: in.UpdateMessage,
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, monitoringv1beta1.SlackField{
+			Title: f.Title,
+			Value: f.Value,
+			Short: trueOrNil(f.Short),
+		})
+	}
+
+	for _, a := range in.Actions {
+		action := monitoringv1beta1.SlackAction{
+			Type:  a.Type,
+			Text:  a.Text,
+			URL:   a.URL,
+			Style: stringOrNil(a.Style),
+			Name:  stringOrNil(a.Name),
+			Value: stringOrNil(a.Value),
+		}
+
+		if a.ConfirmField != nil {
+			action.ConfirmField = &monitoringv1beta1.SlackConfirmationField{
+				Text:        a.ConfirmField.Text,
+				Title:       stringOrNil(a.ConfirmField.Title),
+				OkText:      stringOrNil(a.ConfirmField.OkText),
+				DismissText: stringOrNil(a.ConfirmField.DismissText),
+			}
+		}
+
+		out.Actions = append(out.Actions, action)
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertPagerdutyConfig(l migrationLocation, in *pagerdutyConfig) monitoringv1beta1.PagerDutyConfig {
+	cm.file(l, "service_key_file", in.ServiceKeyFile)
+	cm.file(l, "routing_key_file", in.RoutingKeyFile)
+
+	out := monitoringv1beta1.PagerDutyConfig{
+		SendResolved: in.VSendResolved,
+		RoutingKey:   cm.optionalV1beta1SecretKey(l, "routingKey", in.RoutingKey),
+		ServiceKey:   cm.optionalV1beta1SecretKey(l, "serviceKey", in.ServiceKey),
+		URL:          urlOrNil(in.URL),
+		Client:       stringOrNil(in.Client),
+		ClientURL:    stringOrNil(in.ClientURL),
+		Description:  stringOrNil(in.Description),
+		Severity:     stringOrNil(in.Severity),
+		Class:        stringOrNil(in.Class),
+		Group:        stringOrNil(in.Group),
+		Component:    stringOrNil(in.Component),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+		Source:       stringOrNil(in.Source),
+		Timeout:      durationOrNil(in.Timeout),
+	}
+
+	for _, k := range sortutil.SortedKeys(in.Details) {
+		switch v := in.Details[k].(type) {
+		case string, bool, int, int64, float64:
+			out.Details = append(out.Details, monitoringv1beta1.KeyValue{Key: k, Value: fmt.Sprint(v)})
+		default:
+			cm.warnf(l, "details."+k, "not converted, only scalar values are supported by the AlertmanagerConfig resource")
+		}
+	}
+
+	for _, i := range in.Images {
+		out.PagerDutyImageConfigs = append(out.PagerDutyImageConfigs, monitoringv1beta1.PagerDutyImageConfig{
+			Src:  stringOrNil(i.Src),
+			Href: stringOrNil(i.Href),
+			Alt:  stringOrNil(i.Alt),
+		})
+	}
+
+	for _, lnk := range in.Links {
+		out.PagerDutyLinkConfigs = append(out.PagerDutyLinkConfigs, monitoringv1beta1.PagerDutyLinkConfig{
+			Href: stringOrNil(lnk.Href),
+			Text: stringOrNil(lnk.Text),
+		})
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertOpsgenieConfig(l migrationLocation, in *opsgenieConfig) monitoringv1beta1.OpsGenieConfig {
+	cm.file(l, "api_key_file", in.APIKeyFile)
+	cm.unsupported(l, "update_alerts", in.UpdateAlerts != nil)
+
+	out := monitoringv1beta1.OpsGenieConfig{
+		SendResolved: in.VSendResolved,
+		APIKey:       cm.optionalV1beta1SecretKey(l, "apiKey", in.APIKey),
+		APIURL:       urlOrNil(in.APIURL),
+		Message:      stringOrNil(in.Message),
+		Description:  stringOrNil(in.Description),
+		Source:       stringOrNil(in.Source),
+		Tags:         stringOrNil(in.Tags),
+		Note:         stringOrNil(in.Note),
+		Priority:     stringOrNil(in.Priority),
+		Details:      convertKeyValues(in.Details),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+		Entity:       stringOrNil(in.Entity),
+		Actions:      stringOrNil(in.Actions),
+	}
+
+	for _, r := range in.Responders {
+		out.Responders = append(out.Responders, monitoringv1beta1.OpsGenieConfigResponder{
+			ID:       stringOrNil(r.ID),
+			Name:     stringOrNil(r.Name),
+			Username: stringOrNil(r.Username),
+			Type:     r.Type,
+		})
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertWeChatConfig(l migrationLocation, in *weChatConfig) monitoringv1beta1.WeChatConfig {
+	cm.file(l, "api_secret_file", in.APISecretFile)
+
+	return monitoringv1beta1.WeChatConfig{
+		SendResolved: in.VSendResolved,
+		APISecret:    cm.optionalV1beta1SecretKey(l, "apiSecret", in.APISecret),
+		APIURL:       urlOrNil(in.APIURL),
+		CorpID:       stringOrNil(in.CorpID),
+		AgentID:      stringOrNil(in.AgentID),
+		ToUser:       stringOrNil(in.ToUser),
+		ToParty:      stringOrNil(in.ToParty),
+		ToTag:        stringOrNil(in.ToTag),
+		Message:      stringOrNil(in.Message),
+		MessageType:  stringOrNil(in.MessageType),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+}
+
+func (cm *configMigrator) convertWebexConfig(l migrationLocation, in *webexConfig) monitoringv1beta1.WebexConfig {
+	return monitoringv1beta1.WebexConfig{
+		SendResolved: in.VSendResolved,
+		APIURL:       urlOrNil(in.APIURL),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+		Message:      stringOrNil(in.Message),
+		RoomID:       in.RoomID,
+	}
+}
+
+func (cm *configMigrator) convertEmailConfig(l migrationLocation, in *emailConfig) monitoringv1beta1.EmailConfig {
+	cm.file(l, "auth_password_file", in.AuthPasswordFile)
+	cm.file(l, "auth_secret_file", in.AuthSecretFile)
+
+	out := monitoringv1beta1.EmailConfig{
+		SendResolved:     in.VSendResolved,
+		To:               stringOrNil(in.To),
+		From:             stringOrNil(in.From),
+		Hello:            stringOrNil(in.Hello),
+		AuthUsername:     stringOrNil(in.AuthUsername),
+		AuthPassword:     cm.optionalV1beta1SecretKey(l, "authPassword", in.AuthPassword),
+		AuthSecret:       cm.optionalV1beta1SecretKey(l, "authSecret", in.AuthSecret),
+		AuthIdentity:     stringOrNil(in.AuthIdentity),
+		Headers:          convertKeyValues(in.Headers),
+		HTML:             in.HTML,
+		Text:             in.Text,
+		RequireTLS:       in.RequireTLS,
+		TLSConfig:        cm.convertTLSConfig(l.child("tls_config", "tlsConfig"), in.TLSConfig),
+		ForceImplicitTLS: in.ForceImplicitTLS,
+	}
+
+	if in.Smarthost.Host != "" {
+		out.Smarthost = ptr.To(net.JoinHostPort(in.Smarthost.Host, in.Smarthost.Port))
+	}
+
+	if t := in.Threading; t != nil && ptr.Deref(t.Enabled, false) {
+		out.Threading = &monitoringv1beta1.EmailThreadingConfig{
+			ThreadByDate: monitoringv1beta1.ThreadByDateTypeNone,
+		}
+		if t.ThreadByDate == "daily" {
+			out.Threading.ThreadByDate = monitoringv1beta1.ThreadByDateTypeDaily
+		}
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertVictorOpsConfig(l migrationLocation, in *victorOpsConfig) monitoringv1beta1.VictorOpsConfig {
+	cm.file(l, "api_key_file", in.APIKeyFile)
+
+	return monitoringv1beta1.VictorOpsConfig{
+		SendResolved:      in.VSendResolved,
+		APIKey:            cm.optionalV1beta1SecretKey(l, "apiKey", in.APIKey),
+		APIURL:            urlOrNil(in.APIURL),
+		RoutingKey:        in.RoutingKey,
+		MessageType:       stringOrNil(in.MessageType),
+		EntityDisplayName: stringOrNil(in.EntityDisplayName),
+		StateMessage:      stringOrNil(in.StateMessage),
+		MonitoringTool:    stringOrNil(in.MonitoringTool),
+		CustomFields:      convertKeyValues(in.CustomFields),
+		HTTPConfig:        cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+}
+
+func (cm *configMigrator) convertPushoverConfig(l migrationLocation, in *pushoverConfig) monitoringv1beta1.PushoverConfig {
+	out := monitoringv1beta1.PushoverConfig{
+		SendResolved: in.VSendResolved,
+		UserKey:      cm.optionalV1beta1SecretKey(l, "userKey", in.UserKey),
+		UserKeyFile:  stringOrNil(in.UserKeyFile),
+		Token:        cm.optionalV1beta1SecretKey(l, "token", in.Token),
+		TokenFile:    stringOrNil(in.TokenFile),
+		Title:        stringOrNil(in.Title),
+		Message:      stringOrNil(in.Message),
+		URL:          in.URL,
+		URLTitle:     stringOrNil(in.URLTitle),
+		Device:       stringOrNil(in.Device),
+		Sound:        stringOrNil(in.Sound),
+		Priority:     stringOrNil(in.Priority),
+		HTML:         in.HTML,
+		Monospace:    in.Monospace,
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+
+	if in.TTL != "" {
+		out.TTL = ptr.To(monitoringv1.Duration(in.TTL))
+	}
+
+	if in.Retry != nil {
+		out.Retry = ptr.To(in.Retry.String())
+	}
+
+	if in.Expire != nil {
+		out.Expire = ptr.To(in.Expire.String())
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertSNSConfig(l migrationLocation, in *snsConfig) monitoringv1beta1.SNSConfig {
+	out := monitoringv1beta1.SNSConfig{
+		SendResolved:     in.VSendResolved,
+		ApiURL:           stringOrNil(in.APIUrl),
+		TopicARN:         stringOrNil(in.TopicARN),
+		Subject:          stringOrNil(in.Subject),
+		PhoneNumber:      stringOrNil(in.PhoneNumber),
+		TargetARN:        stringOrNil(in.TargetARN),
+		Message:          stringOrNil(in.Message),
+		Attributes:       in.Attributes,
+		HTTPConfig:       cm.convertHTTPConfig(l, in.HTTPConfig),
+		UseAWSHTTPClient: trueOrNil(in.UseAWSHTTPClient),
+	}
+
+	if in.Sigv4 != (sigV4Config{}) {
+		sl := l.child("sigv4", "sigv4")
+		out.Sigv4 = &monitoringv1.Sigv4{
+			Region:     in.Sigv4.Region,
+			AccessKey:  cm.optionalSecretKey(sl, "accessKey", in.Sigv4.AccessKey),
+			SecretKey:  cm.optionalSecretKey(sl, "secretKey", in.Sigv4.SecretKey),
+			Profile:    in.Sigv4.Profile,
+			RoleArn:    in.Sigv4.RoleARN,
+			ExternalID: in.Sigv4.ExternalID,
+		}
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertTelegramConfig(l migrationLocation, in *telegramConfig) monitoringv1beta1.TelegramConfig {
+	cm.unsupported(l, "chat_id_file", in.ChatIDFile != "")
+
+	out := monitoringv1beta1.TelegramConfig{
+		SendResolved:         in.VSendResolved,
+		APIURL:               urlOrNil(in.APIUrl),
+		BotToken:             cm.optionalV1beta1SecretKey(l, "botToken", in.BotToken),
+		BotTokenFile:         stringOrNil(in.BotTokenFile),
+		ChatID:               in.ChatID,
+		Message:              in.Message,
+		DisableNotifications: trueOrNil(in.DisableNotifications),
+		ParseMode:            in.ParseMode,
+		HTTPConfig:           cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+
+	if in.MessageThreadID != 0 {
+		out.MessageThreadID = ptr.To(int64(in.MessageThreadID))
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertMSTeamsConfig(l migrationLocation, in *msTeamsConfig) monitoringv1beta1.MSTeamsConfig {
+	return monitoringv1beta1.MSTeamsConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   *cm.secretKey(l, "webhookURL", in.WebhookURL),
+		Title:        stringOrNil(in.Title),
+		Summary:      stringOrNil(in.Summary),
+		Text:         stringOrNil(in.Text),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+}
+
+func (cm *configMigrator) convertMSTeamsV2Config(l migrationLocation, in *msTeamsV2Config) monitoringv1beta1.MSTeamsV2Config {
+	cm.file(l, "webhook_url_file", in.WebhookURLFile)
+
+	return monitoringv1beta1.MSTeamsV2Config{
+		SendResolved: in.SendResolved,
+		WebhookURL:   cm.optionalSecretKey(l, "webhookURL", in.WebhookURL),
+		Title:        stringOrNil(in.Title),
+		Text:         stringOrNil(in.Text),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+}
+
+func (cm *configMigrator) convertJiraConfig(l migrationLocation, in *jiraConfig) monitoringv1beta1.JiraConfig {
+	out := monitoringv1beta1.JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            urlOrNil(in.APIURL),
+		APIType:           stringOrNil(in.APIType),
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           stringOrNil(in.Summary),
+		Description:       stringOrNil(in.Description),
+		Priority:          stringOrNil(in.Priority),
+		Labels:            in.Labels,
+		ReopenTransition:  stringOrNil(in.ReopenTransition),
+		ResolveTransition: stringOrNil(in.ResolveTransition),
+		WontFixResolution: stringOrNil(in.WontFixResolution),
+		HTTPConfig:        cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+
+	if in.ReopenDuration != 0 {
+		out.ReopenDuration = ptr.To(monitoringv1.Duration(in.ReopenDuration.String()))
+	}
+
+	for _, k := range sortutil.SortedKeys(in.Fields) {
+		b, err := json.Marshal(jsonCompatible(in.Fields[k]))
+		if err != nil {
+			cm.warnf(l, "fields."+k, "not converted: %v", err)
+			continue
+		}
+
+		out.Fields = append(out.Fields, monitoringv1beta1.JiraField{
+			Key:   k,
+			Value: apiextensionsv1.JSON{Raw: b},
+		})
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertRocketChatConfig(l migrationLocation, in *rocketChatConfig) monitoringv1beta1.RocketChatConfig {
+	cm.file(l, "token_file", in.TokenFile)
+	cm.file(l, "token_id_file", in.TokenIDFile)
+
+	out := monitoringv1beta1.RocketChatConfig{
+		SendResolved: in.SendResolved,
+		APIURL:       urlOrNil(in.APIURL),
+		Channel:      stringOrNil(in.Channel),
+		Color:        stringOrNil(in.Color),
+		Emoji:        stringOrNil(in.Emoji),
+		IconURL:      stringOrNil(in.IconURL),
+		Text:         stringOrNil(in.Text),
+		Title:        stringOrNil(in.Title),
+		TitleLink:    stringOrNil(in.TitleLink),
+		ShortFields:  trueOrNil(in.ShortFields),
+		ImageURL:     stringOrNil(in.ImageURL),
+		ThumbURL:     stringOrNil(in.ThumbURL),
+		LinkNames:    trueOrNil(in.LinkNames),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+
+	if in.Token != nil {
+		out.Token = *cm.secretKey(l, "token", *in.Token)
+	} else {
+		cm.warnf(l, "token", "missing, the AlertmanagerConfig resource requires the token to be defined for each receiver")
+	}
+
+	if in.TokenID != nil {
+		out.TokenID = *cm.secretKey(l, "tokenID", *in.TokenID)
+	} else {
+		cm.warnf(l, "token_id", "missing, the AlertmanagerConfig resource requires the token ID to be defined for each receiver")
+	}
+
+	for _, f := range in.Fields {
+		out.Fields = append(out.Fields, monitoringv1beta1.RocketChatFieldConfig{
+			Title: stringOrNil(f.Title),
+			Value: stringOrNil(f.Value),
+			Short: f.Short,
+		})
+	}
+
+	for i, a := range in.Actions {
+		field := fmt.Sprintf("actions[%d]", i)
+		cm.unsupported(l, field+".type", a.Type != "")
+		cm.unsupported(l, field+".image_url", a.ImageURL != "")
+		cm.unsupported(l, field+".is_webview", a.IsWebView)
+		cm.unsupported(l, field+".webview_height_ratio", a.WebviewHeightRatio != "")
+		cm.unsupported(l, field+".msg_in_chat_window", a.MsgInChatWindow)
+		cm.unsupported(l, field+".msg_processing_type", a.MsgProcessingType != "")
+
+		out.Actions = append(out.Actions, monitoringv1beta1.RocketChatActionConfig{
+			Text: stringOrNil(a.Text),
+			URL:  stringOrNil(a.URL),
+			Msg:  stringOrNil(a.Msg),
+		})
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertMattermostConfig(l migrationLocation, in *mattermostConfig) monitoringv1beta1.MattermostConfig {
+	cm.file(l, "webhook_url_file", in.WebhookURLFile)
+	for _, f := range []struct {
+		field string
+		set   bool
+	}{
+		{"fallback", in.Fallback != ""},
+		{"color", in.Color != ""},
+		{"pretext", in.Pretext != ""},
+		{"author_name", in.AuthorName != ""},
+		{"author_link", in.AuthorLink != ""},
+		{"author_icon", in.AuthorIcon != ""},
+		{"title", in.Title != ""},
+		{"title_link", in.TitleLink != ""},
+		{"fields", len(in.Fields) > 0},
+		{"thumb_url", in.ThumbURL != ""},
+		{"footer", in.Footer != ""},
+		{"footer_icon", in.FooterIcon != ""},
+		{"image_url", in.ImageURL != ""},
+	} {
+		if f.set {
+			cm.warnf(l, f.field, "not converted, use the attachments field instead")
+		}
+	}
+
+	out := monitoringv1beta1.MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   cm.optionalSecretKey(l, "webhookURL", in.WebhookURL),
+		Channel:      stringOrNil(in.Channel),
+		Username:     stringOrNil(in.Username),
+		Text:         stringOrNil(in.Text),
+		IconURL:      urlOrNil(in.IconURL),
+		IconEmoji:    stringOrNil(in.IconEmoji),
+		HTTPConfig:   cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+
+	for _, a := range in.Attachments {
+		attachment := monitoringv1beta1.MattermostAttachment{
+			Fallback:   stringOrNil(a.Fallback),
+			Color:      stringOrNil(a.Color),
+			Pretext:    stringOrNil(a.Pretext),
+			Text:       stringOrNil(a.Text),
+			AuthorName: stringOrNil(a.AuthorName),
+			AuthorLink: urlOrNil(a.AuthorLink),
+			AuthorIcon: urlOrNil(a.AuthorIcon),
+			Title:      stringOrNil(a.Title),
+			TitleLink:  urlOrNil(a.TitleLink),
+			ThumbURL:   urlOrNil(a.ThumbURL),
+			Footer:     stringOrNil(a.Footer),
+			FooterIcon: urlOrNil(a.FooterIcon),
+			ImageURL:   urlOrNil(a.ImageURL),
+		}
+
+		for _, f := range a.Fields {
+			attachment.Fields = append(attachment.Fields, monitoringv1beta1.MattermostField{
+				Title: f.Title,
+				Value: f.Value,
+				Short: trueOrNil(f.Short),
+			})
+		}
+
+		out.Attachments = append(out.Attachments, attachment)
+	}
+
+	if in.Props != nil {
+		out.Props = &monitoringv1beta1.MattermostProps{Card: in.Props.Card}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &monitoringv1beta1.MattermostPriority{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertIncidentioConfig(l migrationLocation, in *incidentioConfig) monitoringv1beta1.IncidentioConfig {
+	cm.file(l, "url_file", in.URLFile)
+	cm.file(l, "alert_source_token_file", in.AlertSourceTokenFile)
+
+	return monitoringv1beta1.IncidentioConfig{
+		SendResolved:     in.VSendResolved,
+		URL:              monitoringv1beta1.URL(in.URL),
+		AlertSourceToken: cm.optionalSecretKey(l, "alertSourceToken", in.AlertSourceToken),
+		MaxAlerts:        ptr.Deref(in.MaxAlerts, 0),
+		Timeout:          durationOrNil(in.Timeout),
+		HTTPConfig:       cm.convertHTTPConfig(l, in.HTTPConfig),
+	}
+}
+
+func (cm *configMigrator) convertHTTPConfig(l migrationLocation, in *httpClientConfig) *monitoringv1beta1.HTTPConfig {
+	if in == nil {
+		return nil
+	}
+
+	l = l.child("http_config", "httpConfig")
+	cm.file(l, "bearer_token_file", in.BearerTokenFile)
+	cm.unsupported(l, "http_headers", in.HTTPHeaders != nil)
+
+	out := &monitoringv1beta1.HTTPConfig{
+		BearerTokenSecret: cm.optionalV1beta1SecretKey(l, "bearerToken", in.BearerToken),
+		TLSConfig:         cm.convertTLSConfig(l.child("tls_config", "tlsConfig"), in.TLSConfig),
+		ProxyConfig:       cm.convertProxyConfig(l, in.proxyConfig),
+		FollowRedirects:   in.FollowRedirects,
+		EnableHTTP2:       in.EnableHTTP2,
+	}
+
+	if a := in.Authorization; a != nil {
+		cm.file(l, "authorization.credentials_file", a.CredentialsFile)
+		if a.Credentials != "" {
+			out.Authorization = &monitoringv1.SafeAuthorization{
+				Type:        a.Type,
+				Credentials: cm.secretKey(l, "authorization-credentials", a.Credentials),
+			}
+		}
+	}
+
+	if ba := in.BasicAuth; ba != nil {
+		cm.file(l, "basic_auth.password_file", ba.PasswordFile)
+		if ba.Username != "" || ba.Password != "" {
+			out.BasicAuth = &monitoringv1.BasicAuth{
+				Username: *cm.secretKey(l, "basicAuth-username", ba.Username),
+				Password: *cm.secretKey(l, "basicAuth-password", ba.Password),
+			}
+		}
+	}
+
+	if o := in.OAuth2; o != nil {
+		ol := l.child("oauth2", "oauth2")
+		cm.file(ol, "client_secret_file", o.ClientSecretFile)
+		out.OAuth2 = &monitoringv1.OAuth2{
+			ClientID:       monitoringv1.SecretOrConfigMap{Secret: cm.secretKey(ol, "clientID", o.ClientID)},
+			ClientSecret:   *cm.secretKey(ol, "clientSecret", o.ClientSecret),
+			TokenURL:       monitoringv1.URL(o.TokenURL),
+			Scopes:         o.Scopes,
+			EndpointParams: o.EndpointParams,
+			TLSConfig:      cm.convertTLSConfig(ol.child("tls_config", "tlsConfig"), o.TLSConfig),
+			ProxyConfig:    cm.convertProxyConfig(ol, o.proxyConfig),
+		}
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertTLSConfig(l migrationLocation, in *tlsConfig) *monitoringv1.SafeTLSConfig {
+	if in == nil {
+		return nil
+	}
+
+	cm.file(l, "ca_file", in.CAFile)
+	cm.file(l, "cert_file", in.CertFile)
+	cm.file(l, "key_file", in.KeyFile)
+
+	out := &monitoringv1.SafeTLSConfig{
+		ServerName:         stringOrNil(in.ServerName),
+		InsecureSkipVerify: trueOrNil(in.InsecureSkipVerify),
+	}
+
+	if in.MinVersion != "" {
+		out.MinVersion = ptr.To(monitoringv1.TLSVersion(in.MinVersion))
+	}
+
+	if in.MaxVersion != "" {
+		out.MaxVersion = ptr.To(monitoringv1.TLSVersion(in.MaxVersion))
+	}
+
+	return out
+}
+
+func (cm *configMigrator) convertProxyConfig(l migrationLocation, in proxyConfig) monitoringv1.ProxyConfig {
+	out := monitoringv1.ProxyConfig{
+		ProxyURL:             stringOrNil(in.ProxyURL),
+		NoProxy:              stringOrNil(in.NoProxy),
+		ProxyFromEnvironment: trueOrNil(in.ProxyFromEnvironment),
+	}
+
+	for _, k := range sortutil.SortedKeys(in.ProxyConnectHeader) {
+		if out.ProxyConnectHeader == nil {
+			out.ProxyConnectHeader = map[string][]corev1.SecretKeySelector{}
+		}
+
+		for i, v := range in.ProxyConnectHeader[k] {
+			out.ProxyConnectHeader[k] = append(out.ProxyConnectHeader[k], *cm.secretKey(l, fmt.Sprintf("proxyConnectHeader-%s-%d", k, i), v))
+		}
+	}
+
+	return out
+}
+
+func convertKeyValues(in map[string]string) []monitoringv1beta1.KeyValue {
+	var out []monitoringv1beta1.KeyValue
+	for _, k := range sortutil.SortedKeys(in) {
+		out = append(out, monitoringv1beta1.KeyValue{Key: k, Value: in[k]})
+	}
+
+	return out
+}
+
+// jsonCompatible converts the maps decoded from YAML into maps which can be
+// encoded to JSON.
+func jsonCompatible(v any) any {
+	switch v := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, vv := range v {
+			m[fmt.Sprint(k)] = jsonCompatible(vv)
+		}
+		return m
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, vv := range v {
+			m[k] = jsonCompatible(vv)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, vv := range v {
+			s[i] = jsonCompatible(vv)
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func urlOrNil(s string) *monitoringv1beta1.URL {
+	if s == "" {
+		return nil
+	}
+
+	return ptr.To(monitoringv1beta1.URL(s))
+}
+
+func trueOrNil(b bool) *bool {
+	if !b {
+		return nil
+	}
+
+	return &b
+}
+
+func durationOrNil(d *model.Duration) *monitoringv1.Duration {
+	if d == nil {
+		return nil
+	}
+
+	return ptr.To(monitoringv1.Duration(d.String()))
+}
+
+func nonEmptyDuration(s string) *monitoringv1.NonEmptyDuration {
+	if s == "" {
+		return nil
+	}
+
+	return ptr.To(monitoringv1.NonEmptyDuration(s))
+}
@@ -0,0 +1,469 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// TestMigrateConfigurationRoundTrip verifies that the configuration generated
+// from the migrated AlertmanagerConfig resources is equivalent to the
+// original configuration.
+func TestMigrateConfigurationRoundTrip(t *testing.T) {
+	const input = `
+route:
+  receiver: a-webhook
+  routes:
+  - receiver: a-webhook
+    matchers: ['severity="critical"', 'service=~"db.*"']
+    group_by: [alertname]
+    group_wait: 10s
+    mute_time_intervals: [weekends]
+    continue: true
+    routes:
+    - receiver: b-chat
+      matchers: ['team="db"']
+      continue: true
+  - receiver: b-chat
+    active_time_intervals: [office-hours]
+    continue: true
+  - receiver: c-paging
+    matchers: ['severity!="none"']
+    continue: true
+  - receiver: d-misc
+receivers:
+- name: a-webhook
+  webhook_configs:
+  - url: https://example.com/hook
+    send_resolved: true
+    max_alerts: 10
+    timeout: 30s
+    http_config:
+      basic_auth:
+        username: user
+        password: pass
+      follow_redirects: false
+  - url: https://example.com/other
+    http_config:
+      authorization:
+        type: Bearer
+        credentials: token
+      proxy_url: http://proxy:3128
+      proxy_connect_header:
+        X-Proxy: [secret]
+- name: b-chat
+  slack_configs:
+  - api_url: https://slack.example.com/hook
+    channel: '#alerts'
+    title: title
+    short_fields: true
+    fields:
+    - title: f
+      value: v
+      short: true
+    actions:
+    - type: button
+      text: click
+      url: https://example.com
+      confirm:
+        text: sure?
+  discord_configs:
+  - webhook_url: https://discord.example.com/hook
+    title: title
+  msteams_configs:
+  - webhook_url: https://teams.example.com/hook
+    summary: summary
+  msteamsv2_configs:
+  - webhook_url: https://teams.example.com/v2
+  webex_configs:
+  - api_url: https://webex.example.com
+    room_id: room
+    http_config:
+      authorization:
+        type: Bearer
+        credentials: webex-token
+  mattermost_configs:
+  - webhook_url: https://mattermost.example.com/hook
+    channel: alerts
+    attachments:
+    - title: title
+      fields:
+      - title: f
+        value: v
+  rocketchat_configs:
+  - api_url: https://rocketchat.example.com
+    token: token
+    token_id: token-id
+    channel: alerts
+  telegram_configs:
+  - bot_token: bot-token
+    chat_id: 123
+    message_thread_id: 4
+    parse_mode: HTML
+  wechat_configs:
+  - api_secret: wechat-secret
+    api_url: https://wechat.example.com/
+    corp_id: corp
+- name: c-paging
+  pagerduty_configs:
+  - routing_key: routing-key
+    url: https://pagerduty.example.com
+    severity: critical
+    details:
+      foo: bar
+    images:
+    - src: https://example.com/img.png
+      alt: img
+    links:
+    - href: https://example.com
+      text: link
+  opsgenie_configs:
+  - api_key: opsgenie-key
+    api_url: https://opsgenie.example.com/
+    details:
+      foo: bar
+    responders:
+    - name: team
+      type: team
+  victorops_configs:
+  - api_key: victorops-key
+    api_url: https://victorops.example.com/
+    routing_key: routing
+    custom_fields:
+      foo: bar
+  pushover_configs:
+  - user_key: user-key
+    token: pushover-token
+    retry: 1m
+    expire: 1h
+    ttl: 1h
+  incidentio_configs:
+  - url: https://incident.example.com
+    alert_source_token: incident-token
+    max_alerts: 5
+- name: d-misc
+  email_configs:
+  - to: team@example.com
+    from: alertmanager@example.com
+    smarthost: smtp.example.com:587
+    auth_username: user
+    auth_password: smtp-password
+    headers:
+      Subject: alert
+  sns_configs:
+  - topic_arn: arn:aws:sns:us-east-1:123456789012:topic
+    sigv4:
+      region: us-east-1
+      access_key: access
+      secret_key: secret
+  jira_configs:
+  - api_url: https://jira.example.com
+    project: OPS
+    issue_type: Bug
+    reopen_duration: 1h
+    fields:
+      customfield_1: value
+      customfield_2:
+        nested: [1, 2]
+mute_time_intervals:
+- name: weekends
+  time_intervals:
+  - weekdays: [saturday, sunday]
+time_intervals:
+- name: office-hours
+  time_intervals:
+  - times:
+    - start_time: "09:00"
+      end_time: "17:30"
+    weekdays: ["monday:friday"]
+    days_of_month: ["1:15"]
+    months: ["january:june"]
+    years: ["2025:2030"]
+`
+
+	res, err := MigrateConfiguration([]byte(input), MigrationOptions{Namespace: "monitoring"})
+	require.NoError(t, err)
+	require.Len(t, res.Configs, 4)
+
+	var secrets []any
+	amConfigs := map[string]*monitoringv1alpha1.AlertmanagerConfig{}
+	for _, mc := range res.Configs {
+		require.Empty(t, mc.Warnings)
+
+		if mc.Secret != nil {
+			s := mc.Secret.DeepCopy()
+			s.Data = map[string][]byte{}
+			for k, v := range s.StringData {
+				s.Data[k] = []byte(v)
+			}
+			secrets = append(secrets, s)
+		}
+
+		amConfig := &monitoringv1alpha1.AlertmanagerConfig{}
+		require.NoError(t, mc.AlertmanagerConfig.ConvertTo(amConfig))
+		amConfigs[amConfig.Namespace+"/"+amConfig.Name] = amConfig
+	}
+
+	version, err := semver.ParseTolerant(operator.DefaultAlertmanagerVersion)
+	require.NoError(t, err)
+
+	cb := NewConfigBuilder(newNopLogger(t), version, assets.NewTestStoreBuilder(secrets...), &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "monitoring"},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigMatcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: monitoringv1.NoneConfigMatcherStrategyType,
+			},
+		},
+	})
+	require.NoError(t, cb.InitializeFromRawConfiguration([]byte(`
+route:
+  receiver: "null"
+receivers:
+- name: "null"
+`)))
+	require.NoError(t, cb.AddAlertmanagerConfigs(context.Background(), amConfigs))
+
+	expected, err := alertmanagerConfigFromBytes([]byte(input))
+	require.NoError(t, err)
+
+	// The operator enforces "continue: true" for the top-level routes.
+	for _, r := range expected.Route.Routes {
+		r.Continue = true
+	}
+
+	// Remove the "<namespace>/<name>/" prefix added by the operator.
+	unprefix := func(s string) string {
+		return s[strings.LastIndex(s, "/")+1:]
+	}
+
+	var receivers []*receiver
+	for _, r := range cb.cfg.Receivers {
+		r.Name = unprefix(r.Name)
+		if r.Name != "null" && !slices.ContainsFunc(receivers, func(e *receiver) bool { return e.Name == r.Name }) {
+			receivers = append(receivers, r)
+		}
+	}
+	slices.SortFunc(receivers, func(a, b *receiver) int { return strings.Compare(a.Name, b.Name) })
+	requireYAMLEqual(t, expected.Receivers, receivers)
+
+	for _, r := range cb.cfg.Route.Routes {
+		walkRoutes(r, func(r *route) {
+			r.Receiver = unprefix(r.Receiver)
+			for i := range r.MuteTimeIntervals {
+				r.MuteTimeIntervals[i] = unprefix(r.MuteTimeIntervals[i])
+			}
+			for i := range r.ActiveTimeIntervals {
+				r.ActiveTimeIntervals[i] = unprefix(r.ActiveTimeIntervals[i])
+			}
+		})
+	}
+	requireYAMLEqual(t, expected.Route.Routes, cb.cfg.Route.Routes)
+
+	var timeIntervals []*timeInterval
+	for _, ti := range cb.cfg.MuteTimeIntervals {
+		ti.Name = unprefix(ti.Name)
+		if !slices.ContainsFunc(timeIntervals, func(e *timeInterval) bool { return e.Name == ti.Name }) {
+			timeIntervals = append(timeIntervals, ti)
+		}
+	}
+	slices.SortFunc(timeIntervals, func(a, b *timeInterval) int { return strings.Compare(b.Name, a.Name) })
+	requireYAMLEqual(t, append(expected.MuteTimeIntervals, expected.TimeIntervals...), timeIntervals)
+}
+
+func requireYAMLEqual(t *testing.T, expected, actual any) {
+	t.Helper()
+
+	e, err := yaml.Marshal(expected)
+	require.NoError(t, err)
+
+	a, err := yaml.Marshal(actual)
+	require.NoError(t, err)
+
+	require.Equal(t, string(e), string(a))
+}
+
+func TestMigrateConfigurationSplitByLabel(t *testing.T) {
+	const input = `
+global:
+  resolve_timeout: 5m
+route:
+  receiver: default
+  group_by: [alertname]
+  routes:
+  - receiver: team-a
+    match:
+      team: a
+    continue: true
+  - receiver: team-a-critical
+    matchers: ['team="a"', 'severity="critical"']
+  - matchers: ['team="b"']
+  - receiver: default
+    matchers: ['team=~"c|d"']
+receivers:
+- name: default
+- name: team-a
+- name: team-a-critical
+- name: unused
+inhibit_rules:
+- source_matchers: ['team="a"', 'severity="critical"']
+  target_matchers: ['team="a"', 'severity="warning"']
+  equal: [alertname]
+- source_matchers: ['severity="critical"']
+  target_matchers: ['severity="warning"']
+`
+
+	res, err := MigrateConfiguration([]byte(input), MigrationOptions{Namespace: "monitoring", SplitByLabel: "team"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"global: not converted, configure the global settings in the Alertmanager resource (spec.alertmanagerConfiguration.global)",
+		`route.routes[3]: not converted, no equality matcher on label "team"`,
+		"inhibit_rules[1]: not converted, the rule can't be assigned to a single AlertmanagerConfig resource",
+		`receivers["unused"]: not converted, the receiver isn't referenced by a top-level route`,
+		`the operator adds a namespace="monitoring" matcher to the routes and inhibition rules of AlertmanagerConfig resources, set spec.alertmanagerConfigMatcherStrategy.type to "None" in the Alertmanager resource to match all alerts`,
+	}, res.Warnings)
+
+	require.Equal(t, []MigratedConfig{
+		{
+			AlertmanagerConfig: migratedAlertmanagerConfig("a", monitoringv1beta1.AlertmanagerConfigSpec{
+				Route: &monitoringv1beta1.Route{
+					Receiver: "null",
+					GroupBy:  []string{"alertname"},
+					Matchers: []monitoringv1beta1.Matcher{{Name: "team", Value: "a", MatchType: monitoringv1beta1.MatchEqual}},
+					Routes: []apiextensionsv1.JSON{
+						{Raw: []byte(`{"receiver":"team-a","matchers":[{"name":"team","value":"a","matchType":"="}],"continue":true}`)},
+						{Raw: []byte(`{"receiver":"team-a-critical","matchers":[{"name":"team","value":"a","matchType":"="},{"name":"severity","value":"critical","matchType":"="}]}`)},
+					},
+				},
+				Receivers: []monitoringv1beta1.Receiver{{Name: "null"}, {Name: "team-a"}, {Name: "team-a-critical"}},
+				InhibitRules: []monitoringv1beta1.InhibitRule{{
+					SourceMatch: []monitoringv1beta1.Matcher{{Name: "team", Value: "a", MatchType: monitoringv1beta1.MatchEqual}, {Name: "severity", Value: "critical", MatchType: monitoringv1beta1.MatchEqual}},
+					TargetMatch: []monitoringv1beta1.Matcher{{Name: "team", Value: "a", MatchType: monitoringv1beta1.MatchEqual}, {Name: "severity", Value: "warning", MatchType: monitoringv1beta1.MatchEqual}},
+					Equal:       []string{"alertname"},
+				}},
+			}),
+			Warnings: []string{
+				"route.routes[1]: the operator enforces 'continue: true' for the top-level route, alerts will also be evaluated by the next routes",
+			},
+		},
+		{
+			AlertmanagerConfig: migratedAlertmanagerConfig("b", monitoringv1beta1.AlertmanagerConfigSpec{
+				Route: &monitoringv1beta1.Route{
+					Receiver: "default",
+					GroupBy:  []string{"alertname"},
+					Matchers: []monitoringv1beta1.Matcher{{Name: "team", Value: "b", MatchType: monitoringv1beta1.MatchEqual}},
+				},
+				Receivers: []monitoringv1beta1.Receiver{{Name: "default"}},
+			}),
+			Warnings: []string{
+				"route.routes[2]: the operator enforces 'continue: true' for the top-level route, alerts will also be evaluated by the next routes",
+			},
+		},
+	}, res.Configs)
+}
+
+func TestMigrateConfigurationWarnings(t *testing.T) {
+	const input = `
+templates: [/etc/alertmanager/*.tmpl]
+route:
+  receiver: team/one
+  routes:
+  - receiver: team/one
+    continue: true
+  - receiver: team/one
+receivers:
+- name: team/one
+  slack_configs:
+  - api_url_file: /etc/slack/url
+    app_url: https://slack.example.com/api
+    http_config:
+      bearer_token: token
+      tls_config:
+        ca_file: /etc/ca.pem
+        insecure_skip_verify: true
+  opsgenie_configs:
+  - api_key: key
+    update_alerts: true
+  webhook_configs:
+  - url: https://example.com
+    http_config:
+      http_headers:
+        X-Foo:
+          values: [bar]
+`
+
+	res, err := MigrateConfiguration([]byte(input), MigrationOptions{Namespace: "monitoring"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"templates: not converted, store the template files in ConfigMaps or Secrets and reference them in spec.templates",
+		`the operator adds a namespace="monitoring" matcher to the routes and inhibition rules of AlertmanagerConfig resources, set spec.alertmanagerConfigMatcherStrategy.type to "None" in the Alertmanager resource to match all alerts`,
+	}, res.Warnings)
+
+	require.Len(t, res.Configs, 2)
+	require.Equal(t, "team-one", res.Configs[0].AlertmanagerConfig.Name)
+	require.Equal(t, "team-one-2", res.Configs[1].AlertmanagerConfig.Name)
+	require.Equal(t, res.Configs[0].Warnings, res.Configs[1].Warnings)
+	require.Equal(t, []string{
+		`receivers["team/one"].opsgenie_configs[0].update_alerts: not converted, the field isn't supported by the AlertmanagerConfig resource`,
+		`receivers["team/one"].slack_configs[0].api_url_file: not converted, the file should be mounted in the Alertmanager pods or its content stored in a Secret`,
+		`receivers["team/one"].slack_configs[0].app_url: not converted, the field isn't supported by the AlertmanagerConfig resource`,
+		`receivers["team/one"].slack_configs[0].http_config.tls_config.ca_file: not converted, the file should be mounted in the Alertmanager pods or its content stored in a Secret`,
+		`receivers["team/one"].webhook_configs[0].http_config.http_headers: not converted, the field isn't supported by the AlertmanagerConfig resource`,
+	}, res.Configs[0].Warnings)
+
+	require.Equal(t, &corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "team-one-credentials", Namespace: "monitoring"},
+		Type:       corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			"team-one-opsgenie-0-apiKey":              "key",
+			"team-one-slack-0-httpConfig-bearerToken": "token",
+			"team-one-webhook-0-url":                  "https://example.com",
+		},
+	}, res.Configs[0].Secret)
+
+	slack := res.Configs[0].AlertmanagerConfig.Spec.Receivers[0].SlackConfigs[0]
+	require.Nil(t, slack.APIURL)
+	require.Equal(t, &monitoringv1beta1.SecretKeySelector{Name: "team-one-credentials", Key: "team-one-slack-0-httpConfig-bearerToken"}, slack.HTTPConfig.BearerTokenSecret)
+	require.Equal(t, &monitoringv1.SafeTLSConfig{InsecureSkipVerify: new(true)}, slack.HTTPConfig.TLSConfig)
+}
+
+func migratedAlertmanagerConfig(name string, spec monitoringv1beta1.AlertmanagerConfigSpec) *monitoringv1beta1.AlertmanagerConfig {
+	return &monitoringv1beta1.AlertmanagerConfig{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       monitoringv1beta1.AlertmanagerConfigKind,
+			APIVersion: monitoringv1beta1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring"},
+		Spec:       spec,
+	}
+}
@@ -16,6 +16,7 @@ package alertmanager
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -31,14 +32,19 @@ import (
 	"github.com/prometheus/common/model"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	typedauthv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/clustertlsconfig"
@@ -81,12 +87,14 @@ type Config struct {
 // configurations.
 type Operator struct {
 	kclient    kubernetes.Interface
+	dclient    dynamic.Interface
 	mdClient   metadata.Interface
 	mclient    monitoringclient.Interface
 	ssarClient typedauthv1.SelfSubjectAccessReviewInterface
 
-	controllerID string
-	repairPolicy operator.RepairPolicy
+	controllerID  string
+	leaderElected <-chan struct{}
+	repairPolicy  operator.RepairPolicy
 
 	logger   *slog.Logger
 	accessor *operator.Accessor
@@ -99,19 +107,33 @@ type Operator struct {
 	cmapInfs    *informers.ForResource
 	secrInfs    *informers.ForResource
 	ssetInfs    *informers.ForResource
+	pdbInfs     *informers.ForResource
+
+	exposureSyncer *operator.ExposureSyncer
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
+	debugStore      *operator.DebugStore
 
 	newEventRecorder operator.NewEventRecorderFunc
 
-	canReadStorageClass bool
+	canReadStorageClass          bool
+	volumeExpansionEnabled       bool
+	podDisruptionBudgetSupported bool
+	ingressExposureSupported     bool
+	httpRouteExposureSupported   bool
 
 	config Config
 
 	configResourcesStatusEnabled bool
+
+	finalizerSyncer *operator.FinalizerSyncer
+
+	silenceInfs    *informers.ForResource
+	silenceQ       workqueue.TypedRateLimitingInterface[string]
+	silencesClient silencesClient
 }
 
 type ControllerOption func(*Operator)
@@ -124,6 +146,38 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithVolumeExpansion tells that the controller should expand the persistent
+// volume claims when the storage size increases.
+func WithVolumeExpansion() ControllerOption {
+	return func(o *Operator) {
+		o.volumeExpansionEnabled = true
+	}
+}
+
+// WithPodDisruptionBudget tells that the controller can manage
+// PodDisruptionBudget objects.
+func WithPodDisruptionBudget() ControllerOption {
+	return func(o *Operator) {
+		o.podDisruptionBudgetSupported = true
+	}
+}
+
+// WithIngressExposure tells that the controller can manage Ingress objects
+// exposing the Alertmanager pods.
+func WithIngressExposure() ControllerOption {
+	return func(o *Operator) {
+		o.ingressExposureSupported = true
+	}
+}
+
+// WithHTTPRouteExposure tells that the controller can manage Gateway API
+// HTTPRoute objects exposing the Alertmanager pods.
+func WithHTTPRouteExposure() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteExposureSupported = true
+	}
+}
+
 // WithConfigResourceStatus tells that the controller can manage the status of
 // configuration resources.
 func WithConfigResourceStatus() ControllerOption {
@@ -132,6 +186,25 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithDebugStore tells that the controller should record the debug
+// information of the last reconciliation of the Alertmanager objects in the
+// store. Without a store, the controller doesn't compute it.
+func WithDebugStore(ds *operator.DebugStore) ControllerOption {
+	return func(o *Operator) {
+		o.debugStore = ds
+	}
+}
+
+// WithSilence tells that the controller should reconcile Silence objects.
+func WithSilence() ControllerOption {
+	return func(o *Operator) {
+		o.silenceQ = workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "silence"},
+		)
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -141,6 +214,11 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
 	mdClient, err := metadata.NewForConfig(restConfig)
 	if err != nil {
 		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
@@ -156,6 +234,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 
 	o := &Operator{
 		kclient:    client,
+		dclient:    dclient,
 		mdClient:   mdClient,
 		mclient:    mclient,
 		ssarClient: client.AuthorizationV1().SelfSubjectAccessReviews(),
@@ -167,23 +246,25 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		reconciliations:  &operator.ReconciliationTracker{},
 		newEventRecorder: c.EventRecorderFactory(client, controllerName),
 
-		controllerID: c.ControllerID,
-		repairPolicy: c.RepairPolicy,
-
-		config: Config{
-			LocalHost:                      c.LocalHost,
-			ClusterDomain:                  c.ClusterDomain,
-			ReloaderConfig:                 c.ReloaderConfig,
-			AlertmanagerDefaultBaseImage:   c.AlertmanagerDefaultBaseImage,
-			Annotations:                    c.Annotations,
-			Labels:                         c.Labels,
-			WatchObjectRefsInAllNamespaces: c.WatchObjectRefsInAllNamespaces,
-		},
+		controllerID:  c.ControllerID,
+		leaderElected: c.LeaderElected,
+		repairPolicy:  c.RepairPolicy,
+
+		config:          newConfig(c),
+		finalizerSyncer: operator.NewNoopFinalizerSyncer(),
 	}
 	for _, opt := range options {
 		opt(o)
 	}
 
+	if o.configResourcesStatusEnabled {
+		o.finalizerSyncer = operator.NewFinalizerSyncer(mdClient, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName))
+	}
+
+	if o.silenceQ != nil {
+		o.silencesClient = &podProxySilencesClient{kclient: client}
+	}
+
 	if err := o.bootstrap(ctx, c); err != nil {
 		return nil, err
 	}
@@ -201,6 +282,18 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	return o, nil
 }
 
+func newConfig(c operator.Config) Config {
+	return Config{
+		LocalHost:                      c.LocalHost,
+		ClusterDomain:                  c.ClusterDomain,
+		ReloaderConfig:                 c.ReloaderConfig,
+		AlertmanagerDefaultBaseImage:   c.AlertmanagerDefaultBaseImage,
+		Annotations:                    c.Annotations,
+		Labels:                         c.Labels,
+		WatchObjectRefsInAllNamespaces: c.WatchObjectRefsInAllNamespaces,
+	}
+}
+
 func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error {
 	c.metrics.MustRegister(c.reconciliations)
 
@@ -241,6 +334,22 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return fmt.Errorf("error creating alertmanagerconfig informers: %w", err)
 	}
 
+	if c.silenceQ != nil {
+		c.silenceInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				config.Namespaces.AlertmanagerConfigAllowList,
+				config.Namespaces.DenyList,
+				c.mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.SilenceName),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating silence informers: %w", err)
+		}
+	}
+
 	allowList := config.Namespaces.AlertmanagerConfigAllowList
 	if c.config.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(
@@ -301,6 +410,39 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 		return fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
+	if c.podDisruptionBudgetSupported {
+		c.pdbInfs, err = informers.NewInformersForResourceWithTransform(
+			informers.NewMetadataInformerFactory(
+				config.Namespaces.AlertmanagerAllowList,
+				config.Namespaces.DenyList,
+				c.mdClient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = labelSelectorForStatefulSets()
+				},
+			),
+			policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"),
+			informers.OwnedObjectMetadataStrip(operator.PodDisruptionBudgetGVK()),
+		)
+		if err != nil {
+			return fmt.Errorf("error creating poddisruptionbudget informers: %w", err)
+		}
+	}
+
+	c.exposureSyncer, err = operator.NewExposureSyncer(
+		c.kclient,
+		c.dclient,
+		c.mdClient,
+		config.Namespaces.AlertmanagerAllowList,
+		config.Namespaces.DenyList,
+		resyncPeriod,
+		c.ingressExposureSupported,
+		c.httpRouteExposureSupported,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating exposure syncer: %w", err)
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -342,16 +484,29 @@ func (c *Operator) bootstrap(ctx context.Context, config operator.Config) error
 
 // waitForCacheSync waits for the informers' caches to be synced.
 func (c *Operator) waitForCacheSync(ctx context.Context) error {
-	for _, infs := range []struct {
+	type namedInformers struct {
 		name                 string
 		informersForResource *informers.ForResource
-	}{
+	}
+
+	infsList := []namedInformers{
 		{"Alertmanager", c.alrtInfs},
 		{"AlertmanagerConfig", c.alrtCfgInfs},
 		{"Secret", c.secrInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"StatefulSet", c.ssetInfs},
-	} {
+	}
+	if c.silenceInfs != nil {
+		infsList = append(infsList, namedInformers{"Silence", c.silenceInfs})
+	}
+	if c.pdbInfs != nil {
+		infsList = append(infsList, namedInformers{"PodDisruptionBudget", c.pdbInfs})
+	}
+	for name, infs := range c.exposureSyncer.Informers() {
+		infsList = append(infsList, namedInformers{name, infs})
+	}
+
+	for _, infs := range infsList {
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "alertmanager", c.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -434,6 +589,10 @@ func (c *Operator) addHandlers() {
 	_, _ = c.nsAlrtCfgInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: c.handleNamespaceUpdate,
 	})
+
+	if c.silenceInfs != nil {
+		c.addSilenceHandlers()
+	}
 }
 
 func (c *Operator) enqueueForNamespaceFunc(gbk operator.GetByKeyer) func(string) {
@@ -501,6 +660,13 @@ func (c *Operator) Run(ctx context.Context) error {
 	go c.secrInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
+	if c.pdbInfs != nil {
+		go c.pdbInfs.Start(ctx.Done())
+	}
+	c.exposureSyncer.Start(ctx.Done())
+	if c.silenceInfs != nil {
+		go c.silenceInfs.Start(ctx.Done())
+	}
 	go c.nsAlrtCfgInf.Run(ctx.Done())
 	if c.nsAlrtInf != c.nsAlrtCfgInf {
 		go c.nsAlrtInf.Run(ctx.Done())
@@ -509,6 +675,13 @@ func (c *Operator) Run(ctx context.Context) error {
 	if err := c.waitForCacheSync(ctx); err != nil {
 		return err
 	}
+	c.metrics.Ready().Set(1)
+
+	// Wait for the leadership before reconciling the objects. The informers
+	// keep running in the meantime so that the caches are warm on failover.
+	if !operator.WaitForLeadership(ctx, c.leaderElected) {
+		return nil
+	}
 
 	// Refresh the status of the existing Alertmanager objects.
 	_ = c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
@@ -520,7 +693,10 @@ func (c *Operator) Run(ctx context.Context) error {
 	// TODO(simonpasquier): watch for Alertmanager pods instead of polling.
 	go operator.StatusPoller(ctx, c)
 
-	c.metrics.Ready().Set(1)
+	if c.silenceInfs != nil {
+		go c.runSilenceWorker(ctx)
+	}
+
 	<-ctx.Done()
 	return nil
 }
@@ -589,83 +765,137 @@ func (c *Operator) handleNamespaceUpdate(oldo, curo any) {
 // Sync implements the operator.Syncer interface.
 func (c *Operator) Sync(ctx context.Context, key string) error {
 	c.reconciliations.ResetStatus(key)
-	err := c.sync(ctx, key)
+
+	closure, err := c.sync(ctx, key)
+	if err != nil {
+		_ = closure(ctx)
+	} else {
+		err = closure(ctx)
+	}
+
 	c.reconciliations.SetStatus(key, err)
 
 	return err
 }
 
-func (c *Operator) sync(ctx context.Context, key string) error {
+func (c *Operator) sync(ctx context.Context, key string) (func(context.Context) error, error) {
+	closure := func(context.Context) error { return nil }
+
 	am, err := operator.GetObjectFromKey[*monitoringv1.Alertmanager](c.alrtInfs, key)
 	if err != nil {
-		return err
+		return closure, err
 	}
 
 	if am == nil {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
-		return nil
+		return closure, nil
+	}
+
+	logger := c.logger.With("key", key)
+	logger.Info("sync alertmanager")
+
+	finalizerAdded, err := c.finalizerSyncer.Sync(ctx, am, c.rr.DeletionInProgress(am), func() error {
+		return c.configResStatusCleanup(ctx, am)
+	})
+	if err != nil {
+		return closure, err
+	}
+
+	if finalizerAdded {
+		// Since the finalizer has been added to the object, let's trigger another sync.
+		c.rr.EnqueueForReconciliation(am)
+		return closure, nil
 	}
 
 	// Check if the Alertmanager instance is marked for deletion.
 	if c.rr.DeletionInProgress(am) {
 		c.reconciliations.ForgetObject(key)
-		return nil
+		c.debugStore.ForgetObject(key)
+		return closure, nil
 	}
 
-	logger := c.logger.With("key", key)
-	logger.Info("sync alertmanager")
-
 	if am.Spec.Paused {
 		logger.Info("no action taken (the resource is paused)")
-		return nil
+		return closure, nil
 	}
 
 	c.recordDeprecatedFields(key, logger, am)
 
 	if err := operator.CheckStorageClass(ctx, c.canReadStorageClass, c.kclient, am.Spec.Storage); err != nil {
-		return err
+		return closure, err
+	}
+
+	if am.Spec.PodDisruptionBudget != nil && !c.podDisruptionBudgetSupported {
+		return closure, errors.New("podDisruptionBudget: the operator isn't allowed to manage PodDisruptionBudget objects")
+	}
+
+	if err := c.exposureSyncer.Check(am.Spec.Exposure); err != nil {
+		return closure, err
+	}
+
+	if am.Spec.Exposure != nil && ptr.Deref(am.Spec.Exposure.HostnamePolicy, monitoringv1.SharedExposureHostnamePolicy) == monitoringv1.PerShardExposureHostnamePolicy {
+		return closure, fmt.Errorf("exposure: the %s hostname policy isn't supported", monitoringv1.PerShardExposureHostnamePolicy)
 	}
 
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
 
-	if err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore); err != nil {
-		return fmt.Errorf("provision alertmanager configuration: %w", err)
+	var debugInfo *operator.WorkloadDebugInfo
+	if c.debugStore != nil {
+		debugInfo = &operator.WorkloadDebugInfo{LastReconciliation: time.Now()}
+		// Record the debug information even if the reconciliation fails.
+		defer func() {
+			c.debugStore.Set(key, *debugInfo)
+		}()
+	}
+
+	amConfigs, err := c.provisionAlertmanagerConfiguration(ctx, am, assetStore, debugInfo)
+	if amConfigs != nil {
+		// Returns updateConfigResourcesStatus as the closure
+		// so that we can call it at the end of each sync.
+		closure = func(ctx context.Context) error {
+			return c.updateConfigResourcesStatus(ctx, am, amConfigs)
+		}
+	}
+
+	if err != nil {
+		return closure, fmt.Errorf("provision alertmanager configuration: %w", err)
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
 
 	tlsShardedSecret, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, c.newTLSAssetSecret(am))
 	if err != nil {
-		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+		return closure, fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
 
 	if err := c.createOrUpdateWebConfigSecret(ctx, am); err != nil {
-		return fmt.Errorf("failed to synchronize the web config secret: %w", err)
+		return closure, fmt.Errorf("failed to synchronize the web config secret: %w", err)
 	}
 
 	// TODO(simonpasquier): the operator should take into account changes to
 	// the cluster TLS configuration to trigger a rollout of the pods (this
 	// configuration doesn't support live reload).
 	if err := c.createOrUpdateClusterTLSConfigSecret(ctx, am); err != nil {
-		return fmt.Errorf("failed to synchronize the cluster TLS config secret: %w", err)
+		return closure, fmt.Errorf("failed to synchronize the cluster TLS config secret: %w", err)
 	}
 
 	svcClient := c.kclient.CoreV1().Services(am.Namespace)
 	if am.Spec.ServiceName != nil {
 		selectorLabels := makeSelectorLabels(am.Name)
 		if err := k8s.EnsureCustomGoverningService(ctx, am.Namespace, *am.Spec.ServiceName, svcClient, selectorLabels); err != nil {
-			return err
+			return closure, err
 		}
 	} else {
 		// Create governing service if it doesn't exist.
 		if _, err = k8s.CreateOrUpdateService(ctx, svcClient, makeStatefulSetService(am, c.config)); err != nil {
-			return fmt.Errorf("synchronizing governing service failed: %w", err)
+			return closure, fmt.Errorf("synchronizing governing service failed: %w", err)
 		}
 	}
 
 	existingStatefulSet, err := c.getStatefulSetFromAlertmanagerKey(key)
 	if err != nil {
-		return err
+		return closure, err
 	}
 
 	shouldCreate := false
@@ -675,23 +905,35 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 	}
 
 	if c.rr.DeletionInProgress(existingStatefulSet) {
-		return nil
+		return closure, nil
 	}
 
 	newSSetInputHash, err := createSSetInputHash(*am, c.config, tlsShardedSecret, existingStatefulSet.Spec)
 	if err != nil {
-		return err
+		return closure, err
 	}
 
 	sset, err := makeStatefulSet(logger, am, c.config, newSSetInputHash, tlsShardedSecret)
 	if err != nil {
-		return fmt.Errorf("failed to generate statefulset: %w", err)
+		return closure, fmt.Errorf("failed to generate statefulset: %w", err)
 	}
 	operator.SanitizeSTS(sset)
 
+	if err := c.syncPodDisruptionBudget(ctx, am, sset); err != nil {
+		return closure, err
+	}
+
+	if err := c.exposureSyncer.Sync(ctx, makeExposedWorkload(am, sset), am.Spec.Exposure,
+		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(c.config.Labels),
+		operator.WithOwner(am),
+	); err != nil {
+		return closure, err
+	}
+
 	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
 		logger.Debug("new statefulset generation inputs match current, skipping any actions")
-		return nil
+		return closure, nil
 	}
 
 	ssetClient := c.kclient.AppsV1().StatefulSets(am.Namespace)
@@ -699,21 +941,131 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		logger.Debug("no current statefulset found")
 		logger.Debug("creating statefulset")
 		if _, err := k8s.CreateStatefulSetOrPatchLabels(ctx, ssetClient, sset); err != nil {
-			return fmt.Errorf("failed to create statefulset: %w", err)
+			return closure, fmt.Errorf("failed to create statefulset: %w", err)
+		}
+		return closure, nil
+	}
+
+	if c.volumeExpansionEnabled {
+		expanded, err := operator.ExpandStatefulSetVolumes(ctx, c.kclient, existingStatefulSet, sset)
+		if err != nil {
+			return closure, err
+		}
+
+		if expanded {
+			c.metrics.StsDeleteCreateCounter().Inc()
+			logger.Info("recreating StatefulSet because the persistent volume claims have been expanded")
+			return closure, nil
 		}
-		return nil
 	}
 
 	if err = k8s.ForceUpdateStatefulSet(ctx, ssetClient, sset, func(reason string) {
 		c.metrics.StsDeleteCreateCounter().Inc()
 		logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", reason)
 	}); err != nil {
+		return closure, err
+	}
+
+	return closure, nil
+}
+
+// updateConfigResourcesStatus updates the status of the selected configuration
+// resources (AlertmanagerConfig).
+func (c *Operator) updateConfigResourcesStatus(ctx context.Context, am *monitoringv1.Alertmanager, amConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	var configResourceSyncer = operator.NewConfigResourceSyncer(am, c.dclient, c.accessor)
+
+	for key, configResource := range amConfigs {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update AlertmanagerConfig %s status: %w", key, err)
+		}
+	}
+
+	// Remove bindings from alertmanagerConfigs which reference the
+	// workload but aren't selected anymore.
+	if err := operator.CleanupBindings(ctx, c.alrtCfgInfs.ListAll, amConfigs, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for alertmanagerConfigs: %w", err)
+	}
+	return nil
+}
+
+// configResStatusCleanup removes alertmanager bindings from the configuration resources (AlertmanagerConfig).
+func (c *Operator) configResStatusCleanup(ctx context.Context, am *monitoringv1.Alertmanager) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	var configResourceSyncer = operator.NewConfigResourceSyncer(am, c.dclient, c.accessor)
+
+	if err := operator.CleanupBindings(ctx, c.alrtCfgInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]{}, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for alertmanagerConfigs: %w", err)
+	}
+	return nil
+}
+
+// syncPodDisruptionBudget creates or updates the PodDisruptionBudget of the
+// Alertmanager pods, or deletes it if it isn't configured anymore.
+func (c *Operator) syncPodDisruptionBudget(ctx context.Context, am *monitoringv1.Alertmanager, sset *appsv1.StatefulSet) error {
+	if !c.podDisruptionBudgetSupported {
+		return nil
+	}
+
+	pdbClient := c.kclient.PolicyV1().PodDisruptionBudgets(am.Namespace)
+
+	if am.Spec.PodDisruptionBudget == nil {
+		selector, err := metav1.LabelSelectorAsSelector(sset.Spec.Selector)
+		if err != nil {
+			return err
+		}
+
+		var pdbs []metav1.Object
+		err = c.pdbInfs.ListAllByNamespace(am.Namespace, selector, func(obj any) {
+			pdbs = append(pdbs, obj.(metav1.Object))
+		})
+		if err != nil {
+			return fmt.Errorf("listing PodDisruptionBudget resources failed: %w", err)
+		}
+
+		return k8s.DeletePodDisruptionBudgets(ctx, pdbClient, am, pdbs, sets.New[string]())
+	}
+
+	pdb, err := makePodDisruptionBudget(am, sset)
+	if err != nil {
 		return err
 	}
+	if err := k8s.CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, pdb); err != nil {
+		return fmt.Errorf("failed to synchronize PodDisruptionBudget: %w", err)
+	}
 
 	return nil
 }
 
+// makeExposedWorkload returns the description of the Alertmanager pods
+// exposed by the operator.
+func makeExposedWorkload(am *monitoringv1.Alertmanager, sset *appsv1.StatefulSet) operator.ExposedWorkload {
+	return operator.ExposedWorkload{
+		Name:            prefixedName(am.Name),
+		Namespace:       am.Namespace,
+		ApplicationName: applicationNameLabelValue,
+		InstanceName:    am.Name,
+		ExternalURL:     am.Spec.ExternalURL,
+		RoutePrefix:     am.Spec.RoutePrefix,
+		PortName:        cmp.Or(am.Spec.PortName, defaultPortName),
+		Port:            alertmanagerWebPort,
+		Selector:        makeSelectorLabels(am.Name),
+		Shards: []operator.ExposedShard{
+			{
+				StatefulSetName: sset.Name,
+				Replicas:        ptr.Deref(sset.Spec.Replicas, 1),
+				Selector:        makeSelectorLabels(am.Name),
+			},
+		},
+	}
+}
+
 // getStatefulSetFromAlertmanagerKey returns a copy of the StatefulSet object
 // corresponding to the Alertmanager object identified by key.
 // If the object is not found, it returns a nil pointer without error.
@@ -732,6 +1084,16 @@ func (c *Operator) getStatefulSetFromAlertmanagerKey(key string) (*appsv1.Statef
 	return obj.(*appsv1.StatefulSet).DeepCopy(), nil
 }
 
+// storageResizedCondition returns the StorageResized condition of the
+// Alertmanager or nil if it shouldn't be reported.
+func (c *Operator) storageResizedCondition(ctx context.Context, a *monitoringv1.Alertmanager, sset *appsv1.StatefulSet) *monitoringv1.Condition {
+	if !c.volumeExpansionEnabled {
+		return nil
+	}
+
+	return operator.VolumeExpansionCondition(ctx, c.kclient, a.Spec.Storage, []*appsv1.StatefulSet{sset}, a.Status.Conditions, a.Generation)
+}
+
 // UpdateStatus updates the status subresource of the object identified by the given
 // key.
 // UpdateStatus implements the operator.Syncer interface.
@@ -772,7 +1134,11 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 	a.Status.Selector = selector.String()
 	availableCondition := stsReporter.Update(a)
 	reconciledCondition := c.reconciliations.GetCondition(key, a.Generation)
-	a.Status.Conditions = operator.UpdateConditions(a.Status.Conditions, availableCondition, reconciledCondition)
+	conditions := []monitoringv1.Condition{availableCondition, reconciledCondition}
+	if cond := c.storageResizedCondition(ctx, a, sset); cond != nil {
+		conditions = append(conditions, *cond)
+	}
+	a.Status.Conditions = operator.UpdateConditions(a.Status.Conditions, conditions...)
 	a.Status.Paused = a.Spec.Paused
 
 	if availableCondition.Status != monitoringv1.ConditionTrue {
@@ -891,15 +1257,23 @@ func (c *Operator) loadConfigurationFromSecret(ctx context.Context, am *monitori
 	return rawAlertmanagerConfig, secret.Data, nil
 }
 
-func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.StoreBuilder) error {
+// provisionAlertmanagerConfiguration generates the Alertmanager configuration
+// and returns the AlertmanagerConfig resources selected by the Alertmanager
+// object.
+// The selection is returned even if the generation fails afterwards. It is nil
+// only when the operator couldn't select the resources.
+//
+// When debugInfo isn't nil, it records the selected AlertmanagerConfig
+// resources and the generated configuration with the secret values redacted.
+func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *monitoringv1.Alertmanager, store *assets.StoreBuilder, debugInfo *operator.WorkloadDebugInfo) (operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], error) {
 	amVersion := operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion)
 	version, err := semver.ParseTolerant(amVersion)
 	if err != nil {
-		return fmt.Errorf("failed to parse alertmanager version: %w", err)
+		return nil, fmt.Errorf("failed to parse alertmanager version: %w", err)
 	}
 
 	if version.LT(semver.MustParse("0.15.0")) || version.Major > 0 {
-		return fmt.Errorf("unsupported Alertmanager version %q", amVersion)
+		return nil, fmt.Errorf("unsupported Alertmanager version %q", amVersion)
 	}
 
 	namespacedLogger := c.logger.With("alertmanager", am.Name, "namespace", am.Namespace)
@@ -911,20 +1285,34 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 
 		amRawConfiguration, additionalData, err := c.loadConfigurationFromSecret(ctx, am)
 		if err != nil {
-			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
+			return nil, fmt.Errorf("failed to retrieve configuration from secret: %w", err)
 		}
 
 		err = c.createOrUpdateGeneratedConfigSecret(ctx, am, amRawConfiguration, additionalData)
 		if err != nil {
-			return fmt.Errorf("create or update generated config secret failed: %w", err)
+			return nil, fmt.Errorf("create or update generated config secret failed: %w", err)
 		}
 
-		return nil
+		if debugInfo != nil {
+			debugInfo.Configuration = redactedConfig(namespacedLogger, amRawConfiguration)
+		}
+
+		return operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]{}, nil
+	}
+
+	namespaces, err := c.alertmanagerConfigNamespaces(am)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select AlertmanagerConfig namespaces: %w", err)
 	}
 
-	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, version, store)
+	amConfigs, err := c.selectAlertmanagerConfigs(ctx, am, namespaces, version, store)
 	if err != nil {
-		return fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
+		return nil, fmt.Errorf("failed to select AlertmanagerConfig objects: %w", err)
+	}
+
+	if debugInfo != nil {
+		debugInfo.Namespaces = map[string][]string{monitoringv1alpha1.AlertmanagerConfigKind: namespaces}
+		debugInfo.Resources = map[string][]operator.ResourceDebugInfo{monitoringv1alpha1.AlertmanagerConfigKind: operator.NewResourcesDebugInfo(amConfigs)}
 	}
 
 	var (
@@ -937,12 +1325,12 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 		globalAmConfig, err := c.mclient.MonitoringV1alpha1().AlertmanagerConfigs(am.Namespace).
 			Get(ctx, am.Spec.AlertmanagerConfiguration.Name, metav1.GetOptions{})
 		if err != nil {
-			return fmt.Errorf("failed to get global AlertmanagerConfig: %w", err)
+			return amConfigs, fmt.Errorf("failed to get global AlertmanagerConfig: %w", err)
 		}
 
 		err = cfgBuilder.initializeFromAlertmanagerConfig(ctx, am.Spec.AlertmanagerConfiguration.Global, globalAmConfig)
 		if err != nil {
-			return fmt.Errorf("failed to initialize from global AlertmanagerConfig: %w", err)
+			return amConfigs, fmt.Errorf("failed to initialize from global AlertmanagerConfig: %w", err)
 		}
 
 		for _, v := range am.Spec.AlertmanagerConfiguration.Templates {
@@ -962,30 +1350,41 @@ func (c *Operator) provisionAlertmanagerConfiguration(ctx context.Context, am *m
 
 		amRawConfiguration, additionalData, err = c.loadConfigurationFromSecret(ctx, am)
 		if err != nil {
-			return fmt.Errorf("failed to retrieve configuration from secret: %w", err)
+			return amConfigs, fmt.Errorf("failed to retrieve configuration from secret: %w", err)
 		}
 
 		err = cfgBuilder.InitializeFromRawConfiguration(amRawConfiguration)
 		if err != nil {
-			return fmt.Errorf("failed to initialize from secret: %w", err)
+			return amConfigs, fmt.Errorf("failed to initialize from secret: %w", err)
 		}
 	}
 
-	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs); err != nil {
-		return fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
+	if err := cfgBuilder.AddAlertmanagerConfigs(ctx, amConfigs.ValidResources()); err != nil {
+		return amConfigs, fmt.Errorf("failed to generate Alertmanager configuration: %w", err)
 	}
 
 	generatedConfig, err := cfgBuilder.MarshalJSON()
 	if err != nil {
-		return fmt.Errorf("failed to marshal configuration: %w", err)
+		return amConfigs, fmt.Errorf("failed to marshal configuration: %w", err)
+	}
+
+	if debugInfo != nil {
+		debugInfo.Configuration = redactedConfig(namespacedLogger, generatedConfig)
+	}
+
+	if templateFiles := cfgBuilder.TemplateFiles(); len(templateFiles) > 0 {
+		if additionalData == nil {
+			additionalData = make(map[string][]byte, len(templateFiles))
+		}
+		maps.Copy(additionalData, templateFiles)
 	}
 
 	err = c.createOrUpdateGeneratedConfigSecret(ctx, am, generatedConfig, additionalData)
 	if err != nil {
-		return fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
+		return amConfigs, fmt.Errorf("failed to create or update the generated configuration secret: %w", err)
 	}
 
-	return nil
+	return amConfigs, nil
 }
 
 func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *monitoringv1.Alertmanager, conf []byte, additionalData map[string][]byte) error {
@@ -1018,7 +1417,9 @@ func (c *Operator) createOrUpdateGeneratedConfigSecret(ctx context.Context, am *
 	return nil
 }
 
-func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, amVersion semver.Version, store *assets.StoreBuilder) (map[string]*monitoringv1alpha1.AlertmanagerConfig, error) {
+// alertmanagerConfigNamespaces returns the namespaces from which the
+// Alertmanager object selects AlertmanagerConfig resources.
+func (c *Operator) alertmanagerConfigNamespaces(am *monitoringv1.Alertmanager) ([]string, error) {
 	namespaces := []string{}
 
 	// If 'AlertmanagerConfigNamespaceSelector' is nil, only check own namespace.
@@ -1042,6 +1443,13 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 		c.logger.Debug("filtering namespaces to select AlertmanagerConfigs from", "namespaces", strings.Join(namespaces, ","), "namespace", am.Namespace, "alertmanager", am.Name)
 	}
 
+	return namespaces, nil
+}
+
+// selectAlertmanagerConfigs returns the AlertmanagerConfig resources selected
+// by the Alertmanager object from the given namespaces, including the ones
+// which are invalid.
+func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoringv1.Alertmanager, namespaces []string, amVersion semver.Version, store *assets.StoreBuilder) (operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], error) {
 	// Selected object might overlap, deduplicate them by `<namespace>/<name>`.
 	amConfigs := make(map[string]*monitoringv1alpha1.AlertmanagerConfig)
 
@@ -1063,6 +1471,12 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 				return
 			}
 
+			amConfig = amConfig.DeepCopy()
+			if err := k8s.AddTypeInformationToObject(amConfig); err != nil {
+				c.logger.Error("failed to set type information", "alertmanagerconfig", k, "err", err)
+				return
+			}
+
 			amConfigs[k] = amConfig
 		})
 		if err != nil {
@@ -1070,13 +1484,19 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 		}
 	}
 
-	var rejected int
-	res := make(map[string]*monitoringv1alpha1.AlertmanagerConfig, len(amConfigs))
+	var (
+		rejected int
+		valid    []string
+		res      = make(operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig], len(amConfigs))
+	)
 
 	eventRecorder := c.newEventRecorder(am)
 	for namespaceAndName, amc := range amConfigs {
-		if err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store); err != nil {
+		var reason string
+		err := checkAlertmanagerConfigResource(ctx, amc, amVersion, store)
+		if err != nil {
 			rejected++
+			reason = operator.InvalidConfiguration
 			c.logger.Warn(
 				"skipping alertmanagerconfig",
 				"error", err.Error(),
@@ -1085,20 +1505,17 @@ func (c *Operator) selectAlertmanagerConfigs(ctx context.Context, am *monitoring
 				"alertmanager", am.Name,
 			)
 			eventRecorder.Eventf(amc, corev1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingAlertmanagerConfigResourcesAction, "AlertmanagerConfig %s was rejected due to invalid configuration: %v", amc.GetName(), err)
-			continue
+		} else {
+			valid = append(valid, namespaceAndName)
 		}
 
-		res[namespaceAndName] = amc
+		res[namespaceAndName] = operator.NewTypedConfigurationResource(amc, err, reason, amc.GetGeneration())
 	}
 
-	amcKeys := []string{}
-	for k := range res {
-		amcKeys = append(amcKeys, k)
-	}
-	c.logger.Debug("selected AlertmanagerConfigs", "alertmanagerconfigs", strings.Join(amcKeys, ","), "namespace", am.Namespace, "prometheus", am.Name)
+	c.logger.Debug("selected AlertmanagerConfigs", "alertmanagerconfigs", strings.Join(valid, ","), "namespace", am.Namespace, "prometheus", am.Name)
 
 	if amKey, ok := c.accessor.MetaNamespaceKey(am); ok {
-		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(res))
+		c.metrics.SetSelectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, len(valid))
 		c.metrics.SetRejectedResources(amKey, monitoringv1alpha1.AlertmanagerConfigKind, rejected)
 	}
 
@@ -1120,6 +1537,10 @@ func checkAlertmanagerConfigResource(ctx context.Context, amc *monitoringv1alpha
 		return err
 	}
 
+	if err := checkTemplates(ctx, amc.Spec.Templates, types.NamespacedName{Namespace: amc.Namespace, Name: amc.Name}, store); err != nil {
+		return err
+	}
+
 	if err := checkRoute(ctx, amc.Spec.Route, amVersion); err != nil {
 		return err
 	}
@@ -1261,6 +1682,21 @@ func checkReceivers(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerCon
 		if err != nil {
 			return err
 		}
+
+		err = checkJiraConfigs(ctx, receiver.JiraConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkMattermostConfigs(ctx, receiver.MattermostConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
+
+		err = checkIncidentioConfigs(ctx, receiver.IncidentioConfigs, amc.GetNamespace(), store, amVersion)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -1373,6 +1809,115 @@ func checkDiscordConfigs(
 	return nil
 }
 
+func checkJiraConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.JiraConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.28.0")) {
+		return fmt.Errorf(`'jiraConfigs' is available in Alertmanager >= 0.28.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if config.APIType != nil && amVersion.LT(semver.MustParse("0.29.0")) {
+			return fmt.Errorf(`'apiType' is available in Alertmanager >= 0.29.0 only - current %s`, amVersion)
+		}
+
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkMattermostConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.MattermostConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.30.0")) {
+		return fmt.Errorf(`'mattermostConfigs' is available in Alertmanager >= 0.30.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if config.WebhookURL == nil {
+			// The global webhook URL is supported since Alertmanager 0.32.0.
+			if amVersion.LT(semver.MustParse("0.32.0")) {
+				return fmt.Errorf(`'webhookURL' is required for Alertmanager < 0.32.0 - current %s`, amVersion)
+			}
+		} else {
+			url, err := store.GetSecretKey(ctx, namespace, *config.WebhookURL)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve Mattermost webhook URL: %w", err)
+			}
+			if err := validation.ValidateSecretURL(strings.TrimSpace(url)); err != nil {
+				return fmt.Errorf("failed to validate Mattermost webhook URL: %w", err)
+			}
+		}
+
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkIncidentioConfigs(
+	ctx context.Context,
+	configs []monitoringv1alpha1.IncidentioConfig,
+	namespace string,
+	store *assets.StoreBuilder,
+	amVersion semver.Version,
+) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	if amVersion.LT(semver.MustParse("0.29.0")) {
+		return fmt.Errorf(`'incidentioConfigs' is available in Alertmanager >= 0.29.0 only - current %s`, amVersion)
+	}
+
+	for _, config := range configs {
+		if config.AlertSourceToken != nil {
+			if _, err := store.GetSecretKey(ctx, namespace, *config.AlertSourceToken); err != nil {
+				return fmt.Errorf("failed to retrieve incident.io alert source token: %w", err)
+			}
+		}
+
+		if err := checkHTTPConfig(config.HTTPConfig, amVersion); err != nil {
+			return err
+		}
+
+		if err := configureHTTPConfigInStore(ctx, config.HTTPConfig, namespace, store); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func checkRocketChatConfigs(
 	ctx context.Context,
 	configs []monitoringv1alpha1.RocketChatConfig,
@@ -16,6 +16,7 @@ package alertmanager
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"testing"
@@ -26,6 +27,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +40,7 @@ import (
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
 	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator/operatortest"
 )
 
 func TestCreateStatefulSetInputHash(t *testing.T) {
@@ -2122,7 +2125,8 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 			require.NoError(t, err)
 
 			store := assets.NewStoreBuilder(c.CoreV1(), c.CoreV1())
-			err = o.provisionAlertmanagerConfiguration(context.Background(), tc.am, store)
+			debugInfo := &operator.WorkloadDebugInfo{}
+			_, err = o.provisionAlertmanagerConfiguration(context.Background(), tc.am, store, debugInfo)
 
 			if !tc.ok {
 				require.Error(t, err)
@@ -2141,6 +2145,11 @@ func TestProvisionAlertmanagerConfiguration(t *testing.T) {
 				_, found := secret.Data[k]
 				require.True(t, found, "expecting key %q to be present in the generated secret but got nothing", k)
 			}
+
+			if tc.am.Spec.AlertmanagerConfigSelector != nil {
+				require.Contains(t, debugInfo.Resources, monitoringv1alpha1.AlertmanagerConfigKind)
+				require.NotEmpty(t, debugInfo.Configuration)
+			}
 		})
 	}
 }
@@ -2155,3 +2164,234 @@ func (*alwaysAllowed) Create(_ context.Context, _ *authv1.SelfSubjectAccessRevie
 		},
 	}, nil
 }
+
+func TestStorageResizedCondition(t *testing.T) {
+	newPVC := func(capacity string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test-db-alertmanager-test-0", Namespace: "ns"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: ptr.To("standard"),
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+				},
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+			},
+		}
+	}
+
+	sset := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test", Namespace: "ns"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(1)),
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-test-db"},
+			}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		enabled    bool
+		pvc        *corev1.PersistentVolumeClaim
+		conditions []monitoringv1.Condition
+
+		expected      *monitoringv1.Condition
+		expectActions bool
+	}{
+		{
+			name:    "volume expansion disabled",
+			enabled: false,
+			pvc:     newPVC("1Gi"),
+		},
+		{
+			name:    "resize pending",
+			enabled: true,
+			pvc:     newPVC("1Gi"),
+			expected: &monitoringv1.Condition{
+				Type:   monitoringv1.StorageResized,
+				Status: monitoringv1.ConditionFalse,
+				Reason: "Resizing",
+			},
+			expectActions: true,
+		},
+		{
+			name:    "resized",
+			enabled: true,
+			pvc:     newPVC("2Gi"),
+			expected: &monitoringv1.Condition{
+				Type:   monitoringv1.StorageResized,
+				Status: monitoringv1.ConditionTrue,
+			},
+			expectActions: true,
+		},
+		{
+			name:    "already resized for the current generation",
+			enabled: true,
+			pvc:     newPVC("1Gi"),
+			conditions: []monitoringv1.Condition{{
+				Type:               monitoringv1.StorageResized,
+				Status:             monitoringv1.ConditionTrue,
+				ObservedGeneration: 2,
+			}},
+			expected: &monitoringv1.Condition{
+				Type:   monitoringv1.StorageResized,
+				Status: monitoringv1.ConditionTrue,
+			},
+		},
+		{
+			name:    "resized for a previous generation",
+			enabled: true,
+			pvc:     newPVC("1Gi"),
+			conditions: []monitoringv1.Condition{{
+				Type:               monitoringv1.StorageResized,
+				Status:             monitoringv1.ConditionTrue,
+				ObservedGeneration: 1,
+			}},
+			expected: &monitoringv1.Condition{
+				Type:   monitoringv1.StorageResized,
+				Status: monitoringv1.ConditionFalse,
+				Reason: "Resizing",
+			},
+			expectActions: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset(
+				tc.pvc,
+				&storagev1.StorageClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: "standard"},
+					AllowVolumeExpansion: ptr.To(true),
+				},
+			)
+			c := &Operator{
+				kclient:                kclient,
+				volumeExpansionEnabled: tc.enabled,
+			}
+
+			a := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns", Generation: 2},
+				Spec: monitoringv1.AlertmanagerSpec{
+					Storage: &monitoringv1.StorageSpec{
+						VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+							Spec: corev1.PersistentVolumeClaimSpec{
+								Resources: corev1.VolumeResourceRequirements{
+									Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+								},
+							},
+						},
+					},
+				},
+				Status: monitoringv1.AlertmanagerStatus{
+					Conditions: tc.conditions,
+				},
+			}
+
+			cond := c.storageResizedCondition(context.Background(), a, sset)
+			require.Equal(t, tc.expectActions, len(kclient.Actions()) > 0)
+			if tc.expected == nil {
+				require.Nil(t, cond)
+				return
+			}
+
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expected.Type, cond.Type)
+			require.Equal(t, tc.expected.Status, cond.Status)
+			require.Equal(t, tc.expected.Reason, cond.Reason)
+			require.Equal(t, a.Generation, cond.ObservedGeneration)
+		})
+	}
+}
+
+func TestUpdateConfigResourcesStatus(t *testing.T) {
+	amResource := monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName).GroupResource()
+	newAlertmanagerConfig := func(name string, bindings ...monitoringv1.WorkloadBinding) *monitoringv1alpha1.AlertmanagerConfig {
+		return &monitoringv1alpha1.AlertmanagerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1alpha1.AlertmanagerConfigKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  operatortest.BindingsNamespace,
+				Generation: 2,
+			},
+			Status: monitoringv1.ConfigResourceStatus{Bindings: bindings},
+		}
+	}
+
+	var (
+		mainAccepted  = operatortest.NewWorkloadBinding(amResource, "main", monitoringv1.ConditionTrue)
+		mainRejected  = operatortest.NewWorkloadBinding(amResource, "main", monitoringv1.ConditionFalse)
+		otherAccepted = operatortest.NewWorkloadBinding(amResource, "other", monitoringv1.ConditionTrue)
+	)
+	amConfigs := []*monitoringv1alpha1.AlertmanagerConfig{
+		newAlertmanagerConfig("accepted"),
+		newAlertmanagerConfig("rejected", mainAccepted),
+		newAlertmanagerConfig("stale", otherAccepted, mainAccepted),
+		newAlertmanagerConfig("unrelated", otherAccepted),
+	}
+
+	objs := make([]runtime.Object, 0, len(amConfigs))
+	for _, amc := range amConfigs {
+		objs = append(objs, amc)
+	}
+	clients := operatortest.NewBindingsClients(t, objs...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	gvr := monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName)
+	logger := slog.New(slog.DiscardHandler)
+	c := &Operator{
+		logger:                       logger,
+		dclient:                      clients.DynamicClient,
+		accessor:                     operator.NewAccessor(logger),
+		alrtCfgInfs:                  clients.Informers(ctx, t, gvr),
+		configResourcesStatusEnabled: true,
+	}
+
+	am := &monitoringv1.Alertmanager{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.AlertmanagersKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "main",
+			Namespace: operatortest.BindingsNamespace,
+		},
+	}
+
+	err := c.updateConfigResourcesStatus(ctx, am, operator.TypedResourcesSelection[*monitoringv1alpha1.AlertmanagerConfig]{
+		"default/accepted": operator.NewTypedConfigurationResource(amConfigs[0], nil, "", 2),
+		"default/rejected": operator.NewTypedConfigurationResource(amConfigs[1], errors.New("invalid receiver"), operator.InvalidConfiguration, 2),
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name     string
+		expected []monitoringv1.WorkloadBinding
+	}{
+		{
+			name:     "accepted",
+			expected: []monitoringv1.WorkloadBinding{mainAccepted},
+		},
+		{
+			name:     "rejected",
+			expected: []monitoringv1.WorkloadBinding{mainRejected},
+		},
+		{
+			name:     "stale",
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+		{
+			name:     "unrelated",
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			operatortest.RequireBindings(ctx, t, clients.DynamicClient, gvr, tc.name, tc.expected)
+		})
+	}
+}
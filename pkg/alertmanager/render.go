@@ -0,0 +1,89 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// Render generates the configuration and the statefulset of the
+// Alertmanager resource from the given objects, without interacting with a
+// Kubernetes API server. The Alertmanager resource has no rule files.
+func Render(ctx context.Context, logger *slog.Logger, c operator.Config, am *monitoringv1.Alertmanager, objects operator.RenderObjects) (files, ruleFiles map[string]string, ssets []*appsv1.StatefulSet, err error) {
+	o := &Operator{
+		kclient:  objects.KubeClient(),
+		mclient:  objects.MonitoringClient(),
+		logger:   logger,
+		accessor: operator.NewAccessor(logger),
+		config:   newConfig(c),
+
+		nsAlrtInf:    objects.NamespaceInformer(),
+		nsAlrtCfgInf: objects.NamespaceInformer(),
+
+		metrics:          operator.NewMetrics(prometheus.NewRegistry()),
+		reconciliations:  &operator.ReconciliationTracker{},
+		newEventRecorder: c.EventRecorderFactory(objects.KubeClient(), controllerName),
+	}
+
+	o.alrtCfgInfs, err = objects.NewInformersForResource(ctx, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.AlertmanagerConfigName))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create alertmanagerconfig informers: %w", err)
+	}
+
+	store := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
+
+	if _, err := o.provisionAlertmanagerConfiguration(ctx, am, store, nil); err != nil {
+		return nil, nil, nil, fmt.Errorf("provision alertmanager configuration: %w", err)
+	}
+
+	s, err := o.kclient.CoreV1().Secrets(am.Namespace).Get(ctx, generatedConfigSecretName(am.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	conf, err := operator.GunzipConfig(s.Data[alertmanagerConfigFileCompressed])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decompress the configuration: %w", err)
+	}
+
+	tlsShardedSecret, err := operator.ReconcileShardedSecret(ctx, store.TLSAssets(), o.kclient, o.newTLSAssetSecret(am))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+	}
+
+	inputHash, err := createSSetInputHash(*am, o.config, tlsShardedSecret, appsv1.StatefulSetSpec{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	sset, err := makeStatefulSet(logger, am, o.config, inputHash, tlsShardedSecret)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate statefulset: %w", err)
+	}
+	operator.SanitizeSTS(sset)
+
+	return map[string]string{alertmanagerConfigFile: conf}, nil, []*appsv1.StatefulSet{sset}, nil
+}
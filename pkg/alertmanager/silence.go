@@ -0,0 +1,740 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1alpha1ac "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// silenceResyncPeriod is the interval at which the silences are checked
+// against the Alertmanager API.
+const silenceResyncPeriod = time.Minute
+
+// silencesClient interacts with the silences API of an Alertmanager
+// resource.
+type silencesClient interface {
+	// getSilence returns the silence identified by id. It returns nil if
+	// the silence doesn't exist.
+	getSilence(ctx context.Context, am *monitoringv1.Alertmanager, id string) (*models.GettableSilence, error)
+	// postSilence creates or updates a silence and returns its identifier.
+	postSilence(ctx context.Context, am *monitoringv1.Alertmanager, s *models.PostableSilence) (string, error)
+	// deleteSilence expires the silence identified by id.
+	deleteSilence(ctx context.Context, am *monitoringv1.Alertmanager, id string) error
+}
+
+// podProxySilencesClient implements silencesClient by sending the requests to
+// the Alertmanager pods through the proxy subresource of the Kubernetes API.
+//
+// Because Alertmanager replicas share their silences via the gossip
+// protocol, a request is sent to the first pod which answers.
+type podProxySilencesClient struct {
+	kclient kubernetes.Interface
+}
+
+var _ silencesClient = &podProxySilencesClient{}
+
+func (c *podProxySilencesClient) getSilence(ctx context.Context, am *monitoringv1.Alertmanager, id string) (*models.GettableSilence, error) {
+	b, err := c.do(ctx, am, http.MethodGet, "/api/v2/silence/"+id, nil)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var s models.GettableSilence
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("failed to decode silence: %w", err)
+	}
+
+	return &s, nil
+}
+
+func (c *podProxySilencesClient) postSilence(ctx context.Context, am *monitoringv1.Alertmanager, s *models.PostableSilence) (string, error) {
+	body, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := c.do(ctx, am, http.MethodPost, "/api/v2/silences", body)
+	if err != nil {
+		return "", err
+	}
+
+	var resp struct {
+		SilenceID string `json:"silenceID"`
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return resp.SilenceID, nil
+}
+
+func (c *podProxySilencesClient) deleteSilence(ctx context.Context, am *monitoringv1.Alertmanager, id string) error {
+	_, err := c.do(ctx, am, http.MethodDelete, "/api/v2/silence/"+id, nil)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+// do sends the request to the ready pods of the Alertmanager resource until
+// one of them answers.
+func (c *podProxySilencesClient) do(ctx context.Context, am *monitoringv1.Alertmanager, verb, p string, body []byte) ([]byte, error) {
+	pods, err := c.kclient.CoreV1().Pods(am.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(makeSelectorLabels(am.Name)).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	scheme := "http"
+	if am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil {
+		scheme = "https"
+	}
+	portName := cmp.Or(am.Spec.PortName, defaultPortName)
+	routePrefix := cmp.Or(am.Spec.RoutePrefix, "/")
+
+	var errs []error
+	for _, pod := range pods.Items {
+		if ready, _ := k8s.PodRunningAndReady(pod); !ready {
+			continue
+		}
+
+		req := c.kclient.CoreV1().RESTClient().
+			Verb(verb).
+			Namespace(am.Namespace).
+			Resource("pods").
+			SubResource("proxy").
+			Name(fmt.Sprintf("%s:%s:%s", scheme, pod.Name, portName)).
+			Suffix(path.Join(routePrefix, p))
+		if body != nil {
+			req = req.Body(body).SetHeader("Content-Type", "application/json")
+		}
+
+		b, err := req.DoRaw(ctx)
+		if err == nil || apierrors.IsNotFound(err) {
+			return b, err
+		}
+
+		errs = append(errs, fmt.Errorf("pod %s: %w", pod.Name, err))
+	}
+
+	if len(errs) == 0 {
+		return nil, errors.New("no ready Alertmanager pod")
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// addSilenceHandlers adds the event handlers which trigger the
+// synchronization of Silence objects.
+func (c *Operator) addSilenceHandlers() {
+	c.silenceInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueSilence,
+		UpdateFunc: func(old, cur any) {
+			oldMeta, ok := c.accessor.ObjectMetadata(old)
+			if !ok {
+				return
+			}
+			curMeta, ok := c.accessor.ObjectMetadata(cur)
+			if !ok {
+				return
+			}
+
+			if oldMeta.GetGeneration() == curMeta.GetGeneration() &&
+				equality.Semantic.DeepEqual(oldMeta.GetLabels(), curMeta.GetLabels()) &&
+				curMeta.GetDeletionTimestamp() == nil {
+				return
+			}
+
+			c.enqueueSilence(cur)
+		},
+	})
+
+	// Changes to Alertmanager objects and namespace labels may modify the
+	// selection of Silence objects.
+	c.alrtInfs.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			c.enqueueSilencesForAlertmanager(obj.(*monitoringv1.Alertmanager))
+		},
+		UpdateFunc: func(old, cur any) {
+			oldAm, curAm := old.(*monitoringv1.Alertmanager), cur.(*monitoringv1.Alertmanager)
+			if oldAm.Generation == curAm.Generation && curAm.GetDeletionTimestamp() == nil {
+				return
+			}
+
+			c.enqueueSilencesForAlertmanager(oldAm)
+			c.enqueueSilencesForAlertmanager(curAm)
+		},
+		DeleteFunc: func(obj any) {
+			if d, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = d.Obj
+			}
+
+			if am, ok := obj.(*monitoringv1.Alertmanager); ok {
+				c.enqueueSilencesForAlertmanager(am)
+			}
+		},
+	})
+	_, _ = c.nsAlrtCfgInf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, cur any) {
+			oldNs, curNs := old.(*corev1.Namespace), cur.(*corev1.Namespace)
+			if equality.Semantic.DeepEqual(oldNs.Labels, curNs.Labels) {
+				return
+			}
+
+			if err := c.silenceInfs.ListAllByNamespace(curNs.Name, labels.Everything(), c.enqueueSilence); err != nil {
+				c.logger.Error("failed to list Silence objects", "namespace", curNs.Name, "err", err)
+			}
+		},
+	})
+}
+
+// enqueueSilencesForAlertmanager enqueues the Silence objects which are
+// selected by the Alertmanager resource or which have been applied to it.
+func (c *Operator) enqueueSilencesForAlertmanager(am *monitoringv1.Alertmanager) {
+	err := c.silenceInfs.ListAll(labels.Everything(), func(obj any) {
+		s := obj.(*monitoringv1alpha1.Silence)
+
+		if slices.ContainsFunc(s.Status.Alertmanagers, func(st monitoringv1alpha1.SilenceAlertmanagerStatus) bool {
+			return st.Namespace == am.Namespace && st.Name == am.Name
+		}) {
+			c.enqueueSilence(s)
+			return
+		}
+
+		ns, err := c.getSilenceNamespace(s)
+		if err != nil {
+			c.logger.Error("failed to get namespace", "namespace", s.Namespace, "err", err)
+			return
+		}
+
+		if c.alertmanagerSelectsSilence(am, s, ns) {
+			c.enqueueSilence(s)
+		}
+	})
+	if err != nil {
+		c.logger.Error("failed to list Silence objects", "err", err)
+	}
+}
+
+func (c *Operator) enqueueSilence(obj any) {
+	key, ok := c.accessor.MetaNamespaceKey(obj)
+	if !ok {
+		return
+	}
+
+	c.silenceQ.Add(key)
+}
+
+func (c *Operator) enqueueAllSilences() {
+	if err := c.silenceInfs.ListAll(labels.Everything(), c.enqueueSilence); err != nil {
+		c.logger.Error("failed to list Silence objects", "err", err)
+	}
+}
+
+// runSilenceWorker processes the Silence objects until the context is
+// canceled.
+func (c *Operator) runSilenceWorker(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		c.silenceQ.ShutDown()
+	}()
+
+	// Periodically check all silences to recreate the ones which have been
+	// lost by Alertmanager (e.g. after a restart without persistent storage).
+	go func() {
+		ticker := time.NewTicker(silenceResyncPeriod)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				c.enqueueAllSilences()
+			}
+		}
+	}()
+
+	for c.processNextSilence(ctx) {
+	}
+}
+
+func (c *Operator) processNextSilence(ctx context.Context) bool {
+	key, quit := c.silenceQ.Get()
+	if quit {
+		return false
+	}
+	defer c.silenceQ.Done(key)
+
+	if err := c.syncSilence(ctx, key); err != nil {
+		utilruntime.HandleError(fmt.Errorf("sync silence %q failed: %w", key, err))
+		c.silenceQ.AddRateLimited(key)
+		return true
+	}
+
+	c.silenceQ.Forget(key)
+	return true
+}
+
+// syncSilence applies the Silence object identified by key to the
+// Alertmanager resources which select it and expires it from the
+// Alertmanager resources which don't select it anymore.
+func (c *Operator) syncSilence(ctx context.Context, key string) error {
+	s, err := operator.GetObjectFromKey[*monitoringv1alpha1.Silence](c.silenceInfs, key)
+	if err != nil {
+		return err
+	}
+
+	if s == nil {
+		return nil
+	}
+
+	deleting := s.GetDeletionTimestamp() != nil
+
+	var selected []*monitoringv1.Alertmanager
+	if !deleting {
+		selected, err = c.selectAlertmanagersForSilence(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(selected) > 0 {
+		if err := c.patchSilenceFinalizer(ctx, s, k8s.FinalizerAddPatch); err != nil {
+			return fmt.Errorf("failed to add %q finalizer: %w", k8s.SilenceExpirationFinalizerName, err)
+		}
+	}
+
+	var (
+		now      = time.Now()
+		errs     []error
+		current  = make(map[string]monitoringv1alpha1.SilenceAlertmanagerStatus, len(s.Status.Alertmanagers))
+		statuses = make([]monitoringv1alpha1.SilenceAlertmanagerStatus, 0, len(selected))
+	)
+	for _, st := range s.Status.Alertmanagers {
+		current[st.Namespace+"/"+st.Name] = st
+	}
+
+	for _, am := range selected {
+		amKey := am.Namespace + "/" + am.Name
+
+		st, err := c.applySilence(ctx, am, s, current[amKey], now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("alertmanager %s: %w", amKey, err))
+		}
+		statuses = append(statuses, st)
+
+		delete(current, amKey)
+	}
+
+	// Expire the silence from the Alertmanager resources which don't select
+	// the object anymore.
+	for amKey, st := range current {
+		if err := c.expireSilence(ctx, amKey, st.SilenceID); err != nil {
+			errs = append(errs, fmt.Errorf("alertmanager %s: %w", amKey, err))
+			st.Message = err.Error()
+			statuses = append(statuses, st)
+		}
+	}
+
+	slices.SortFunc(statuses, func(a, b monitoringv1alpha1.SilenceAlertmanagerStatus) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	if !equality.Semantic.DeepEqual(statuses, s.Status.Alertmanagers) && !(len(statuses) == 0 && len(s.Status.Alertmanagers) == 0) {
+		if err := c.applySilenceStatus(ctx, s, statuses); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update status: %w", err))
+		}
+	}
+
+	// Remove the finalizer once the silence has been expired from all the
+	// Alertmanager resources, either because the object is being deleted or
+	// because no Alertmanager resource selects it anymore.
+	if len(statuses) == 0 {
+		if err := c.patchSilenceFinalizer(ctx, s, k8s.FinalizerDeletePatch); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove %q finalizer: %w", k8s.SilenceExpirationFinalizerName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// selectAlertmanagersForSilence returns the Alertmanager resources which
+// select the Silence object.
+func (c *Operator) selectAlertmanagersForSilence(s *monitoringv1alpha1.Silence) ([]*monitoringv1.Alertmanager, error) {
+	ns, err := c.getSilenceNamespace(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var ams []*monitoringv1.Alertmanager
+	err = c.alrtInfs.ListAll(labels.Everything(), func(obj any) {
+		am := obj.(*monitoringv1.Alertmanager)
+
+		if c.rr.DeletionInProgress(am) || !c.alertmanagerSelectsSilence(am, s, ns) {
+			return
+		}
+
+		ams = append(ams, am)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Alertmanager objects: %w", err)
+	}
+
+	return ams, nil
+}
+
+// getSilenceNamespace returns the namespace of the Silence object from the
+// cache. It returns nil if the namespace isn't found.
+func (c *Operator) getSilenceNamespace(s *monitoringv1alpha1.Silence) (*corev1.Namespace, error) {
+	obj, exists, err := c.nsAlrtCfgInf.GetStore().GetByKey(s.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %q: %w", s.Namespace, err)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	return obj.(*corev1.Namespace), nil
+}
+
+// alertmanagerSelectsSilence returns true if the silence selectors of the
+// Alertmanager resource match the Silence object.
+func (c *Operator) alertmanagerSelectsSilence(am *monitoringv1.Alertmanager, s *monitoringv1alpha1.Silence, ns *corev1.Namespace) bool {
+	if am.Spec.SilenceSelector == nil {
+		return false
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceSelector)
+	if err != nil {
+		c.logger.Error("failed to convert SilenceSelector to selector", "alertmanager", am.Name, "namespace", am.Namespace, "err", err)
+		return false
+	}
+
+	if !selector.Matches(labels.Set(s.Labels)) {
+		return false
+	}
+
+	// If 'SilenceNamespaceSelector' is nil, only check own namespace.
+	if am.Spec.SilenceNamespaceSelector == nil {
+		return am.Namespace == s.Namespace
+	}
+
+	nsSelector, err := metav1.LabelSelectorAsSelector(am.Spec.SilenceNamespaceSelector)
+	if err != nil {
+		c.logger.Error("failed to convert SilenceNamespaceSelector to selector", "alertmanager", am.Name, "namespace", am.Namespace, "err", err)
+		return false
+	}
+
+	return ns != nil && nsSelector.Matches(labels.Set(ns.Labels))
+}
+
+// applySilence ensures that the silence exists in Alertmanager and returns
+// its status.
+func (c *Operator) applySilence(
+	ctx context.Context,
+	am *monitoringv1.Alertmanager,
+	s *monitoringv1alpha1.Silence,
+	st monitoringv1alpha1.SilenceAlertmanagerStatus,
+	now time.Time,
+) (monitoringv1alpha1.SilenceAlertmanagerStatus, error) {
+	out := monitoringv1alpha1.SilenceAlertmanagerStatus{
+		Namespace: am.Namespace,
+		Name:      am.Name,
+		SilenceID: st.SilenceID,
+		State:     st.State,
+	}
+
+	desired, err := makePostableSilence(am, s)
+	if err != nil {
+		out.Message = err.Error()
+		// The error can't be solved by retrying.
+		return out, nil
+	}
+
+	var existing *models.GettableSilence
+	if out.SilenceID != "" {
+		existing, err = c.silencesClient.getSilence(ctx, am, out.SilenceID)
+		if err != nil {
+			out.Message = err.Error()
+			return out, err
+		}
+	}
+
+	endsAt := time.Time(*desired.EndsAt)
+	switch {
+	case existing != nil && silenceMatches(existing, desired, now) &&
+		(silenceState(existing) != monitoringv1alpha1.SilenceExpired || !endsAt.After(now)):
+		// The silence is up-to-date.
+		out.State = silenceState(existing)
+		return out, nil
+
+	case !endsAt.After(now):
+		// The silence has ended, there's no need to create it.
+		out.State = monitoringv1alpha1.SilenceExpired
+		return out, nil
+	}
+
+	// Update the existing silence in place unless it has expired (e.g. it
+	// has been expired manually).
+	if existing != nil && silenceState(existing) != monitoringv1alpha1.SilenceExpired {
+		desired.ID = out.SilenceID
+	}
+
+	id, err := c.silencesClient.postSilence(ctx, am, desired)
+	if err != nil {
+		out.Message = err.Error()
+		return out, err
+	}
+
+	out.SilenceID = id
+	out.State = monitoringv1alpha1.SilenceActive
+	if time.Time(*desired.StartsAt).After(now) {
+		out.State = monitoringv1alpha1.SilencePending
+	}
+
+	return out, nil
+}
+
+// expireSilence expires the silence from the Alertmanager resource identified
+// by amKey. It is a no-op if the Alertmanager resource doesn't exist anymore.
+func (c *Operator) expireSilence(ctx context.Context, amKey string, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	am, err := operator.GetObjectFromKey[*monitoringv1.Alertmanager](c.alrtInfs, amKey)
+	if err != nil {
+		return err
+	}
+
+	if am == nil {
+		return nil
+	}
+
+	existing, err := c.silencesClient.getSilence(ctx, am, id)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil || silenceState(existing) == monitoringv1alpha1.SilenceExpired {
+		return nil
+	}
+
+	return c.silencesClient.deleteSilence(ctx, am, id)
+}
+
+func (c *Operator) applySilenceStatus(ctx context.Context, s *monitoringv1alpha1.Silence, statuses []monitoringv1alpha1.SilenceAlertmanagerStatus) error {
+	status := monitoringv1alpha1ac.SilenceStatus()
+	for _, st := range statuses {
+		sac := monitoringv1alpha1ac.SilenceAlertmanagerStatus().
+			WithNamespace(st.Namespace).
+			WithName(st.Name)
+		if st.SilenceID != "" {
+			sac.WithSilenceID(st.SilenceID)
+		}
+		if st.State != "" {
+			sac.WithState(st.State)
+		}
+		if st.Message != "" {
+			sac.WithMessage(st.Message)
+		}
+		status.WithAlertmanagers(sac)
+	}
+
+	_, err := c.mclient.MonitoringV1alpha1().Silences(s.Namespace).ApplyStatus(
+		ctx,
+		monitoringv1alpha1ac.Silence(s.Name, s.Namespace).WithStatus(status),
+		metav1.ApplyOptions{FieldManager: k8s.PrometheusOperatorFieldManager, Force: true},
+	)
+
+	return err
+}
+
+func (c *Operator) patchSilenceFinalizer(ctx context.Context, s *monitoringv1alpha1.Silence, patchFn func([]string, string) ([]byte, error)) error {
+	patch, err := patchFn(s.GetFinalizers(), k8s.SilenceExpirationFinalizerName)
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	_, err = c.mdClient.Resource(monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.SilenceName)).
+		Namespace(s.Namespace).
+		Patch(ctx, s.Name, types.JSONPatchType, patch, metav1.PatchOptions{FieldManager: k8s.PrometheusOperatorFieldManager})
+
+	return err
+}
+
+// makePostableSilence returns the Alertmanager silence corresponding to the
+// Silence object for the given Alertmanager resource.
+// The namespace matcher is enforced according to the
+// AlertmanagerConfigMatcherStrategy of the Alertmanager resource.
+func makePostableSilence(am *monitoringv1.Alertmanager, s *monitoringv1alpha1.Silence) (*models.PostableSilence, error) {
+	amVersion, err := semver.ParseTolerant(operator.StringValOrDefault(am.Spec.Version, operator.DefaultAlertmanagerVersion))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse alertmanager version: %w", err)
+	}
+
+	startsAt := s.CreationTimestamp.Time
+	if s.Spec.StartsAt != nil {
+		startsAt = s.Spec.StartsAt.Time
+	}
+
+	var endsAt time.Time
+	switch {
+	case s.Spec.EndsAt != nil:
+		endsAt = s.Spec.EndsAt.Time
+	case s.Spec.Duration != nil:
+		d, err := model.ParseDuration(string(*s.Spec.Duration))
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+		endsAt = startsAt.Add(time.Duration(d))
+	default:
+		return nil, errors.New("one of endsAt or duration is required")
+	}
+
+	if !endsAt.After(startsAt) {
+		return nil, errors.New("the end time must be after the start time")
+	}
+
+	enforcer := getEnforcer(am.Spec.AlertmanagerConfigMatcherStrategy, amVersion, am.Namespace)
+	matchers := enforcer.processSilenceMatchers(
+		types.NamespacedName{Namespace: s.Namespace, Name: s.Name},
+		slices.Clone(s.Spec.Matchers),
+	)
+
+	out := &models.PostableSilence{
+		Silence: models.Silence{
+			Comment:   ptr.To(s.Spec.Comment),
+			CreatedBy: ptr.To(s.Spec.CreatedBy),
+			StartsAt:  ptr.To(strfmt.DateTime(startsAt)),
+			EndsAt:    ptr.To(strfmt.DateTime(endsAt)),
+		},
+	}
+
+	for _, m := range matchers {
+		if err := m.Validate(); err != nil {
+			return nil, err
+		}
+
+		isRegex, isEqual := m.Regex, true
+		if m.MatchType != "" {
+			isRegex = m.MatchType == monitoringv1alpha1.MatchRegexp || m.MatchType == monitoringv1alpha1.MatchNotRegexp
+			isEqual = m.MatchType == monitoringv1alpha1.MatchEqual || m.MatchType == monitoringv1alpha1.MatchRegexp
+		}
+
+		if isRegex {
+			if _, err := regexp.Compile("^(?:" + m.Value + ")$"); err != nil {
+				return nil, fmt.Errorf("invalid regular expression for matcher %q: %w", m.Name, err)
+			}
+		}
+
+		out.Matchers = append(out.Matchers, &models.Matcher{
+			Name:    ptr.To(m.Name),
+			Value:   ptr.To(m.Value),
+			IsRegex: ptr.To(isRegex),
+			IsEqual: ptr.To(isEqual),
+		})
+	}
+
+	return out, nil
+}
+
+// silenceMatches returns true if the existing silence is equivalent to the
+// desired one.
+func silenceMatches(existing *models.GettableSilence, desired *models.PostableSilence, now time.Time) bool {
+	if ptr.Deref(existing.Comment, "") != ptr.Deref(desired.Comment, "") ||
+		ptr.Deref(existing.CreatedBy, "") != ptr.Deref(desired.CreatedBy, "") {
+		return false
+	}
+
+	if existing.EndsAt == nil || !time.Time(*existing.EndsAt).Equal(time.Time(*desired.EndsAt)) {
+		return false
+	}
+
+	// Alertmanager sets the start time to the current time when it is in the
+	// past so it can only be compared for future start times.
+	if desiredStartsAt := time.Time(*desired.StartsAt); desiredStartsAt.After(now) {
+		if existing.StartsAt == nil || !time.Time(*existing.StartsAt).Equal(desiredStartsAt) {
+			return false
+		}
+	}
+
+	matcherString := func(m *models.Matcher) string {
+		return fmt.Sprintf("%s/%t/%t/%s", ptr.Deref(m.Name, ""), ptr.Deref(m.IsEqual, true), ptr.Deref(m.IsRegex, false), ptr.Deref(m.Value, ""))
+	}
+
+	existingMatchers := make([]string, 0, len(existing.Matchers))
+	for _, m := range existing.Matchers {
+		existingMatchers = append(existingMatchers, matcherString(m))
+	}
+	slices.Sort(existingMatchers)
+
+	desiredMatchers := make([]string, 0, len(desired.Matchers))
+	for _, m := range desired.Matchers {
+		desiredMatchers = append(desiredMatchers, matcherString(m))
+	}
+	slices.Sort(desiredMatchers)
+
+	return slices.Equal(existingMatchers, desiredMatchers)
+}
+
+func silenceState(s *models.GettableSilence) monitoringv1alpha1.SilenceState {
+	if s.Status == nil || s.Status.State == nil {
+		return ""
+	}
+
+	return monitoringv1alpha1.SilenceState(*s.Status.State)
+}
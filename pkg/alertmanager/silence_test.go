@@ -0,0 +1,433 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/alertmanager/api/v2/models"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+var silenceTestTime = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestSilence() *monitoringv1alpha1.Silence {
+	return &monitoringv1alpha1.Silence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "maintenance",
+			Namespace:         "team-a",
+			CreationTimestamp: metav1.NewTime(silenceTestTime),
+		},
+		Spec: monitoringv1alpha1.SilenceSpec{
+			Matchers: []monitoringv1alpha1.Matcher{
+				{Name: "alertname", Value: "Watchdog", MatchType: monitoringv1alpha1.MatchEqual},
+				{Name: "severity", Value: "warning|info", MatchType: monitoringv1alpha1.MatchRegexp},
+				{Name: "cluster", Value: "prod", MatchType: monitoringv1alpha1.MatchNotEqual},
+			},
+			Duration:  ptr.To(monitoringv1.Duration("2h")),
+			Comment:   "planned maintenance",
+			CreatedBy: "jane",
+		},
+	}
+}
+
+func TestMakePostableSilence(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		strategy monitoringv1.AlertmanagerConfigMatcherStrategyType
+		amNS     string
+		silence  func(*monitoringv1alpha1.Silence)
+
+		expectedMatchers []string
+		expectedStart    time.Time
+		expectedEnd      time.Time
+		expectedErr      bool
+	}{
+		{
+			name:     "namespace enforced",
+			strategy: monitoringv1.OnNamespaceConfigMatcherStrategyType,
+			amNS:     "monitoring",
+			expectedMatchers: []string{
+				"alertname/true/false/Watchdog",
+				"severity/true/true/warning|info",
+				"cluster/false/false/prod",
+				"namespace/true/false/team-a",
+			},
+			expectedStart: silenceTestTime,
+			expectedEnd:   silenceTestTime.Add(2 * time.Hour),
+		},
+		{
+			name:     "no enforcement",
+			strategy: monitoringv1.NoneConfigMatcherStrategyType,
+			amNS:     "monitoring",
+			expectedMatchers: []string{
+				"alertname/true/false/Watchdog",
+				"severity/true/true/warning|info",
+				"cluster/false/false/prod",
+			},
+			expectedStart: silenceTestTime,
+			expectedEnd:   silenceTestTime.Add(2 * time.Hour),
+		},
+		{
+			name:     "no enforcement in the Alertmanager namespace",
+			strategy: monitoringv1.OnNamespaceExceptForAlertmanagerNamespaceConfigMatcherStrategyType,
+			amNS:     "team-a",
+			expectedMatchers: []string{
+				"alertname/true/false/Watchdog",
+				"severity/true/true/warning|info",
+				"cluster/false/false/prod",
+			},
+			expectedStart: silenceTestTime,
+			expectedEnd:   silenceTestTime.Add(2 * time.Hour),
+		},
+		{
+			name:     "explicit start and end times",
+			strategy: monitoringv1.NoneConfigMatcherStrategyType,
+			amNS:     "monitoring",
+			silence: func(s *monitoringv1alpha1.Silence) {
+				s.Spec.Matchers = []monitoringv1alpha1.Matcher{{Name: "job", Value: "node", Regex: true}}
+				s.Spec.StartsAt = ptr.To(metav1.NewTime(silenceTestTime.Add(time.Hour)))
+				s.Spec.EndsAt = ptr.To(metav1.NewTime(silenceTestTime.Add(3 * time.Hour)))
+				s.Spec.Duration = nil
+			},
+			expectedMatchers: []string{"job/true/true/node"},
+			expectedStart:    silenceTestTime.Add(time.Hour),
+			expectedEnd:      silenceTestTime.Add(3 * time.Hour),
+		},
+		{
+			name:     "end time before start time",
+			strategy: monitoringv1.NoneConfigMatcherStrategyType,
+			silence: func(s *monitoringv1alpha1.Silence) {
+				s.Spec.EndsAt = ptr.To(metav1.NewTime(silenceTestTime.Add(-time.Hour)))
+				s.Spec.Duration = nil
+			},
+			expectedErr: true,
+		},
+		{
+			name:     "invalid matcher",
+			strategy: monitoringv1.NoneConfigMatcherStrategyType,
+			silence: func(s *monitoringv1alpha1.Silence) {
+				s.Spec.Matchers = []monitoringv1alpha1.Matcher{{Name: "job", Value: "(", MatchType: monitoringv1alpha1.MatchRegexp}}
+			},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: tc.amNS},
+				Spec: monitoringv1.AlertmanagerSpec{
+					AlertmanagerConfigMatcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{Type: tc.strategy},
+				},
+			}
+
+			s := newTestSilence()
+			if tc.silence != nil {
+				tc.silence(s)
+			}
+
+			ps, err := makePostableSilence(am, s)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedMatchers, toMatcherStrings(ps.Matchers))
+			require.Equal(t, "planned maintenance", *ps.Comment)
+			require.Equal(t, "jane", *ps.CreatedBy)
+			require.True(t, tc.expectedStart.Equal(time.Time(*ps.StartsAt)))
+			require.True(t, tc.expectedEnd.Equal(time.Time(*ps.EndsAt)))
+		})
+	}
+}
+
+func toMatcherStrings(matchers models.Matchers) []string {
+	var s []string
+	for _, m := range matchers {
+		s = append(s, *m.Name+"/"+boolString(*m.IsEqual)+"/"+boolString(*m.IsRegex)+"/"+*m.Value)
+	}
+	return s
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+type fakeSilencesClient struct {
+	silences map[string]*models.GettableSilence
+	posted   []*models.PostableSilence
+	deleted  []string
+}
+
+func (f *fakeSilencesClient) getSilence(_ context.Context, _ *monitoringv1.Alertmanager, id string) (*models.GettableSilence, error) {
+	return f.silences[id], nil
+}
+
+func (f *fakeSilencesClient) postSilence(_ context.Context, _ *monitoringv1.Alertmanager, s *models.PostableSilence) (string, error) {
+	f.posted = append(f.posted, s)
+	if s.ID != "" {
+		return s.ID, nil
+	}
+	return "new-id", nil
+}
+
+func (f *fakeSilencesClient) deleteSilence(_ context.Context, _ *monitoringv1.Alertmanager, id string) error {
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func gettableSilence(ps *models.PostableSilence, id string, state monitoringv1alpha1.SilenceState) *models.GettableSilence {
+	return &models.GettableSilence{
+		ID:      ptr.To(id),
+		Silence: ps.Silence,
+		Status:  &models.SilenceStatus{State: ptr.To(string(state))},
+	}
+}
+
+func TestApplySilence(t *testing.T) {
+	am := &monitoringv1.Alertmanager{
+		ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "monitoring"},
+		Spec: monitoringv1.AlertmanagerSpec{
+			AlertmanagerConfigMatcherStrategy: monitoringv1.AlertmanagerConfigMatcherStrategy{
+				Type: monitoringv1.NoneConfigMatcherStrategyType,
+			},
+		},
+	}
+
+	desired, err := makePostableSilence(am, newTestSilence())
+	require.NoError(t, err)
+
+	outdated, err := makePostableSilence(am, newTestSilence())
+	require.NoError(t, err)
+	outdated.Comment = ptr.To("old comment")
+
+	for _, tc := range []struct {
+		name     string
+		now      time.Time
+		status   monitoringv1alpha1.SilenceAlertmanagerStatus
+		silences map[string]*models.GettableSilence
+
+		expectedPost  bool
+		expectedID    string
+		expectedState monitoringv1alpha1.SilenceState
+	}{
+		{
+			name:          "new silence",
+			now:           silenceTestTime.Add(time.Minute),
+			expectedPost:  true,
+			expectedID:    "new-id",
+			expectedState: monitoringv1alpha1.SilenceActive,
+		},
+		{
+			name:   "up-to-date silence",
+			now:    silenceTestTime.Add(time.Minute),
+			status: monitoringv1alpha1.SilenceAlertmanagerStatus{SilenceID: "abc"},
+			silences: map[string]*models.GettableSilence{
+				"abc": gettableSilence(desired, "abc", monitoringv1alpha1.SilenceActive),
+			},
+			expectedID:    "abc",
+			expectedState: monitoringv1alpha1.SilenceActive,
+		},
+		{
+			name:   "outdated silence",
+			now:    silenceTestTime.Add(time.Minute),
+			status: monitoringv1alpha1.SilenceAlertmanagerStatus{SilenceID: "abc"},
+			silences: map[string]*models.GettableSilence{
+				"abc": gettableSilence(outdated, "abc", monitoringv1alpha1.SilenceActive),
+			},
+			expectedPost:  true,
+			expectedID:    "abc",
+			expectedState: monitoringv1alpha1.SilenceActive,
+		},
+		{
+			name:          "lost silence",
+			now:           silenceTestTime.Add(time.Minute),
+			status:        monitoringv1alpha1.SilenceAlertmanagerStatus{SilenceID: "abc"},
+			expectedPost:  true,
+			expectedID:    "new-id",
+			expectedState: monitoringv1alpha1.SilenceActive,
+		},
+		{
+			name:   "silence expired manually",
+			now:    silenceTestTime.Add(time.Minute),
+			status: monitoringv1alpha1.SilenceAlertmanagerStatus{SilenceID: "abc"},
+			silences: map[string]*models.GettableSilence{
+				"abc": gettableSilence(desired, "abc", monitoringv1alpha1.SilenceExpired),
+			},
+			expectedPost:  true,
+			expectedID:    "new-id",
+			expectedState: monitoringv1alpha1.SilenceActive,
+		},
+		{
+			name:          "ended silence",
+			now:           silenceTestTime.Add(3 * time.Hour),
+			expectedState: monitoringv1alpha1.SilenceExpired,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sc := &fakeSilencesClient{silences: tc.silences}
+			c := &Operator{silencesClient: sc}
+
+			st, err := c.applySilence(context.Background(), am, newTestSilence(), tc.status, tc.now)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedPost, len(sc.posted) == 1)
+			require.Equal(t, "monitoring", st.Namespace)
+			require.Equal(t, "main", st.Name)
+			require.Equal(t, tc.expectedID, st.SilenceID)
+			require.Equal(t, tc.expectedState, st.State)
+			require.Empty(t, st.Message)
+		})
+	}
+}
+
+func TestSilenceMatches(t *testing.T) {
+	desired := &models.PostableSilence{
+		Silence: models.Silence{
+			Comment:   ptr.To("comment"),
+			CreatedBy: ptr.To("jane"),
+			StartsAt:  ptr.To(strfmt.DateTime(silenceTestTime)),
+			EndsAt:    ptr.To(strfmt.DateTime(silenceTestTime.Add(time.Hour))),
+			Matchers: models.Matchers{
+				{Name: ptr.To("a"), Value: ptr.To("1"), IsEqual: ptr.To(true), IsRegex: ptr.To(false)},
+				{Name: ptr.To("b"), Value: ptr.To("2"), IsEqual: ptr.To(true), IsRegex: ptr.To(false)},
+			},
+		},
+	}
+
+	// Alertmanager may return the matchers in a different order and updates
+	// the start time when it is in the past.
+	existing := &models.GettableSilence{
+		Silence: models.Silence{
+			Comment:   ptr.To("comment"),
+			CreatedBy: ptr.To("jane"),
+			StartsAt:  ptr.To(strfmt.DateTime(silenceTestTime.Add(time.Minute))),
+			EndsAt:    ptr.To(strfmt.DateTime(silenceTestTime.Add(time.Hour))),
+			Matchers: models.Matchers{
+				{Name: ptr.To("b"), Value: ptr.To("2"), IsEqual: ptr.To(true), IsRegex: ptr.To(false)},
+				{Name: ptr.To("a"), Value: ptr.To("1"), IsEqual: ptr.To(true), IsRegex: ptr.To(false)},
+			},
+		},
+	}
+	require.True(t, silenceMatches(existing, desired, silenceTestTime.Add(time.Minute)))
+
+	existing.Matchers[0].IsEqual = ptr.To(false)
+	require.False(t, silenceMatches(existing, desired, silenceTestTime.Add(time.Minute)))
+	existing.Matchers[0].IsEqual = ptr.To(true)
+
+	// The start time is compared when it is in the future.
+	require.False(t, silenceMatches(existing, desired, silenceTestTime.Add(-time.Minute)))
+
+	existing.EndsAt = ptr.To(strfmt.DateTime(silenceTestTime.Add(2 * time.Hour)))
+	require.False(t, silenceMatches(existing, desired, silenceTestTime.Add(time.Minute)))
+}
+
+func TestAlertmanagerSelectsSilence(t *testing.T) {
+	s := newTestSilence()
+	s.Labels = map[string]string{"team": "a"}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"silences": "enabled"}},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.AlertmanagerSpec
+		amNs     string
+		ns       *corev1.Namespace
+		expected bool
+	}{
+		{
+			name: "nil silence selector",
+			amNs: "team-a",
+			ns:   ns,
+		},
+		{
+			name: "same namespace",
+			spec: monitoringv1.AlertmanagerSpec{
+				SilenceSelector: &metav1.LabelSelector{},
+			},
+			amNs:     "team-a",
+			ns:       ns,
+			expected: true,
+		},
+		{
+			name: "other namespace without namespace selector",
+			spec: monitoringv1.AlertmanagerSpec{
+				SilenceSelector: &metav1.LabelSelector{},
+			},
+			amNs: "monitoring",
+			ns:   ns,
+		},
+		{
+			name: "labels not matching",
+			spec: monitoringv1.AlertmanagerSpec{
+				SilenceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			},
+			amNs: "team-a",
+			ns:   ns,
+		},
+		{
+			name: "namespace selector matching",
+			spec: monitoringv1.AlertmanagerSpec{
+				SilenceSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				SilenceNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"silences": "enabled"}},
+			},
+			amNs:     "monitoring",
+			ns:       ns,
+			expected: true,
+		},
+		{
+			name: "namespace selector not matching",
+			spec: monitoringv1.AlertmanagerSpec{
+				SilenceSelector:          &metav1.LabelSelector{},
+				SilenceNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"silences": "disabled"}},
+			},
+			amNs: "monitoring",
+			ns:   ns,
+		},
+		{
+			name: "unknown namespace",
+			spec: monitoringv1.AlertmanagerSpec{
+				SilenceSelector:          &metav1.LabelSelector{},
+				SilenceNamespaceSelector: &metav1.LabelSelector{},
+			},
+			amNs: "monitoring",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Operator{logger: slog.Default()}
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: tc.amNs},
+				Spec:       tc.spec,
+			}
+
+			require.Equal(t, tc.expected, c.alertmanagerSelectsSilence(am, s, tc.ns))
+		})
+	}
+}
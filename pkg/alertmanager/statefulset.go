@@ -26,6 +26,7 @@ import (
 	"github.com/blang/semver/v4"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -180,6 +181,42 @@ func makeStatefulSet(logger *slog.Logger, am *monitoringv1.Alertmanager, config
 	return statefulset, nil
 }
 
+// makePodDisruptionBudget returns the PodDisruptionBudget for the
+// Alertmanager pods. When the Alertmanager cluster has more than one replica,
+// the budget keeps at least a majority of the peers available so that
+// evictions don't break the gossip quorum. The budget always allows at least
+// one eviction otherwise a cluster with 2 replicas would block node drains.
+func makePodDisruptionBudget(am *monitoringv1.Alertmanager, sset *appsv1.StatefulSet) (*policyv1.PodDisruptionBudget, error) {
+	spec := *am.Spec.PodDisruptionBudget
+	replicas := int(ptr.Deref(am.Spec.Replicas, minReplicas))
+
+	if replicas > 1 {
+		var (
+			minAvailable int
+			err          error
+		)
+		switch {
+		case spec.MinAvailable != nil:
+			minAvailable, err = intstr.GetScaledValueFromIntOrPercent(spec.MinAvailable, replicas, true)
+		case spec.MaxUnavailable != nil:
+			var maxUnavailable int
+			maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(spec.MaxUnavailable, replicas, true)
+			minAvailable = replicas - maxUnavailable
+		}
+		if err != nil {
+			return nil, fmt.Errorf("podDisruptionBudget: %w", err)
+		}
+
+		if quorum := min(replicas/2+1, replicas-1); minAvailable < quorum {
+			spec = monitoringv1.PodDisruptionBudgetSpec{
+				MinAvailable: ptr.To(intstr.FromInt(quorum)),
+			}
+		}
+	}
+
+	return operator.MakePodDisruptionBudget(sset, spec, operator.WithManagingOwner(am)), nil
+}
+
 func makeStatefulSetService(a *monitoringv1.Alertmanager, config Config) *corev1.Service {
 	if a.Spec.PortName == "" {
 		a.Spec.PortName = defaultPortName
@@ -1735,3 +1735,90 @@ func TestMakeStatefulSetSpecDispatchStartDelay(t *testing.T) {
 		})
 	}
 }
+
+func TestMakePodDisruptionBudget(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		replicas int32
+		spec     monitoringv1.PodDisruptionBudgetSpec
+
+		expectedMinAvailable   *intstr.IntOrString
+		expectedMaxUnavailable *intstr.IntOrString
+	}{
+		{
+			name:                   "single replica",
+			replicas:               1,
+			spec:                   monitoringv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt(1))},
+			expectedMaxUnavailable: ptr.To(intstr.FromInt(1)),
+		},
+		{
+			name:                 "two replicas with user-defined minAvailable",
+			replicas:             2,
+			spec:                 monitoringv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt(2))},
+			expectedMinAvailable: ptr.To(intstr.FromInt(2)),
+		},
+		{
+			name:                 "two replicas with maxUnavailable",
+			replicas:             2,
+			spec:                 monitoringv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt(2))},
+			expectedMinAvailable: ptr.To(intstr.FromInt(1)),
+		},
+		{
+			name:                   "two replicas with maxUnavailable of 1",
+			replicas:               2,
+			spec:                   monitoringv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt(1))},
+			expectedMaxUnavailable: ptr.To(intstr.FromInt(1)),
+		},
+		{
+			name:                   "maxUnavailable preserving quorum",
+			replicas:               3,
+			spec:                   monitoringv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt(1))},
+			expectedMaxUnavailable: ptr.To(intstr.FromInt(1)),
+		},
+		{
+			name:                 "maxUnavailable breaking quorum",
+			replicas:             3,
+			spec:                 monitoringv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromInt(2))},
+			expectedMinAvailable: ptr.To(intstr.FromInt(2)),
+		},
+		{
+			name:                 "maxUnavailable percentage breaking quorum",
+			replicas:             5,
+			spec:                 monitoringv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("50%"))},
+			expectedMinAvailable: ptr.To(intstr.FromInt(3)),
+		},
+		{
+			name:                 "minAvailable preserving quorum",
+			replicas:             5,
+			spec:                 monitoringv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromString("60%"))},
+			expectedMinAvailable: ptr.To(intstr.FromString("60%")),
+		},
+		{
+			name:                 "minAvailable breaking quorum",
+			replicas:             4,
+			spec:                 monitoringv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt(1))},
+			expectedMinAvailable: ptr.To(intstr.FromInt(3)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			am := &monitoringv1.Alertmanager{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "ns"},
+				Spec: monitoringv1.AlertmanagerSpec{
+					Replicas:            ptr.To(tc.replicas),
+					PodDisruptionBudget: &tc.spec,
+				},
+			}
+
+			sset, err := makeStatefulSet(nil, am, defaultTestConfig, "", &operator.ShardedSecret{})
+			require.NoError(t, err)
+
+			pdb, err := makePodDisruptionBudget(am, sset)
+			require.NoError(t, err)
+
+			require.Equal(t, sset.Name, pdb.Name)
+			require.Equal(t, sset.Spec.Selector, pdb.Spec.Selector)
+			require.Equal(t, tc.expectedMinAvailable, pdb.Spec.MinAvailable)
+			require.Equal(t, tc.expectedMaxUnavailable, pdb.Spec.MaxUnavailable)
+		})
+	}
+}
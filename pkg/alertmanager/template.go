@@ -0,0 +1,168 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/alertmanager/template"
+	"k8s.io/apimachinery/pkg/types"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// templateActionRe matches the template actions which reference a template
+// by name.
+var templateActionRe = regexp.MustCompile("(\\{\\{-?\\s*(define|template|block)\\s+)(\"[^\"]*\"|`[^`]*`)")
+
+// definedTemplateNames adds the names of the templates defined in the content
+// to the set.
+func definedTemplateNames(content string, defined map[string]struct{}) {
+	for _, m := range templateActionRe.FindAllStringSubmatch(content, -1) {
+		if m[2] == "template" {
+			continue
+		}
+
+		defined[unquoteTemplateName(m[3])] = struct{}{}
+	}
+}
+
+// namespaceTemplateNames renames the templates of the given set to
+// "<namespace>/<name>/<template name>" in the content, both in their
+// definitions and in the references. References to templates which aren't in
+// the set (e.g. the default Alertmanager templates) are left untouched.
+func namespaceTemplateNames(content string, defined map[string]struct{}, crKey types.NamespacedName) string {
+	return templateActionRe.ReplaceAllStringFunc(content, func(s string) string {
+		m := templateActionRe.FindStringSubmatch(s)
+
+		name := unquoteTemplateName(m[3])
+		if _, found := defined[name]; !found {
+			return s
+		}
+
+		return m[1] + strconv.Quote(makeNamespacedString(name, crKey))
+	})
+}
+
+func unquoteTemplateName(s string) string {
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		return unquoted
+	}
+
+	return s
+}
+
+// templateFileName returns the name of the file holding the notification
+// template in the generated configuration secret.
+func templateFileName(crKey types.NamespacedName, key string) string {
+	return fmt.Sprintf("%s_%s_%s", crKey.Namespace, crKey.Name, key)
+}
+
+func templateKey(t monitoringv1.SecretOrConfigMap) string {
+	if t.Secret != nil {
+		return t.Secret.Key
+	}
+
+	return t.ConfigMap.Key
+}
+
+// loadTemplates returns the notification templates referenced by the
+// AlertmanagerConfig resource with the template names namespaced, indexed by
+// file name. Because a template file can reference the templates defined in
+// the other files of the resource, the template names are collected from all
+// the files before being rewritten.
+func loadTemplates(ctx context.Context, store *assets.StoreBuilder, templates []monitoringv1.SecretOrConfigMap, crKey types.NamespacedName) (map[string]string, []string, error) {
+	var (
+		files     = make(map[string]string, len(templates))
+		fileNames = make([]string, 0, len(templates))
+		defined   = map[string]struct{}{}
+	)
+
+	for i, t := range templates {
+		content, err := store.GetKey(ctx, crKey.Namespace, t)
+		if err != nil {
+			return nil, nil, fmt.Errorf("templates[%d]: %w", i, err)
+		}
+
+		fileName := templateFileName(crKey, templateKey(t))
+		if _, found := files[fileName]; found {
+			return nil, nil, fmt.Errorf("templates[%d]: duplicate key %q", i, templateKey(t))
+		}
+
+		files[fileName] = content
+		fileNames = append(fileNames, fileName)
+		definedTemplateNames(content, defined)
+	}
+
+	for fileName, content := range files {
+		files[fileName] = namespaceTemplateNames(content, defined, crKey)
+	}
+
+	return files, fileNames, nil
+}
+
+// checkTemplates verifies that the notification templates referenced by the
+// AlertmanagerConfig resource exist and can be parsed.
+func checkTemplates(ctx context.Context, templates []monitoringv1.SecretOrConfigMap, crKey types.NamespacedName, store *assets.StoreBuilder) error {
+	files, fileNames, err := loadTemplates(ctx, store, templates, crKey)
+	if err != nil {
+		return err
+	}
+
+	for i, fileName := range fileNames {
+		tmpl, err := template.New()
+		if err != nil {
+			return err
+		}
+
+		if err := tmpl.Parse(strings.NewReader(files[fileName])); err != nil {
+			return fmt.Errorf("templates[%d]: failed to parse template: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// convertTemplates adds the notification templates referenced by the
+// AlertmanagerConfig resource to the configuration.
+func (cb *ConfigBuilder) convertTemplates(ctx context.Context, templates []monitoringv1.SecretOrConfigMap, crKey types.NamespacedName) error {
+	files, fileNames, err := loadTemplates(ctx, cb.store, templates, crKey)
+	if err != nil {
+		return err
+	}
+
+	if cb.templateFiles == nil {
+		cb.templateFiles = make(map[string][]byte, len(files))
+	}
+
+	for _, fileName := range fileNames {
+		cb.templateFiles[fileName] = []byte(files[fileName])
+		cb.cfg.Templates = append(cb.cfg.Templates, path.Join(alertmanagerConfigDir, fileName))
+	}
+
+	return nil
+}
+
+// TemplateFiles returns the notification templates loaded from the
+// AlertmanagerConfig resources, indexed by file name.
+func (cb *ConfigBuilder) TemplateFiles() map[string][]byte {
+	return cb.templateFiles
+}
@@ -0,0 +1,157 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alertmanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+func TestNamespaceTemplateNames(t *testing.T) {
+	crKey := types.NamespacedName{Namespace: "ns", Name: "amc"}
+
+	for _, tc := range []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "define and template",
+			content:  `{{ define "title" }}{{ .Status }}{{ end }}{{ define "text" }}{{ template "title" . }}{{ end }}`,
+			expected: `{{ define "ns/amc/title" }}{{ .Status }}{{ end }}{{ define "ns/amc/text" }}{{ template "ns/amc/title" . }}{{ end }}`,
+		},
+		{
+			name:     "trim markers and raw strings",
+			content:  "{{- define `title` -}}x{{- end -}}{{- template \"title\" . -}}",
+			expected: `{{- define "ns/amc/title" -}}x{{- end -}}{{- template "ns/amc/title" . -}}`,
+		},
+		{
+			name:     "block",
+			content:  `{{ block "title" . }}default{{ end }}{{ template "title" . }}`,
+			expected: `{{ block "ns/amc/title" . }}default{{ end }}{{ template "ns/amc/title" . }}`,
+		},
+		{
+			name:     "reference to an undefined template",
+			content:  `{{ define "text" }}{{ template "slack.default.title" . }}{{ end }}`,
+			expected: `{{ define "ns/amc/text" }}{{ template "slack.default.title" . }}{{ end }}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			defined := map[string]struct{}{}
+			definedTemplateNames(tc.content, defined)
+			require.Equal(t, tc.expected, namespaceTemplateNames(tc.content, defined, crKey))
+		})
+	}
+}
+
+func TestLoadTemplatesAcrossFiles(t *testing.T) {
+	crKey := types.NamespacedName{Namespace: "ns", Name: "amc"}
+	kclient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "templates", Namespace: "ns"},
+			Data: map[string]string{
+				"title.tmpl": `{{ define "title" }}{{ .Status }}{{ end }}`,
+				"text.tmpl":  `{{ define "text" }}{{ template "title" . }} {{ template "slack.default.title" . }}{{ end }}`,
+			},
+		},
+	)
+
+	var templates []monitoringv1.SecretOrConfigMap
+	for _, key := range []string{"text.tmpl", "title.tmpl"} {
+		templates = append(templates, monitoringv1.SecretOrConfigMap{
+			ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "templates"},
+				Key:                  key,
+			},
+		})
+	}
+
+	store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+	files, fileNames, err := loadTemplates(context.Background(), store, templates, crKey)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ns_amc_text.tmpl", "ns_amc_title.tmpl"}, fileNames)
+	require.Equal(t, map[string]string{
+		"ns_amc_text.tmpl":  `{{ define "ns/amc/text" }}{{ template "ns/amc/title" . }} {{ template "slack.default.title" . }}{{ end }}`,
+		"ns_amc_title.tmpl": `{{ define "ns/amc/title" }}{{ .Status }}{{ end }}`,
+	}, files)
+}
+
+func TestCheckTemplates(t *testing.T) {
+	crKey := types.NamespacedName{Namespace: "ns", Name: "amc"}
+	kclient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "templates", Namespace: "ns"},
+			Data: map[string]string{
+				"valid.tmpl":   `{{ define "title" }}{{ .Status }}{{ end }}`,
+				"invalid.tmpl": `{{ define "title" }}{{ .Status }}`,
+			},
+		},
+	)
+
+	configMapRef := func(key string) monitoringv1.SecretOrConfigMap {
+		return monitoringv1.SecretOrConfigMap{
+			ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "templates"},
+				Key:                  key,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		name      string
+		templates []monitoringv1.SecretOrConfigMap
+		err       bool
+	}{
+		{
+			name:      "valid template",
+			templates: []monitoringv1.SecretOrConfigMap{configMapRef("valid.tmpl")},
+		},
+		{
+			name:      "invalid template",
+			templates: []monitoringv1.SecretOrConfigMap{configMapRef("invalid.tmpl")},
+			err:       true,
+		},
+		{
+			name:      "missing key",
+			templates: []monitoringv1.SecretOrConfigMap{configMapRef("missing.tmpl")},
+			err:       true,
+		},
+		{
+			name:      "duplicate key",
+			templates: []monitoringv1.SecretOrConfigMap{configMapRef("valid.tmpl"), configMapRef("valid.tmpl")},
+			err:       true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewStoreBuilder(kclient.CoreV1(), kclient.CoreV1())
+
+			err := checkTemplates(context.Background(), tc.templates, crKey, store)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/alertmanager/validation"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 )
 
@@ -41,9 +42,27 @@ func ValidateAlertmanagerConfig(amc *monitoringv1alpha1.AlertmanagerConfig) erro
 		return err
 	}
 
+	if err := validateTemplates(amc.Spec.Templates); err != nil {
+		return err
+	}
+
 	return validateRoute(amc.Spec.Route, receivers, muteTimeIntervals, true)
 }
 
+func validateTemplates(templates []monitoringv1.SecretOrConfigMap) error {
+	for i, t := range templates {
+		if t.Secret == nil && t.ConfigMap == nil {
+			return fmt.Errorf("templates[%d]: one of secret or configMap is required", i)
+		}
+
+		if err := t.Validate(); err != nil {
+			return fmt.Errorf("templates[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]struct{}, error) {
 	var err error
 	receiverNames := make(map[string]struct{})
@@ -117,6 +136,18 @@ func validateReceivers(receivers []monitoringv1alpha1.Receiver) (map[string]stru
 		if err := validateRocketchatConfigs(receiver.RocketChatConfigs); err != nil {
 			return receiverValidationFailedFormat(err)
 		}
+
+		if err := validateJiraConfigs(receiver.JiraConfigs); err != nil {
+			return receiverValidationFailedFormat(err)
+		}
+
+		if err := validateMattermostConfigs(receiver.MattermostConfigs); err != nil {
+			return receiverValidationFailedFormat(err)
+		}
+
+		if err := validateIncidentioConfigs(receiver.IncidentioConfigs); err != nil {
+			return receiverValidationFailedFormat(err)
+		}
 	}
 
 	return receiverNames, nil
@@ -214,6 +245,105 @@ func validateDiscordConfigs(configs []monitoringv1alpha1.DiscordConfig) error {
 	return nil
 }
 
+func validateIncidentioConfigs(configs []monitoringv1alpha1.IncidentioConfig) error {
+	v := func(conf monitoringv1alpha1.IncidentioConfig) error {
+		if _, err := validation.ValidateURL(string(conf.URL)); err != nil {
+			return fmt.Errorf("invalid 'url': %w", err)
+		}
+
+		hasAuthorization := conf.HTTPConfig != nil && conf.HTTPConfig.Authorization != nil
+		if conf.AlertSourceToken != nil && hasAuthorization {
+			return errors.New("'alertSourceToken' and 'httpConfig.authorization' are mutually exclusive")
+		}
+
+		if conf.AlertSourceToken == nil && !hasAuthorization {
+			return errors.New("one of 'alertSourceToken' or 'httpConfig.authorization' must be configured")
+		}
+
+		if err := conf.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("'httpConfig': %w", err)
+		}
+
+		return nil
+	}
+
+	for i, conf := range configs {
+		if err := v(conf); err != nil {
+			return fmt.Errorf("'incidentioConfigs'[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMattermostConfigs(configs []monitoringv1alpha1.MattermostConfig) error {
+	v := func(conf monitoringv1alpha1.MattermostConfig) error {
+		if err := validation.ValidateTemplateURLPtr((*string)(conf.IconURL)); err != nil {
+			return fmt.Errorf("invalid 'iconURL': %w", err)
+		}
+
+		for j, a := range conf.Attachments {
+			for _, u := range []struct {
+				name string
+				url  *monitoringv1alpha1.URL
+			}{
+				{"authorLink", a.AuthorLink},
+				{"authorIcon", a.AuthorIcon},
+				{"titleLink", a.TitleLink},
+				{"thumbURL", a.ThumbURL},
+				{"footerIcon", a.FooterIcon},
+				{"imageURL", a.ImageURL},
+			} {
+				if err := validation.ValidateTemplateURLPtr((*string)(u.url)); err != nil {
+					return fmt.Errorf("'attachments'[%d]: invalid '%s': %w", j, u.name, err)
+				}
+			}
+		}
+
+		if err := conf.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("'httpConfig': %w", err)
+		}
+
+		return nil
+	}
+
+	for i, conf := range configs {
+		if err := v(conf); err != nil {
+			return fmt.Errorf("'mattermostConfigs'[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func validateJiraConfigs(configs []monitoringv1alpha1.JiraConfig) error {
+	v := func(conf monitoringv1alpha1.JiraConfig) error {
+		if err := validation.ValidateURLPtr((*string)(conf.APIURL)); err != nil {
+			return fmt.Errorf("invalid 'apiURL': %w", err)
+		}
+
+		for j, f := range conf.Fields {
+			if len(f.Value.Raw) == 0 {
+				return fmt.Errorf("'fields'[%d]: missing value for key %q", j, f.Key)
+			}
+		}
+
+		if err := conf.HTTPConfig.Validate(); err != nil {
+			return fmt.Errorf("'httpConfig': %w", err)
+		}
+
+		return nil
+	}
+
+	for i, conf := range configs {
+		if err := v(conf); err != nil {
+			return fmt.Errorf("'jiraConfigs'[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 func validateRocketchatConfigs(configs []monitoringv1alpha1.RocketChatConfig) error {
 	v := func(conf monitoringv1alpha1.RocketChatConfig) error {
 		if err := validation.ValidateURLPtr((*string)(conf.APIURL)); err != nil {
@@ -17,8 +17,11 @@ package v1beta1
 import (
 	"testing"
 
+	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/utils/ptr"
 
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
 )
 
@@ -956,3 +959,367 @@ func TestValidateSNSAlertmanagerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateJiraAlertmanagerConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		in        *monitoringv1beta1.AlertmanagerConfig
+		expectErr bool
+	}{
+		{
+			name: "Test validate JiraConfigs - valid",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									APIURL:    new(monitoringv1beta1.URL("https://jira.example.com")),
+									Project:   "OPS",
+									IssueType: "Bug",
+									Fields: []monitoringv1beta1.JiraField{
+										{
+											Key:   "customfield_10001",
+											Value: apiextensionsv1.JSON{Raw: []byte(`"value"`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Test fail to validate JiraConfigs - invalid URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									APIURL:    new(monitoringv1beta1.URL("http://%><invalid.com")),
+									Project:   "OPS",
+									IssueType: "Bug",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate JiraConfigs - missing field value",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "jira",
+							JiraConfigs: []monitoringv1beta1.JiraConfig{
+								{
+									Project:   "OPS",
+									IssueType: "Bug",
+									Fields: []monitoringv1beta1.JiraField{
+										{
+											Key: "customfield_10001",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAlertmanagerConfig(tc.in)
+			if tc.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+
+			if err != nil {
+				if tc.expectErr {
+					return
+				}
+				t.Errorf("got error but expected none -%s", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateMattermostAlertmanagerConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		in        *monitoringv1beta1.AlertmanagerConfig
+		expectErr bool
+	}{
+		{
+			name: "Test validate MattermostConfigs - valid",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "mattermost",
+							MattermostConfigs: []monitoringv1beta1.MattermostConfig{
+								{
+									IconURL: new(monitoringv1beta1.URL("https://example.com/icon.png")),
+									Attachments: []monitoringv1beta1.MattermostAttachment{
+										{
+											TitleLink: new(monitoringv1beta1.URL(`{{ template "mattermost.default.titlelink" . }}`)),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Test fail to validate MattermostConfigs - invalid icon URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "mattermost",
+							MattermostConfigs: []monitoringv1beta1.MattermostConfig{
+								{
+									IconURL: new(monitoringv1beta1.URL("http://%><invalid.com")),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate MattermostConfigs - invalid attachment image URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "mattermost",
+							MattermostConfigs: []monitoringv1beta1.MattermostConfig{
+								{
+									Attachments: []monitoringv1beta1.MattermostAttachment{
+										{
+											ImageURL: new(monitoringv1beta1.URL("{{ invalid")),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAlertmanagerConfig(tc.in)
+			if tc.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+
+			if err != nil {
+				if tc.expectErr {
+					return
+				}
+				t.Errorf("got error but expected none -%s", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateIncidentioAlertmanagerConfig(t *testing.T) {
+	token := &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "incidentio"},
+		Key:                  "token",
+	}
+
+	testCases := []struct {
+		name      string
+		in        *monitoringv1beta1.AlertmanagerConfig
+		expectErr bool
+	}{
+		{
+			name: "Test validate IncidentioConfigs - valid",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "incidentio",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									URL:              "https://api.incident.io/v2/alert_events/http/id",
+									AlertSourceToken: token,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Test fail to validate IncidentioConfigs - invalid URL",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "incidentio",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									URL:              "http://%><invalid.com",
+									AlertSourceToken: token,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate IncidentioConfigs - missing credentials",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "incidentio",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									URL: "https://api.incident.io/v2/alert_events/http/id",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate IncidentioConfigs - token and authorization",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Receivers: []monitoringv1beta1.Receiver{
+						{
+							Name: "incidentio",
+							IncidentioConfigs: []monitoringv1beta1.IncidentioConfig{
+								{
+									URL:              "https://api.incident.io/v2/alert_events/http/id",
+									AlertSourceToken: token,
+									HTTPConfig: &monitoringv1beta1.HTTPConfig{
+										Authorization: &monitoringv1.SafeAuthorization{
+											Credentials: token,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAlertmanagerConfig(tc.in)
+			if tc.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+
+			if err != nil {
+				if tc.expectErr {
+					return
+				}
+				t.Errorf("got error but expected none -%s", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateAlertmanagerConfigTemplates(t *testing.T) {
+	testCases := []struct {
+		name      string
+		in        *monitoringv1beta1.AlertmanagerConfig
+		expectErr bool
+	}{
+		{
+			name: "Test templates",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1.SecretOrConfigMap{
+						{
+							ConfigMap: &v1.ConfigMapKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+								Key:                  "slack.tmpl",
+							},
+						},
+						{
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+								Key:                  "email.tmpl",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Test fail to validate templates - empty reference",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1.SecretOrConfigMap{{}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Test fail to validate templates - both secret and configmap",
+			in: &monitoringv1beta1.AlertmanagerConfig{
+				Spec: monitoringv1beta1.AlertmanagerConfigSpec{
+					Templates: []monitoringv1.SecretOrConfigMap{
+						{
+							ConfigMap: &v1.ConfigMapKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+								Key:                  "slack.tmpl",
+							},
+							Secret: &v1.SecretKeySelector{
+								LocalObjectReference: v1.LocalObjectReference{Name: "templates"},
+								Key:                  "email.tmpl",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateAlertmanagerConfig(tc.in)
+			if tc.expectErr && err == nil {
+				t.Error("expected error but got none")
+			}
+
+			if err != nil {
+				if tc.expectErr {
+					return
+				}
+				t.Errorf("got error but expected none -%s", err.Error())
+			}
+		})
+	}
+}
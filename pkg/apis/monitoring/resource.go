@@ -46,6 +46,12 @@ const (
 	ScrapeConfigsKind = "ScrapeConfig"
 	ScrapeConfigName  = "scrapeconfigs"
 
+	RemoteWritesKind = "RemoteWrite"
+	RemoteWriteName  = "remotewrites"
+
+	SilencesKind = "Silence"
+	SilenceName  = "silences"
+
 	ThanosRulersKind = "ThanosRuler"
 	ThanosRulerName  = "thanosrulers"
 )
@@ -60,6 +66,8 @@ var resourceToKindMap = map[string]string{
 	PrometheusRuleName:     PrometheusRuleKind,
 	ProbeName:              ProbesKind,
 	ScrapeConfigName:       ScrapeConfigsKind,
+	RemoteWriteName:        RemoteWritesKind,
+	SilenceName:            SilencesKind,
 	ThanosRulerName:        ThanosRulersKind,
 }
 
@@ -73,6 +81,8 @@ var kindToResource = map[string]string{
 	PrometheusRuleKind:      PrometheusRuleName,
 	ProbesKind:              ProbeName,
 	ScrapeConfigsKind:       ScrapeConfigName,
+	RemoteWritesKind:        RemoteWriteName,
+	SilencesKind:            SilenceName,
 	ThanosRulersKind:        ThanosRulerName,
 }
 
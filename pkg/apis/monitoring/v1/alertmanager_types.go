@@ -357,6 +357,23 @@ type AlertmanagerSpec struct {
 	// +optional
 	AlertmanagerConfigMatcherStrategy AlertmanagerConfigMatcherStrategy `json:"alertmanagerConfigMatcherStrategy,omitempty"`
 
+	// silenceSelector defines the selector to be used to select the Silence
+	// objects applied to Alertmanager.
+	//
+	// If nil, no Silence object is selected.
+	//
+	// The namespace matchers of the silences are enforced according to
+	// `alertmanagerConfigMatcherStrategy`.
+	//
+	// It requires the `SilenceCustomResourceDefinition` feature gate to be
+	// enabled.
+	// +optional
+	SilenceSelector *metav1.LabelSelector `json:"silenceSelector,omitempty"`
+	// silenceNamespaceSelector defines the namespaces to be selected for
+	// Silence discovery. If nil, only check own namespace.
+	// +optional
+	SilenceNamespaceSelector *metav1.LabelSelector `json:"silenceNamespaceSelector,omitempty"`
+
 	// minReadySeconds defines the minimum number of seconds for which a newly
 	// created pod should be ready without any of its container crashing for it
 	// to be considered available.
@@ -373,6 +390,31 @@ type AlertmanagerSpec struct {
 	// +kubebuilder:validation:Minimum:=0
 	// +optional
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+
+	// podDisruptionBudget defines the PodDisruptionBudget created by the
+	// operator for the Alertmanager pods.
+	//
+	// When the Alertmanager cluster has more than one replica, the operator
+	// ensures that the PodDisruptionBudget keeps a majority of the peers
+	// available: the effective `minAvailable` value is at least
+	// `replicas/2 + 1`, capped to `replicas - 1` so that at least one pod can
+	// always be evicted (e.g. with 2 replicas, `minAvailable` is at least 1).
+	//
+	// If unset, the operator doesn't create any PodDisruptionBudget.
+	//
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// exposure defines how the operator exposes the Alertmanager web
+	// endpoint outside of the cluster with an Ingress or a Gateway API
+	// HTTPRoute object.
+	//
+	// The `PerShard` hostname policy isn't supported.
+	//
+	// If unset, the operator doesn't expose Alertmanager.
+	//
+	// +optional
+	Exposure *Exposure `json:"exposure,omitempty"`
 	// hostAliases Pods configuration
 	// +listType=map
 	// +listMapKey=ip
@@ -191,27 +191,38 @@ type ProbeParam struct {
 }
 
 // ProbeTargets defines how to discover the probed targets.
-// One of the `staticConfig` or `ingress` must be defined.
-// If both are defined, `staticConfig` takes precedence.
+// One of the `staticConfig`, `ingress` or `httpRoute` must be defined.
+// If several are defined, `staticConfig` takes precedence over `ingress`
+// which takes precedence over `httpRoute`.
 // +k8s:openapi-gen=true
 type ProbeTargets struct {
 	// staticConfig defines the static list of targets to probe and the
 	// relabeling configuration.
-	// If `ingress` is also defined, `staticConfig` takes precedence.
+	// If `ingress` or `httpRoute` is also defined, `staticConfig` takes precedence.
 	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#static_config.
 	// +optional
 	StaticConfig *ProbeTargetStaticConfig `json:"staticConfig,omitempty"`
 	// ingress defines the Ingress objects to probe and the relabeling
 	// configuration.
 	// If `staticConfig` is also defined, `staticConfig` takes precedence.
+	// If `httpRoute` is also defined, `ingress` takes precedence.
 	// +optional
 	Ingress *ProbeTargetIngress `json:"ingress,omitempty"`
+	// httpRoute defines the Gateway API HTTPRoute objects to probe and the
+	// relabeling configuration.
+	// If `staticConfig` or `ingress` is also defined, they take precedence.
+	//
+	// It requires the Gateway API HTTPRoute and Gateway resources
+	// (`gateway.networking.k8s.io/v1`) to be installed in the cluster and the
+	// operator to have permissions to list and watch them.
+	// +optional
+	HTTPRoute *ProbeTargetHTTPRoute `json:"httpRoute,omitempty"`
 }
 
 // Validate semantically validates the given ProbeTargets.
 func (it *ProbeTargets) Validate() error {
-	if it.StaticConfig == nil && it.Ingress == nil {
-		return errors.New("at least one of .spec.targets.staticConfig and .spec.targets.ingress is required")
+	if it.StaticConfig == nil && it.Ingress == nil && it.HTTPRoute == nil {
+		return errors.New("at least one of .spec.targets.staticConfig, .spec.targets.ingress and .spec.targets.httpRoute is required")
 	}
 
 	return nil
@@ -255,6 +266,29 @@ type ProbeTargetIngress struct {
 	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
 }
 
+// ProbeTargetHTTPRoute defines the set of Gateway API HTTPRoute objects
+// considered for probing.
+// The operator configures a target for each URL built from the hostnames and
+// path matches of each HTTPRoute object and from the listeners of its parent
+// Gateways.
+// +k8s:openapi-gen=true
+type ProbeTargetHTTPRoute struct {
+	// selector to select the HTTPRoute objects.
+	// +optional
+	Selector metav1.LabelSelector `json:"selector,omitempty"`
+	// namespaceSelector defines from which namespaces to select HTTPRoute objects.
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+	// relabelingConfigs to apply to the label set of the target before it gets
+	// scraped.
+	// The probed URL is available via the `__param_target` label and the
+	// HTTPRoute's namespace and name via the `namespace` and `httproute`
+	// labels.
+	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#relabel_config
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelingConfigs,omitempty"`
+}
+
 // ProberSpec contains specification parameters for the Prober used for probing.
 // +k8s:openapi-gen=true
 type ProberSpec struct {
@@ -219,6 +219,32 @@ type CommonPrometheusFields struct {
 	// +optional
 	ScrapeConfigNamespaceSelector *metav1.LabelSelector `json:"scrapeConfigNamespaceSelector,omitempty"`
 
+	// remoteWriteSelector defines the RemoteWrite resources to be selected for
+	// the remote write configuration. An empty label selector matches all
+	// objects. A null label selector matches no objects.
+	//
+	// The remote write endpoints defined by the selected resources are
+	// appended to the endpoints defined in `spec.remoteWrite`.
+	//
+	// It requires the `RemoteWriteCustomResourceDefinition` feature gate to
+	// be enabled.
+	//
+	// Note that the RemoteWrite custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	RemoteWriteSelector *metav1.LabelSelector `json:"remoteWriteSelector,omitempty"`
+	// remoteWriteNamespaceSelector defines the namespaces to match for RemoteWrite discovery. An empty label selector
+	// matches all namespaces. A null label selector matches the current
+	// namespace only.
+	//
+	// It requires the `RemoteWriteCustomResourceDefinition` feature gate to
+	// be enabled.
+	//
+	// Note that the RemoteWrite custom resource definition is currently at Alpha level.
+	//
+	// +optional
+	RemoteWriteNamespaceSelector *metav1.LabelSelector `json:"remoteWriteNamespaceSelector,omitempty"`
+
 	// version of Prometheus being deployed. The operator uses this information
 	// to generate the Prometheus StatefulSet + configuration files.
 	//
@@ -1236,6 +1262,30 @@ type PrometheusSpec struct {
 	// +optional
 	ShardRetentionPolicy *ShardRetentionPolicy `json:"shardRetentionPolicy,omitempty"`
 
+	// podDisruptionBudget defines the PodDisruptionBudget created by the
+	// operator for the Prometheus pods.
+	//
+	// When Prometheus is sharded, the operator creates one
+	// PodDisruptionBudget per shard and the values apply to the pods of
+	// each shard.
+	//
+	// If unset, the operator doesn't create any PodDisruptionBudget.
+	//
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// exposure defines how the operator exposes the Prometheus web endpoint
+	// outside of the cluster with an Ingress or a Gateway API HTTPRoute
+	// object.
+	//
+	// When Prometheus is sharded, the `PerShard` hostname policy exposes
+	// each shard behind its own hostname.
+	//
+	// If unset, the operator doesn't expose Prometheus.
+	//
+	// +optional
+	Exposure *Exposure `json:"exposure,omitempty"`
+
 	// disableCompaction when true, the Prometheus compaction is disabled.
 	//
 	// When `spec.thanos.objectStorageConfig` or `spec.thanos.objectStorageConfigFile` are defined, the operator's
@@ -1405,7 +1455,7 @@ type ShardRetentionPolicy struct {
 }
 
 // ShardingStrategyMode defines the sharding mode for Prometheus.
-// +kubebuilder:validation:Enum=Address;Topology
+// +kubebuilder:validation:Enum=Address;Topology;ConsistentHash
 type ShardingStrategyMode string
 
 const (
@@ -1418,8 +1468,35 @@ const (
 	//
 	// (Beta) Using this mode requires the `PrometheusTopologySharding` feature gate (enabled by default).
 	TopologyShardingStrategyMode ShardingStrategyMode = "Topology"
+
+	// ConsistentHashShardingStrategyMode distributes targets across a fixed
+	// number of virtual buckets which are assigned to the shards with
+	// rendezvous hashing. When the number of shards changes, only the targets
+	// of the reassigned buckets (about 1/N of the targets) move to another
+	// shard.
+	ConsistentHashShardingStrategyMode ShardingStrategyMode = "ConsistentHash"
 )
 
+// ConsistentHashShardingStrategy defines the configuration for
+// consistent-hash sharding.
+type ConsistentHashShardingStrategy struct {
+	// virtualBuckets defines the number of virtual buckets used to
+	// distribute the targets. A higher value gives a more even distribution
+	// of the targets across the shards at the cost of larger relabeling
+	// configurations.
+	//
+	// If the value is lower than the number of shards, the number of shards
+	// is used instead.
+	//
+	// Changing the value reassigns most of the targets.
+	//
+	// Default: 1024
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16384
+	// +optional
+	VirtualBuckets *int32 `json:"virtualBuckets,omitempty"`
+}
+
 // TopologyShardingStrategy defines the configuration for topology-aware sharding.
 type TopologyShardingStrategy struct {
 	// externalLabelName defines the name of the Prometheus external label used
@@ -1441,8 +1518,10 @@ type TopologyShardingStrategy struct {
 
 // ShardingStrategy defines the sharding strategy for Prometheus.
 // +kubebuilder:validation:XValidation:rule="!has(self.topology) || (has(self.mode) && self.mode == 'Topology')",message="topology can only be defined when mode is set to 'Topology'"
+// +kubebuilder:validation:XValidation:rule="!has(self.consistentHash) || (has(self.mode) && self.mode == 'ConsistentHash')",message="consistentHash can only be defined when mode is set to 'ConsistentHash'"
 type ShardingStrategy struct {
-	// mode defines the sharding mode. Can be 'Address' or 'Topology'.
+	// mode defines the sharding mode. Can be 'Address', 'Topology' or
+	// 'ConsistentHash'.
 	//
 	// 'Address' is the default mode and distributes targets across shards
 	// based on a hash of the target address.
@@ -1452,6 +1531,10 @@ type ShardingStrategy struct {
 	// (Alpha) Using the 'Topology' mode requires the `PrometheusTopologySharding`
 	// feature gate to be enabled.
 	//
+	// 'ConsistentHash' distributes targets based on a hash of the target
+	// address like 'Address' but it minimizes the number of targets moving to
+	// another shard when the number of shards changes.
+	//
 	// +optional
 	Mode *ShardingStrategyMode `json:"mode,omitempty"`
 
@@ -1459,6 +1542,11 @@ type ShardingStrategy struct {
 	// This field is only valid when mode is set to 'Topology'.
 	// +optional
 	Topology *TopologyShardingStrategy `json:"topology,omitempty"`
+
+	// consistentHash defines the configuration for consistent-hash sharding.
+	// This field is only valid when mode is set to 'ConsistentHash'.
+	// +optional
+	ConsistentHash *ConsistentHashShardingStrategy `json:"consistentHash,omitempty"`
 }
 
 // PrometheusStatus is the most recent observed status of the Prometheus cluster.
@@ -2477,6 +2565,63 @@ type ShardStatus struct {
 	// unavailableReplicas defines the Total number of unavailable pods targeted by this shard.
 	// +required
 	UnavailableReplicas int32 `json:"unavailableReplicas"`
+	// health defines the runtime health of the shard as reported by the
+	// HTTP API of its ready pods.
+	//
+	// It is only populated when the `PrometheusRuntimeHealth` feature gate
+	// is enabled and at least one pod of the shard answered.
+	// +optional
+	Health *ShardHealth `json:"health,omitempty"`
+}
+
+// ShardHealth summarizes the runtime information reported by the pods of a
+// shard.
+type ShardHealth struct {
+	// queriedReplicas defines the number of pods which answered the
+	// operator's requests.
+	// +required
+	QueriedReplicas int32 `json:"queriedReplicas"`
+	// configReloadSuccessful is true when the last configuration reload was
+	// successful for all the queried pods.
+	// +required
+	ConfigReloadSuccessful bool `json:"configReloadSuccessful"`
+	// lastConfigReloadTime defines the oldest time of the last successful
+	// configuration reload among the queried pods.
+	// +optional
+	LastConfigReloadTime *metav1.Time `json:"lastConfigReloadTime,omitempty"`
+	// activeTargets defines the highest number of active targets among the
+	// queried pods.
+	// +required
+	ActiveTargets int32 `json:"activeTargets"`
+	// droppedTargets defines the highest number of targets dropped by
+	// relabeling among the queried pods.
+	//
+	// It is computed as the difference between the number of discovered
+	// targets and the number of active targets.
+	// +required
+	DroppedTargets int32 `json:"droppedTargets"`
+	// unhealthyTargets defines the highest number of active targets with a
+	// failed scrape among the queried pods.
+	// +required
+	UnhealthyTargets int32 `json:"unhealthyTargets"`
+	// headSeries defines the highest number of series in the head block
+	// among the queried pods.
+	//
+	// It isn't reported for PrometheusAgent resources.
+	// +optional
+	HeadSeries *int64 `json:"headSeries,omitempty"`
+	// walCorruptions defines the total number of WAL corruptions detected by
+	// the queried pods since they started.
+	// +required
+	WALCorruptions int64 `json:"walCorruptions"`
+	// remoteWriteFailedSamples defines the number of samples which failed to
+	// be sent to the remote write endpoints by the queried pods since the
+	// previous health check.
+	//
+	// It isn't reported for the pods queried for the first time. When it is
+	// greater than zero, the Healthy condition is Degraded.
+	// +optional
+	RemoteWriteFailedSamples *int64 `json:"remoteWriteFailedSamples,omitempty"`
 }
 
 type TSDBSpec struct {
@@ -2695,6 +2840,144 @@ type ScrapeClass struct {
 	//
 	// +optional
 	AttachMetadata *AttachMetadata `json:"attachMetadata,omitempty"`
+
+	// scrapeInterval defines the default interval between scrapes for the
+	// scrape objects using this class.
+	// It will only apply if the scrape resource doesn't specify any interval.
+	//
+	// +optional
+	ScrapeInterval *Duration `json:"scrapeInterval,omitempty"`
+
+	// scrapeTimeout defines the default timeout for the scrape requests of
+	// the scrape objects using this class.
+	// It will only apply if the scrape resource doesn't specify any timeout
+	// and if the value isn't greater than the effective scrape interval.
+	//
+	// +optional
+	ScrapeTimeout *Duration `json:"scrapeTimeout,omitempty"`
+
+	// sampleLimit defines the default per-scrape limit on the number of
+	// scraped samples that will be accepted.
+	// It will only apply if the scrape resource doesn't specify any sample
+	// limit. The `spec.enforcedSampleLimit` field still takes precedence.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	SampleLimit *int64 `json:"sampleLimit,omitempty"`
+
+	// targetLimit defines the default limit on the number of scraped targets
+	// that will be accepted.
+	// It will only apply if the scrape resource doesn't specify any target
+	// limit. The `spec.enforcedTargetLimit` field still takes precedence.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	TargetLimit *int64 `json:"targetLimit,omitempty"`
+
+	// labelLimit defines the default per-scrape limit on the number of
+	// labels that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any label
+	// limit. The `spec.enforcedLabelLimit` field still takes precedence.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	LabelLimit *int64 `json:"labelLimit,omitempty"`
+
+	// labelNameLengthLimit defines the default per-scrape limit on the
+	// length of label names that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any label
+	// name length limit. The `spec.enforcedLabelNameLengthLimit` field
+	// still takes precedence.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	LabelNameLengthLimit *int64 `json:"labelNameLengthLimit,omitempty"`
+
+	// labelValueLengthLimit defines the default per-scrape limit on the
+	// length of label values that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any label
+	// value length limit. The `spec.enforcedLabelValueLengthLimit` field
+	// still takes precedence.
+	//
+	// +kubebuilder:validation:Minimum:=0
+	// +optional
+	LabelValueLengthLimit *int64 `json:"labelValueLengthLimit,omitempty"`
+
+	// bodySizeLimit defines the default limit on the size of uncompressed
+	// response body that will be accepted by Prometheus.
+	// It will only apply if the scrape resource doesn't specify any body
+	// size limit. The `spec.enforcedBodySizeLimit` field still takes
+	// precedence.
+	//
+	// +optional
+	BodySizeLimit *ByteSize `json:"bodySizeLimit,omitempty"`
+
+	// scrapeProtocols defines the default protocols to negotiate during a
+	// scrape.
+	// It will only apply if the scrape resource doesn't specify any scrape
+	// protocols.
+	//
+	// +listType=set
+	// +optional
+	ScrapeProtocols []ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+
+	// honorLabels defines the default value of the `honorLabels` field.
+	//
+	// For ScrapeConfig resources, the `honorLabels` field of the resource
+	// takes precedence when it is set.
+	// It applies to the Probe resources too. For ServiceMonitor and PodMonitor
+	// endpoints, the labels are honored if either the endpoint or the scrape
+	// class enables it: the endpoints can only opt in because they can't
+	// distinguish between an unset field and `false`.
+	//
+	// +optional
+	HonorLabels *bool `json:"honorLabels,omitempty"` // nolint:kubeapilinter
+
+	// honorTimestamps defines the default value of the `honorTimestamps`
+	// field.
+	// It will only apply if the scrape resource doesn't specify any value.
+	//
+	// +optional
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"` // nolint:kubeapilinter
+
+	// The native histogram settings apply individually when the scrape
+	// resource doesn't specify them.
+	//
+	// +optional
+	NativeHistogramConfig `json:",inline"`
+
+	// The proxy settings apply only if the scrape resource doesn't configure
+	// any proxy field. The secrets referenced by `proxyConnectHeader` must
+	// be in the same namespace as the Prometheus object.
+	//
+	// +optional
+	ProxyConfig `json:",inline"`
+
+	// basicAuth defines the default basic authentication settings.
+	// It will only apply if the scrape resource doesn't specify any
+	// authentication method (authorization, basic auth, OAuth2 or bearer
+	// token).
+	// The referenced secrets must be in the same namespace as the Prometheus
+	// object.
+	//
+	// Cannot be set at the same time as `authorization` or `oauth2`.
+	//
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+
+	// oauth2 defines the default OAuth2 settings.
+	// It will only apply if the scrape resource doesn't specify any
+	// authentication method (authorization, basic auth, OAuth2 or bearer
+	// token).
+	// The referenced secrets and config maps must be in the same namespace as
+	// the Prometheus object.
+	//
+	// Cannot be set at the same time as `authorization` or `basicAuth`.
+	//
+	// It requires Prometheus >= v2.27.0.
+	//
+	// +optional
+	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
 }
 
 // TranslationStrategyOption represents a translation strategy option for the OTLP endpoint.
@@ -18,6 +18,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -31,12 +32,16 @@ const (
 // +kubebuilder:resource:categories="prometheus-operator",shortName="ruler"
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".spec.version",description="The version of Thanos Ruler"
 // +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="The number of desired replicas"
+// +kubebuilder:printcolumn:name="Shards",type="integer",JSONPath=".spec.shards",description="The number of desired shards",priority=1
 // +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.availableReplicas",description="The number of ready replicas"
 // +kubebuilder:printcolumn:name="Reconciled",type="string",JSONPath=".status.conditions[?(@.type == 'Reconciled')].status"
 // +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type == 'Available')].status"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="Paused",type="boolean",JSONPath=".status.paused",description="Whether the resource reconciliation is paused or not",priority=1
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.shards,statuspath=.status.shards,selectorpath=.status.selector
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
 
 // The `ThanosRuler` custom resource definition (CRD) defines a desired [Thanos Ruler](https://github.com/thanos-io/thanos/blob/main/docs/components/rule.md) setup to run in a Kubernetes cluster.
 //
@@ -114,9 +119,31 @@ type ThanosRulerSpec struct {
 	Paused bool `json:"paused,omitempty"` // nolint:kubeapilinter
 
 	// replicas defines the number of thanos ruler instances to deploy.
+	//
+	// When `spec.shards` is greater than 1, it is the number of replicas of
+	// each shard.
 	// +optional
 	Replicas *int32 `json:"replicas,omitempty"`
 
+	// shards defines the number of shards to distribute the rule groups onto.
+	//
+	// `spec.replicas` multiplied by `spec.shards` is the total number of Pods
+	// being created. Each shard is deployed as a separate StatefulSet and
+	// evaluates a deterministic subset of the rule groups selected by the
+	// ThanosRuler resource. The assignment of a rule group to a shard is
+	// computed from the hash of the PrometheusRule namespace, name and the
+	// rule group name, hence changing the number of shards moves rule groups
+	// between shards.
+	//
+	// When the value is greater than 1, the shard's pods have the
+	// `thanos_ruler_shard` external label set to the shard's index.
+	//
+	// Default: 1
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Shards *int32 `json:"shards,omitempty"`
+
 	// nodeSelector defines which Nodes the Pods are scheduled on.
 	// +optional
 	//nolint:kubeapilinter
@@ -242,13 +269,28 @@ type ThanosRulerSpec struct {
 
 	// queryEndpoints defines the list of Thanos Query endpoints from which to query metrics.
 	//
-	// For Thanos >= v0.11.0, it is recommended to use `queryConfig` instead.
+	// For Thanos >= v0.11.0, it is recommended to use `queryAPIEndpoints` instead.
 	//
-	// `queryConfig` takes precedence over this field.
+	// `queryConfig` and `queryAPIEndpoints` take precedence over this field.
 	//
 	// +optional
 	QueryEndpoints []string `json:"queryEndpoints,omitempty"`
 
+	// queryAPIEndpoints defines the Thanos Query services from which to query
+	// metrics.
+	//
+	// The operator generates the Thanos query configuration from the list.
+	// The Secrets and ConfigMaps referenced for authentication and TLS must
+	// be in the same namespace as the ThanosRuler object.
+	//
+	// It requires Thanos >= v0.11.0.
+	//
+	// `queryConfig` takes precedence over this field.
+	//
+	// +listType=atomic
+	// +optional
+	QueryAPIEndpoints []ThanosQueryEndpoint `json:"queryAPIEndpoints,omitempty"`
+
 	// queryConfig defines the list of Thanos Query endpoints from which to query metrics.
 	//
 	// The configuration format is defined at https://thanos.io/tip/components/rule.md/#query-api
@@ -257,16 +299,18 @@ type ThanosRulerSpec struct {
 	//
 	// The operator performs no validation of the configuration.
 	//
-	// This field takes precedence over `queryEndpoints`.
+	// This field takes precedence over `queryAPIEndpoints` and `queryEndpoints`.
 	//
 	// +optional
 	QueryConfig *v1.SecretKeySelector `json:"queryConfig,omitempty"`
 
 	// alertmanagersUrl defines the list of Alertmanager endpoints to send alerts to.
 	//
-	// For Thanos >= v0.10.0, it is recommended to use `alertmanagersConfig` instead.
+	// For Thanos >= v0.10.0, it is recommended to use `alertmanagers` or
+	// `alertmanagerSelector` instead.
 	//
-	// `alertmanagersConfig` takes precedence over this field.
+	// `alertmanagersConfig`, `alertmanagers` and `alertmanagerSelector` take
+	// precedence over this field.
 	//
 	// +optional
 	AlertManagersURL []string `json:"alertmanagersUrl,omitempty"`
@@ -278,10 +322,46 @@ type ThanosRulerSpec struct {
 	//
 	// The operator performs no validation of the configuration.
 	//
-	// This field takes precedence over `alertmanagersUrl`.
+	// This field takes precedence over `alertmanagers`, `alertmanagerSelector`
+	// and `alertmanagersUrl`.
 	//
 	// +optional
 	AlertManagersConfig *v1.SecretKeySelector `json:"alertmanagersConfig,omitempty"`
+	// alertmanagers defines the list of Alertmanager endpoints to send alerts to.
+	//
+	// Each endpoint references a Kubernetes service which is resolved using
+	// DNS: a named port is resolved with a DNS SRV query and a numeric port
+	// with a DNS A/AAAA query. It is recommended to reference headless
+	// services so that the alerts are sent to all the Alertmanager replicas.
+	//
+	// The credentials and TLS assets must be in the same namespace as the
+	// ThanosRuler object. The `sigv4`, `relabelings`, `alertRelabelings`,
+	// `enableHttp2` and `bearerTokenFile` fields as well as the proxy fields
+	// other than `proxyUrl` aren't supported by Thanos.
+	//
+	// It requires Thanos >= v0.10.0.
+	//
+	// +listType=atomic
+	// +optional
+	Alertmanagers []AlertmanagerEndpoints `json:"alertmanagers,omitempty"`
+	// alertmanagerSelector defines the Alertmanager objects to send alerts
+	// to. The Alertmanager objects are selected from the namespace of the
+	// ThanosRuler object and each of their pods is resolved from its
+	// hostname under the governing service (`alertmanager-operated` unless
+	// `serviceName` is set). Scaling a selected Alertmanager restarts the
+	// Thanos Ruler pods.
+	//
+	// The selected Alertmanagers are combined with the endpoints defined in
+	// `alertmanagers`. Alertmanagers which listen on localhost only are
+	// ignored and Alertmanagers with web TLS aren't supported.
+	//
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	//
+	// It requires Thanos >= v0.10.0.
+	//
+	// +optional
+	AlertmanagerSelector *metav1.LabelSelector `json:"alertmanagerSelector,omitempty"`
 
 	// ruleSelector defines the PrometheusRule objects to be selected for rule evaluation. An empty
 	// label selector matches all objects. A null label selector matches no
@@ -473,6 +553,26 @@ type ThanosRulerSpec struct {
 	// +optional
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
 
+	// podDisruptionBudget defines the PodDisruptionBudget created by the
+	// operator for the Thanos Ruler pods.
+	//
+	// If unset, the operator doesn't create any PodDisruptionBudget.
+	//
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// exposure defines how the operator exposes the Thanos Ruler web
+	// endpoint outside of the cluster with an Ingress or a Gateway API
+	// HTTPRoute object.
+	//
+	// Thanos Ruler has no external URL: the hostname must be defined
+	// explicitly to route a specific hostname.
+	//
+	// If unset, the operator doesn't expose Thanos Ruler.
+	//
+	// +optional
+	Exposure *Exposure `json:"exposure,omitempty"`
+
 	// alertRelabelConfigs defines the alert relabeling in Thanos Ruler.
 	//
 	// Alert relabel configuration must have the form as specified in the
@@ -563,6 +663,96 @@ type ThanosRulerSpec struct {
 	HostUsers *bool `json:"hostUsers,omitempty"` // nolint:kubeapilinter
 }
 
+// ThanosDNSDiscoveryMode defines how Thanos resolves the address of a
+// service.
+//
+// +kubebuilder:validation:Enum=A;SRV;SRVNoA
+type ThanosDNSDiscoveryMode string
+
+const (
+	// ThanosDNSDiscoveryA resolves the service's name with a DNS A/AAAA
+	// query ("dns+" prefix).
+	ThanosDNSDiscoveryA ThanosDNSDiscoveryMode = "A"
+	// ThanosDNSDiscoverySRV resolves the service's port with a DNS SRV query
+	// and the returned targets with A/AAAA queries ("dnssrv+" prefix).
+	ThanosDNSDiscoverySRV ThanosDNSDiscoveryMode = "SRV"
+	// ThanosDNSDiscoverySRVNoA resolves the service's port with a DNS SRV
+	// query without resolving the returned targets ("dnssrvnoa+" prefix).
+	ThanosDNSDiscoverySRVNoA ThanosDNSDiscoveryMode = "SRVNoA"
+)
+
+// ThanosQueryEndpoint defines a Thanos Query API endpoint exposed by a
+// Kubernetes service.
+//
+// +k8s:openapi-gen=true
+// +kubebuilder:validation:XValidation:rule="!(has(self.basicAuth) && has(self.authorization))",message="basicAuth and authorization are mutually exclusive"
+type ThanosQueryEndpoint struct {
+	// namespace of the service.
+	//
+	// If not set, the namespace of the ThanosRuler object is used.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// name of the service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// port on which the Thanos Query HTTP API is exposed. It can be the name
+	// or the number of the service's port.
+	//
+	// +required
+	Port intstr.IntOrString `json:"port"`
+
+	// dnsDiscovery defines how Thanos Ruler resolves the service's address.
+	//
+	// * `A` resolves the service's name and requires a numeric port.
+	// * `SRV` resolves the service's port name and the returned targets.
+	// * `SRVNoA` resolves the service's port name only.
+	//
+	// If not defined, it defaults to `SRV` when `port` is a name and to `A`
+	// otherwise.
+	//
+	// +optional
+	DNSDiscovery *ThanosDNSDiscoveryMode `json:"dnsDiscovery,omitempty"`
+
+	// scheme defines the HTTP scheme to use when querying the endpoint.
+	//
+	// +optional
+	Scheme *Scheme `json:"scheme,omitempty"`
+
+	// pathPrefix defines the prefix of the HTTP API path.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
+
+	// tlsConfig defines the TLS configuration to use when querying the
+	// endpoint. The `minVersion` and `maxVersion` fields aren't supported.
+	//
+	// +optional
+	TLSConfig *SafeTLSConfig `json:"tlsConfig,omitempty"`
+
+	// basicAuth defines the basic authentication credentials to use when
+	// querying the endpoint.
+	//
+	// Cannot be set at the same time as `authorization`.
+	//
+	// +optional
+	BasicAuth *BasicAuth `json:"basicAuth,omitempty"`
+
+	// authorization defines the credentials to use when querying the
+	// endpoint. Only the `Bearer` type is supported.
+	//
+	// Cannot be set at the same time as `basicAuth`.
+	//
+	// +optional
+	Authorization *SafeAuthorization `json:"authorization,omitempty"`
+}
+
 // ThanosRulerWebSpec defines the configuration of the ThanosRuler web server.
 // +k8s:openapi-gen=true
 type ThanosRulerWebSpec struct {
@@ -599,6 +789,12 @@ type ThanosRulerStatus struct {
 	// +listMapKey=type
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// shards defines the most recently observed number of shards.
+	// +optional
+	Shards int32 `json:"shards,omitempty"`
+	// selector used to match the pods targeted by this ThanosRuler resource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
 }
 
 func (tr *ThanosRuler) ExpectedReplicas() int {
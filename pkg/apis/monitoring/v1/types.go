@@ -175,7 +175,7 @@ type ObjectReference struct {
 	Group string `json:"group"`
 	// resource of the referent.
 	// +required
-	// +kubebuilder:validation:Enum=prometheusrules;servicemonitors;podmonitors;probes;scrapeconfigs
+	// +kubebuilder:validation:Enum=prometheusrules;servicemonitors;podmonitors;probes;scrapeconfigs;remotewrites
 	Resource string `json:"resource"`
 	// namespace of the referent.
 	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/
@@ -282,6 +282,35 @@ const (
 	// - False: the controller rejected the configuration due to an error.
 	// - Unknown: the operator couldn't determine the condition status.
 	Accepted ConditionType = "Accepted"
+	// StorageResized indicates whether the persistent volume claims of the
+	// workload have the storage size requested by the volume claim template.
+	// It is only reported when the operator is allowed to expand the
+	// persistent volume claims.
+	// The possible status values for this condition type are:
+	// - True: all persistent volume claims have the requested capacity.
+	// - False: the expansion is in progress or failed (the reason and message give more details).
+	// - Unknown: the operator couldn't determine the condition status.
+	StorageResized ConditionType = "StorageResized"
+	// Healthy indicates whether the pods of the workload report a healthy
+	// runtime state (e.g. the last configuration reload succeeded).
+	// It is only reported for Prometheus and PrometheusAgent resources when
+	// the `PrometheusRuntimeHealth` feature gate is enabled.
+	// The possible status values for this condition type are:
+	// - True: all the ready pods are healthy.
+	// - Degraded: some pods detected recoverable errors (e.g. WAL corruptions
+	//   or samples which failed to be sent to the remote write endpoints).
+	// - False: at least one pod failed to reload its configuration.
+	// - Unknown: the operator couldn't query the pods.
+	Healthy ConditionType = "Healthy"
+	// ConfigReloaded indicates whether the pods of the workload loaded the
+	// latest configuration successfully.
+	// It is only reported for Prometheus and PrometheusAgent resources when
+	// the `PrometheusRuntimeHealth` feature gate is enabled.
+	// The possible status values for this condition type are:
+	// - True: all the ready pods reloaded their configuration successfully.
+	// - False: at least one pod failed to reload its configuration.
+	// - Unknown: the operator couldn't query the pods.
+	ConfigReloaded ConditionType = "ConfigReloaded"
 )
 
 // +kubebuilder:validation:MinLength=1
@@ -1018,6 +1047,181 @@ type RollingUpdateStatefulSetStrategy struct {
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"varint,2,opt,name=maxUnavailable"`
 }
 
+// PodDisruptionBudgetSpec defines the PodDisruptionBudget managed by the
+// operator for the pods of a workload.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.minAvailable) != has(self.maxUnavailable)",message="exactly one of minAvailable and maxUnavailable must be set"
+type PodDisruptionBudgetSpec struct {
+	// minAvailable defines the minimum number of pods that must remain
+	// available after an eviction. The value can be an absolute number (ex:
+	// 5) or a percentage of desired pods (ex: 10%).
+	//
+	// It is mutually exclusive with `maxUnavailable`.
+	//
+	//	+kubebuilder:validation:XIntOrString
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// maxUnavailable defines the maximum number of pods that can be
+	// unavailable after an eviction. The value can be an absolute number (ex:
+	// 5) or a percentage of desired pods (ex: 10%).
+	//
+	// It is mutually exclusive with `minAvailable`.
+	//
+	//	+kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ExposureHostnamePolicy defines which hostnames are exposed by the operator.
+//
+// +kubebuilder:validation:Enum=Shared;PerShard;PerReplica
+type ExposureHostnamePolicy string
+
+const (
+	// SharedExposureHostnamePolicy exposes all the pods behind a single
+	// hostname.
+	SharedExposureHostnamePolicy ExposureHostnamePolicy = "Shared"
+
+	// PerShardExposureHostnamePolicy additionally exposes the pods of each
+	// shard behind the `shard-<shard number>.<hostname>` hostname.
+	PerShardExposureHostnamePolicy ExposureHostnamePolicy = "PerShard"
+
+	// PerReplicaExposureHostnamePolicy additionally exposes each pod behind
+	// the `<pod name>.<hostname>` hostname.
+	PerReplicaExposureHostnamePolicy ExposureHostnamePolicy = "PerReplica"
+)
+
+// Exposure defines how the operator exposes the web endpoint of the pods
+// outside of the cluster.
+//
+// The operator creates a Service selecting the pods (one per shard or per
+// replica depending on `hostnamePolicy`) and either an Ingress or a Gateway
+// API HTTPRoute object routing the traffic to the Service(s). The objects are
+// owned by the workload resource and deleted with it.
+//
+// The exposed path is the path of the external URL (or the external prefix
+// for ThanosRuler) if defined, otherwise the route prefix. When the exposed
+// path differs from the route prefix, the HTTPRoute object rewrites the path
+// prefix; Ingress objects don't support it.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.ingress) != has(self.httpRoute)",message="exactly one of ingress and httpRoute must be set"
+type Exposure struct {
+	// ingress defines the Ingress object created by the operator.
+	//
+	// It is mutually exclusive with `httpRoute`.
+	//
+	// +optional
+	Ingress *IngressExposure `json:"ingress,omitempty"`
+
+	// httpRoute defines the Gateway API HTTPRoute object created by the
+	// operator.
+	//
+	// It is mutually exclusive with `ingress`.
+	//
+	// It requires the Gateway API CRDs to be installed in the cluster.
+	//
+	// +optional
+	HTTPRoute *HTTPRouteExposure `json:"httpRoute,omitempty"`
+
+	// hostname defines the hostname routed to the pods.
+	//
+	// If unset, the operator uses the host of the external URL. If neither
+	// are defined, the Ingress or HTTPRoute object matches any hostname.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// hostnamePolicy defines the additional hostnames exposed by the
+	// operator:
+	// * `Shared` (default): all the pods are exposed behind `hostname`.
+	// * `PerShard`: the pods of each shard are also exposed behind
+	// `shard-<shard number>.<hostname>`. Not supported for Alertmanager.
+	// * `PerReplica`: each pod is also exposed behind `<pod name>.<hostname>`.
+	//
+	// `PerShard` and `PerReplica` require a hostname.
+	//
+	// +optional
+	HostnamePolicy *ExposureHostnamePolicy `json:"hostnamePolicy,omitempty"`
+
+	// labels defines additional labels added to the Ingress or HTTPRoute
+	// object.
+	//
+	// +optional
+	//nolint:kubeapilinter
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// annotations defines additional annotations added to the Ingress or
+	// HTTPRoute object.
+	//
+	// +optional
+	//nolint:kubeapilinter
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// IngressExposure defines the Ingress object created by the operator.
+type IngressExposure struct {
+	// ingressClassName defines the name of the IngressClass resource.
+	//
+	// If unset, the default IngressClass of the cluster is used.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// tlsSecretName defines the name of the Secret holding the TLS
+	// certificate for the exposed hostnames. The Secret must be in the same
+	// namespace as the workload.
+	//
+	// If unset, TLS isn't configured.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	TLSSecretName *string `json:"tlsSecretName,omitempty"`
+}
+
+// HTTPRouteExposure defines the Gateway API HTTPRoute object created by the
+// operator.
+type HTTPRouteExposure struct {
+	// parentRefs defines the Gateways that the HTTPRoute attaches to.
+	//
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	ParentRefs []GatewayParentReference `json:"parentRefs"`
+}
+
+// GatewayParentReference references a Gateway API Gateway object.
+type GatewayParentReference struct {
+	// name defines the name of the Gateway.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// namespace defines the namespace of the Gateway.
+	//
+	// If unset, the namespace of the workload is used.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// sectionName defines the name of the Gateway's listener.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	SectionName *string `json:"sectionName,omitempty"`
+
+	// port defines the port of the Gateway's listener.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
 // StatefulSetUpdateStrategyType is a string enumeration type that enumerates
 // all possible update strategies for the StatefulSet pods.
 //
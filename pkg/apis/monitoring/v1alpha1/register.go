@@ -53,6 +53,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&PrometheusAgentList{},
 		&ScrapeConfig{},
 		&ScrapeConfigList{},
+		&RemoteWrite{},
+		&RemoteWriteList{},
+		&Silence{},
+		&SilenceList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
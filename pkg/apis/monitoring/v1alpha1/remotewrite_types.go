@@ -0,0 +1,95 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	RemoteWritesKind   = "RemoteWrite"
+	RemoteWriteName    = "remotewrites"
+	RemoteWriteKindKey = "remotewrite"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="rw"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+
+// RemoteWrite defines a namespaced remote write endpoint to be aggregated
+// into the configuration of the Prometheus and PrometheusAgent resources
+// which select it.
+//
+// The fields which would give access to the Prometheus filesystem
+// (`bearerTokenFile`, `authorization.credentialsFile`, `sigv4.profile` and
+// the file-based TLS settings) as well as the deprecated `bearerToken` field
+// aren't supported. The authentication methods which rely on the Prometheus
+// identity (the `managedIdentity`, `sdk` and `workloadIdentity` Azure AD
+// methods and the Sigv4 method without `accessKey` and `secretKey`) aren't
+// supported either.
+//
+// The `name` field is ignored: the remote write queue is named after the
+// namespace and the name of the RemoteWrite object (`<namespace>/<name>`)
+// to guarantee that the names are unique in the Prometheus configuration.
+type RemoteWrite struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the remote write endpoint.
+	// +required
+	Spec v1.RemoteWriteSpec `json:"spec"`
+	// status defines the status subresource. It is under active development and is updated only when the
+	// "StatusForConfigurationResources" feature gate is enabled.
+	//
+	// Most recent observed status of the RemoteWrite. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status v1.ConfigResourceStatus `json:"status,omitempty,omitzero"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *RemoteWrite) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+func (l *RemoteWrite) Bindings() []v1.WorkloadBinding {
+	return l.Status.Bindings
+}
+
+// RemoteWriteList is a list of RemoteWrites.
+// +k8s:openapi-gen=true
+type RemoteWriteList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of RemoteWrites
+	// +required
+	Items []RemoteWrite `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *RemoteWriteList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
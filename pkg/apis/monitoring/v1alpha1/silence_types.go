@@ -0,0 +1,162 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const (
+	SilencesKind   = "Silence"
+	SilenceName    = "silences"
+	SilenceKindKey = "silence"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator"
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+
+// Silence defines a silence applied by the operator to the Alertmanager
+// resources which select it.
+//
+// The operator re-creates the silence when it has expired or disappeared
+// from Alertmanager before its end time (for instance after the loss of the
+// Alertmanager storage) and expires it when the object is deleted.
+type Silence struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ObjectMeta as the metadata that all persisted resources.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// spec defines the specification of the silence.
+	// +required
+	Spec SilenceSpec `json:"spec"`
+	// status defines the most recent observed status of the Silence. Read-only.
+	// More info:
+	// https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status SilenceStatus `json:"status,omitempty,omitzero"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *Silence) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
+
+// SilenceSpec defines the specification of a Silence.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.endsAt) && has(self.duration))",message="endsAt and duration are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="has(self.endsAt) || has(self.duration)",message="one of endsAt or duration is required"
+type SilenceSpec struct {
+	// matchers defines the list of matchers that the alerts have to fulfill
+	// to be silenced.
+	//
+	// Depending on the `alertmanagerConfigMatcherStrategy` of the
+	// Alertmanager resource, the operator adds a matcher on the `namespace`
+	// label with the namespace of the Silence object.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
+	// +required
+	Matchers []Matcher `json:"matchers"`
+	// startsAt defines the time at which the silence starts.
+	// If not defined, the silence starts at the creation time of the object.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+	// endsAt defines the time at which the silence ends.
+	// It is mutually exclusive with `duration`.
+	// +optional
+	EndsAt *metav1.Time `json:"endsAt,omitempty"`
+	// duration defines how long the silence lasts from its start time.
+	// It is mutually exclusive with `endsAt`.
+	// +optional
+	Duration *v1.Duration `json:"duration,omitempty"`
+	// comment defines the comment of the silence.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Comment string `json:"comment"`
+	// createdBy defines the author of the silence.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	CreatedBy string `json:"createdBy"`
+}
+
+// SilenceState is the state of a silence in Alertmanager.
+// +kubebuilder:validation:Enum=pending;active;expired
+type SilenceState string
+
+const (
+	// SilencePending means that the silence will become active in the future.
+	SilencePending SilenceState = "pending"
+	// SilenceActive means that the silence is active.
+	SilenceActive SilenceState = "active"
+	// SilenceExpired means that the silence has ended.
+	SilenceExpired SilenceState = "expired"
+)
+
+// SilenceStatus defines the observed state of a Silence.
+type SilenceStatus struct {
+	// alertmanagers defines the list of Alertmanager resources which apply
+	// the silence.
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	// +optional
+	Alertmanagers []SilenceAlertmanagerStatus `json:"alertmanagers,omitempty"`
+}
+
+// SilenceAlertmanagerStatus defines the state of a silence in a given
+// Alertmanager resource.
+type SilenceAlertmanagerStatus struct {
+	// namespace defines the namespace of the Alertmanager resource.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace"`
+	// name defines the name of the Alertmanager resource.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+	// silenceID defines the identifier of the silence in Alertmanager.
+	// +optional
+	SilenceID string `json:"silenceID,omitempty"`
+	// state defines the state of the silence in Alertmanager.
+	// +optional
+	State SilenceState `json:"state,omitempty"`
+	// message defines the reason why the silence couldn't be applied.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// SilenceList is a list of Silences.
+// +k8s:openapi-gen=true
+type SilenceList struct {
+	// TypeMeta defines the versioned schema of this representation of an object.
+	metav1.TypeMeta `json:",inline"`
+	// metadata defines ListMeta as metadata for collection responses.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of Silences
+	// +required
+	Items []Silence `json:"items"`
+}
+
+// DeepCopyObject implements the runtime.Object interface.
+func (l *SilenceList) DeepCopyObject() runtime.Object {
+	return l.DeepCopy()
+}
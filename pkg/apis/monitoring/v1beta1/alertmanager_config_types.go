@@ -94,6 +94,19 @@ type AlertmanagerConfigSpec struct {
 	// timeIntervals defines the list of timeIntervals specifying when the routes should be muted.
 	// +optional
 	TimeIntervals []TimeInterval `json:"timeIntervals,omitempty"`
+	// templates defines the custom notification templates. The ConfigMaps
+	// and Secrets must be in the same namespace as the AlertmanagerConfig
+	// resource.
+	//
+	// To avoid conflicts between resources, the templates defined in these
+	// files (with `define` and `block` actions) are renamed to
+	// `<namespace>/<name>/<template name>` where `<namespace>` and `<name>`
+	// are the namespace and name of the AlertmanagerConfig resource. The
+	// references within the files are updated accordingly while the
+	// receivers should use the prefixed name (e.g. `{{ template
+	// "default/example/slack.title" . }}`).
+	// +optional
+	Templates []monitoringv1.SecretOrConfigMap `json:"templates,omitempty"`
 }
 
 // Route defines a node in the routing tree.
@@ -220,6 +233,18 @@ type Receiver struct {
 	// It requires Alertmanager >= 0.28.0.
 	// +optional
 	RocketChatConfigs []RocketChatConfig `json:"rocketchatConfigs,omitempty"`
+	// jiraConfigs defines the list of Jira configurations.
+	// It requires Alertmanager >= 0.28.0.
+	// +optional
+	JiraConfigs []JiraConfig `json:"jiraConfigs,omitempty"`
+	// mattermostConfigs defines the list of Mattermost configurations.
+	// It requires Alertmanager >= 0.30.0.
+	// +optional
+	MattermostConfigs []MattermostConfig `json:"mattermostConfigs,omitempty"`
+	// incidentioConfigs defines the list of incident.io configurations.
+	// It requires Alertmanager >= 0.29.0.
+	// +optional
+	IncidentioConfigs []IncidentioConfig `json:"incidentioConfigs,omitempty"`
 }
 
 // PagerDutyConfig configures notifications via PagerDuty.
@@ -1336,6 +1361,268 @@ type RocketChatActionConfig struct {
 	Msg *string `json:"msg,omitempty"`
 }
 
+// JiraConfig configures notifications via Jira.
+// See https://prometheus.io/docs/alerting/latest/configuration/#jira_config
+// It requires Alertmanager >= 0.28.0.
+type JiraConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"` // nolint:kubeapilinter
+	// apiURL defines the URL of the Jira API.
+	// If not specified, the global Jira API URL of the Alertmanager
+	// configuration is used.
+	// +optional
+	APIURL *URL `json:"apiURL,omitempty"`
+	// apiType defines the Jira API type.
+	// When set to `auto`, Alertmanager picks the API type from the API URL.
+	// It requires Alertmanager >= 0.29.0.
+	// +kubebuilder:validation:Enum=auto;cloud;datacenter
+	// +optional
+	APIType *string `json:"apiType,omitempty"`
+	// project defines the project key where issues are created.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Project string `json:"project"`
+	// issueType defines the type of the issue (e.g. Bug).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	IssueType string `json:"issueType"`
+	// summary defines the template for the issue summary.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Summary *string `json:"summary,omitempty"`
+	// description defines the template for the issue description.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Description *string `json:"description,omitempty"`
+	// priority defines the template for the issue priority.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Priority *string `json:"priority,omitempty"`
+	// labels defines the labels to be added to the issue.
+	// +listType=set
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// fields defines custom fields to be set on the issue.
+	// The value of each field can be any valid JSON value, for instance
+	// `{"value": "green"}` for a select list.
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Fields []JiraField `json:"fields,omitempty"`
+	// reopenTransition defines the name of the workflow transition to reopen
+	// an issue. It should be set when resolveTransition is set.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ReopenTransition *string `json:"reopenTransition,omitempty"`
+	// resolveTransition defines the name of the workflow transition to
+	// resolve an issue. It should be set when reopenTransition is set.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ResolveTransition *string `json:"resolveTransition,omitempty"`
+	// wontFixResolution defines the resolution which denotes that an issue
+	// shouldn't be reopened.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	WontFixResolution *string `json:"wontFixResolution,omitempty"`
+	// reopenDuration defines the duration after which a resolved issue isn't
+	// reopened but a new issue is created instead.
+	// +optional
+	ReopenDuration *monitoringv1.Duration `json:"reopenDuration,omitempty"`
+	// httpConfig defines the HTTP client configuration for Jira API requests.
+	// It is used to configure the API credentials (e.g. basic authentication
+	// or authorization header) from Secrets.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// JiraField defines a custom field of a Jira issue.
+type JiraField struct {
+	// key defines the key of the custom field (e.g. `customfield_10000`).
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Key string `json:"key"`
+	// value defines the value of the custom field.
+	// +required
+	Value apiextensionsv1.JSON `json:"value"`
+}
+
+// MattermostConfig configures notifications via Mattermost.
+// See https://prometheus.io/docs/alerting/latest/configuration/#mattermost_config
+// It requires Alertmanager >= 0.30.0.
+type MattermostConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"` // nolint:kubeapilinter
+	// webhookURL defines the Mattermost incoming webhook URL.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// If not specified, the global Mattermost webhook URL of the Alertmanager
+	// configuration is used (it requires Alertmanager >= 0.32.0).
+	// +optional
+	WebhookURL *v1.SecretKeySelector `json:"webhookURL,omitempty"`
+	// channel overrides the channel configured for the incoming webhook.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Channel *string `json:"channel,omitempty"`
+	// username overrides the username configured for the incoming webhook.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Username *string `json:"username,omitempty"`
+	// text defines the template of the message text.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// iconURL overrides the profile picture of the message sender.
+	// +optional
+	IconURL *URL `json:"iconURL,omitempty"`
+	// iconEmoji overrides the profile picture of the message sender with an
+	// emoji.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	IconEmoji *string `json:"iconEmoji,omitempty"`
+	// attachments defines the message attachments.
+	// +optional
+	Attachments []MattermostAttachment `json:"attachments,omitempty"`
+	// props defines the message properties.
+	// +optional
+	Props *MattermostProps `json:"props,omitempty"`
+	// priority defines the message priority.
+	// +optional
+	Priority *MattermostPriority `json:"priority,omitempty"`
+	// httpConfig defines the HTTP client configuration for Mattermost requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
+// MattermostAttachment defines a Mattermost message attachment.
+// See https://developers.mattermost.com/integrate/reference/message-attachments/
+type MattermostAttachment struct {
+	// fallback defines a plain-text summary of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Fallback *string `json:"fallback,omitempty"`
+	// color defines the color of the left border of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Color *string `json:"color,omitempty"`
+	// pretext defines the text displayed above the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Pretext *string `json:"pretext,omitempty"`
+	// text defines the text of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Text *string `json:"text,omitempty"`
+	// authorName defines the name of the author.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	AuthorName *string `json:"authorName,omitempty"`
+	// authorLink defines the URL linked from the author name.
+	// +optional
+	AuthorLink *URL `json:"authorLink,omitempty"`
+	// authorIcon defines the URL of the author icon.
+	// +optional
+	AuthorIcon *URL `json:"authorIcon,omitempty"`
+	// title defines the title of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Title *string `json:"title,omitempty"`
+	// titleLink defines the URL linked from the title.
+	// +optional
+	TitleLink *URL `json:"titleLink,omitempty"`
+	// fields defines the fields displayed in a table inside the attachment.
+	// +optional
+	Fields []MattermostField `json:"fields,omitempty"`
+	// thumbURL defines the URL of the thumbnail image.
+	// +optional
+	ThumbURL *URL `json:"thumbURL,omitempty"`
+	// footer defines the footer text of the attachment.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Footer *string `json:"footer,omitempty"`
+	// footerIcon defines the URL of the footer icon.
+	// +optional
+	FooterIcon *URL `json:"footerIcon,omitempty"`
+	// imageURL defines the URL of the image displayed inside the attachment.
+	// +optional
+	ImageURL *URL `json:"imageURL,omitempty"`
+}
+
+// MattermostField defines a field of a Mattermost message attachment.
+type MattermostField struct {
+	// title defines the title of the field.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Title string `json:"title"`
+	// value defines the value of the field.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Value string `json:"value"`
+	// short defines whether the field is displayed side by side with other
+	// fields.
+	// +optional
+	Short *bool `json:"short,omitempty"` // nolint:kubeapilinter
+}
+
+// MattermostProps defines the properties of a Mattermost message.
+type MattermostProps struct {
+	// card defines the Markdown-formatted text displayed in the message
+	// details side panel.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Card *string `json:"card,omitempty"`
+}
+
+// MattermostPriority defines the priority of a Mattermost message.
+type MattermostPriority struct {
+	// priority defines the priority label of the message.
+	// +kubebuilder:validation:Enum=important;urgent
+	// +required
+	Priority string `json:"priority"`
+	// requestedAck defines whether an acknowledgement is requested from the
+	// recipients.
+	// +optional
+	RequestedAck *bool `json:"requestedAck,omitempty"` // nolint:kubeapilinter
+	// persistentNotifications defines whether recipients are notified
+	// repeatedly until they acknowledge the message. It only applies to
+	// urgent messages.
+	// +optional
+	PersistentNotifications *bool `json:"persistentNotifications,omitempty"` // nolint:kubeapilinter
+}
+
+// IncidentioConfig configures notifications via incident.io.
+// See https://prometheus.io/docs/alerting/latest/configuration/#incidentio_config
+// It requires Alertmanager >= 0.29.0.
+type IncidentioConfig struct {
+	// sendResolved defines whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"` // nolint:kubeapilinter
+	// url defines the URL of the incident.io alert source which receives
+	// the notifications.
+	// +required
+	URL URL `json:"url"`
+	// alertSourceToken defines the token used to authenticate with the
+	// incident.io alert source.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// It is mutually exclusive with `httpConfig.authorization`.
+	// +optional
+	AlertSourceToken *v1.SecretKeySelector `json:"alertSourceToken,omitempty"`
+	// maxAlerts defines the maximum number of alerts to be sent per incident.io message.
+	// When 0, all alerts are included in the payload.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxAlerts int32 `json:"maxAlerts,omitempty"`
+	// timeout defines the maximum time allowed to invoke the incident.io
+	// alert source.
+	// +optional
+	Timeout *monitoringv1.Duration `json:"timeout,omitempty"`
+	// httpConfig defines the HTTP client configuration for incident.io requests.
+	// +optional
+	HTTPConfig *HTTPConfig `json:"httpConfig,omitempty"`
+}
+
 // InhibitRule defines an inhibition rule that allows to mute alerts when other
 // alerts are already firing.
 // See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
@@ -1439,6 +1726,10 @@ func (l *AlertmanagerConfig) DeepCopyObject() runtime.Object {
 	return l.DeepCopy()
 }
 
+func (l *AlertmanagerConfig) Bindings() []monitoringv1.WorkloadBinding {
+	return l.Status.Bindings
+}
+
 // DeepCopyObject implements the runtime.Object interface.
 func (l *AlertmanagerConfigList) DeepCopyObject() runtime.Object {
 	return l.DeepCopy()
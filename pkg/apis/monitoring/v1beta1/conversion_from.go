@@ -328,6 +328,122 @@ func convertRocketchatConfigFrom(in v1alpha1.RocketChatConfig) RocketChatConfig
 	}
 }
 
+func convertIncidentioConfigFrom(in v1alpha1.IncidentioConfig) IncidentioConfig {
+	return IncidentioConfig{
+		SendResolved:     in.SendResolved,
+		URL:              URL(in.URL),
+		AlertSourceToken: in.AlertSourceToken,
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
+func convertMattermostFieldsFrom(in []v1alpha1.MattermostField) []MattermostField {
+	if in == nil {
+		return nil
+	}
+	out := make([]MattermostField, len(in))
+	for i, field := range in {
+		out[i] = MattermostField{
+			Title: field.Title,
+			Value: field.Value,
+			Short: field.Short,
+		}
+	}
+	return out
+}
+
+func convertMattermostAttachmentsFrom(in []v1alpha1.MattermostAttachment) []MattermostAttachment {
+	if in == nil {
+		return nil
+	}
+	out := make([]MattermostAttachment, len(in))
+	for i, a := range in {
+		out[i] = MattermostAttachment{
+			Fallback:   a.Fallback,
+			Color:      a.Color,
+			Pretext:    a.Pretext,
+			Text:       a.Text,
+			AuthorName: a.AuthorName,
+			AuthorLink: (*URL)(a.AuthorLink),
+			AuthorIcon: (*URL)(a.AuthorIcon),
+			Title:      a.Title,
+			TitleLink:  (*URL)(a.TitleLink),
+			Fields:     convertMattermostFieldsFrom(a.Fields),
+			ThumbURL:   (*URL)(a.ThumbURL),
+			Footer:     a.Footer,
+			FooterIcon: (*URL)(a.FooterIcon),
+			ImageURL:   (*URL)(a.ImageURL),
+		}
+	}
+	return out
+}
+
+func convertMattermostConfigFrom(in v1alpha1.MattermostConfig) MattermostConfig {
+	out := MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   in.WebhookURL,
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Text:         in.Text,
+		IconURL:      (*URL)(in.IconURL),
+		IconEmoji:    in.IconEmoji,
+		Attachments:  convertMattermostAttachmentsFrom(in.Attachments),
+		HTTPConfig:   convertHTTPConfigFrom(in.HTTPConfig),
+	}
+
+	if in.Props != nil {
+		out.Props = &MattermostProps{
+			Card: in.Props.Card,
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &MattermostPriority{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	return out
+}
+
+func convertJiraFieldsFrom(in []v1alpha1.JiraField) []JiraField {
+	if in == nil {
+		return nil
+	}
+	out := make([]JiraField, len(in))
+	for i, field := range in {
+		out[i] = JiraField{
+			Key:   field.Key,
+			Value: field.Value,
+		}
+	}
+	return out
+}
+
+func convertJiraConfigFrom(in v1alpha1.JiraConfig) JiraConfig {
+	return JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*URL)(in.APIURL),
+		APIType:           in.APIType,
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Priority:          in.Priority,
+		Labels:            in.Labels,
+		Fields:            convertJiraFieldsFrom(in.Fields),
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		HTTPConfig:        convertHTTPConfigFrom(in.HTTPConfig),
+	}
+}
+
 func convertSlackFieldsFrom(in []v1alpha1.SlackField) []SlackField {
 	out := make([]SlackField, len(in))
 
@@ -457,6 +573,10 @@ func convertEmailConfigFrom(in v1alpha1.EmailConfig) EmailConfig {
 }
 
 func convertEmailThreadingConfigFrom(in *v1alpha1.EmailThreadingConfig) *EmailThreadingConfig {
+	if in == nil {
+		return nil
+	}
+
 	return &EmailThreadingConfig{
 		ThreadByDate: ThreadByDateType(in.ThreadByDate),
 	}
@@ -488,6 +608,7 @@ func convertPushoverConfigFrom(in v1alpha1.PushoverConfig) PushoverConfig {
 		Message:      in.Message,
 		URL:          in.URL,
 		URLTitle:     in.URLTitle,
+		TTL:          in.TTL,
 		Device:       in.Device,
 		Sound:        in.Sound,
 		Priority:     in.Priority,
@@ -668,6 +789,27 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.MattermostConfigs {
+			out.MattermostConfigs = append(
+				out.MattermostConfigs,
+				convertMattermostConfigFrom(in),
+			)
+		}
+
+		for _, in := range in.IncidentioConfigs {
+			out.IncidentioConfigs = append(
+				out.IncidentioConfigs,
+				convertIncidentioConfigFrom(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -692,6 +834,8 @@ func (dst *AlertmanagerConfig) ConvertFrom(srcRaw conversion.Hub) error {
 		)
 	}
 
+	dst.Spec.Templates = src.Spec.Templates
+
 	r, err := convertRouteFrom(src.Spec.Route)
 	if err != nil {
 		return err
@@ -0,0 +1,117 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestAlertmanagerConfigRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name      string
+		receivers []v1alpha1.Receiver
+	}{
+		{
+			name: "email without threading config",
+			receivers: []v1alpha1.Receiver{
+				{
+					Name: "email",
+					EmailConfigs: []v1alpha1.EmailConfig{
+						{
+							To:        ptr.To("team@example.com"),
+							Threading: nil,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "email with threading config",
+			receivers: []v1alpha1.Receiver{
+				{
+					Name: "email",
+					EmailConfigs: []v1alpha1.EmailConfig{
+						{
+							To: ptr.To("team@example.com"),
+							Threading: &v1alpha1.EmailThreadingConfig{
+								ThreadByDate: v1alpha1.ThreadByDateTypeDaily,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "pushover with TTL",
+			receivers: []v1alpha1.Receiver{
+				{
+					Name: "pushover",
+					PushoverConfigs: []v1alpha1.PushoverConfig{
+						{
+							TTL: ptr.To(monitoringv1.Duration("1h")),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := &v1alpha1.AlertmanagerConfig{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: v1alpha1.AlertmanagerConfigSpec{
+					Receivers: tc.receivers,
+				},
+			}
+
+			var amc AlertmanagerConfig
+			if err := amc.ConvertFrom(in.DeepCopy()); err != nil {
+				t.Fatalf("conversion from v1alpha1 failed: %v", err)
+			}
+
+			out := &v1alpha1.AlertmanagerConfig{}
+			if err := amc.ConvertTo(out); err != nil {
+				t.Fatalf("conversion to v1alpha1 failed: %v", err)
+			}
+
+			// Compare the serialized specs since the conversion doesn't
+			// preserve the difference between nil and empty slices.
+			expected, err := json.Marshal(in.Spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := json.Marshal(out.Spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(expected) != string(got) {
+				t.Fatalf("round-trip mismatch:\nexpected: %s\ngot:      %s", expected, got)
+			}
+		})
+	}
+}
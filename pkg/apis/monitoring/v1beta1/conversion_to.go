@@ -324,6 +324,122 @@ func convertRocketchatConfigTo(in RocketChatConfig) v1alpha1.RocketChatConfig {
 	}
 }
 
+func convertIncidentioConfigTo(in IncidentioConfig) v1alpha1.IncidentioConfig {
+	return v1alpha1.IncidentioConfig{
+		SendResolved:     in.SendResolved,
+		URL:              v1alpha1.URL(in.URL),
+		AlertSourceToken: in.AlertSourceToken,
+		MaxAlerts:        in.MaxAlerts,
+		Timeout:          in.Timeout,
+		HTTPConfig:       convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
+func convertMattermostFieldsTo(in []MattermostField) []v1alpha1.MattermostField {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha1.MattermostField, len(in))
+	for i, field := range in {
+		out[i] = v1alpha1.MattermostField{
+			Title: field.Title,
+			Value: field.Value,
+			Short: field.Short,
+		}
+	}
+	return out
+}
+
+func convertMattermostAttachmentsTo(in []MattermostAttachment) []v1alpha1.MattermostAttachment {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha1.MattermostAttachment, len(in))
+	for i, a := range in {
+		out[i] = v1alpha1.MattermostAttachment{
+			Fallback:   a.Fallback,
+			Color:      a.Color,
+			Pretext:    a.Pretext,
+			Text:       a.Text,
+			AuthorName: a.AuthorName,
+			AuthorLink: (*v1alpha1.URL)(a.AuthorLink),
+			AuthorIcon: (*v1alpha1.URL)(a.AuthorIcon),
+			Title:      a.Title,
+			TitleLink:  (*v1alpha1.URL)(a.TitleLink),
+			Fields:     convertMattermostFieldsTo(a.Fields),
+			ThumbURL:   (*v1alpha1.URL)(a.ThumbURL),
+			Footer:     a.Footer,
+			FooterIcon: (*v1alpha1.URL)(a.FooterIcon),
+			ImageURL:   (*v1alpha1.URL)(a.ImageURL),
+		}
+	}
+	return out
+}
+
+func convertMattermostConfigTo(in MattermostConfig) v1alpha1.MattermostConfig {
+	out := v1alpha1.MattermostConfig{
+		SendResolved: in.SendResolved,
+		WebhookURL:   in.WebhookURL,
+		Channel:      in.Channel,
+		Username:     in.Username,
+		Text:         in.Text,
+		IconURL:      (*v1alpha1.URL)(in.IconURL),
+		IconEmoji:    in.IconEmoji,
+		Attachments:  convertMattermostAttachmentsTo(in.Attachments),
+		HTTPConfig:   convertHTTPConfigTo(in.HTTPConfig),
+	}
+
+	if in.Props != nil {
+		out.Props = &v1alpha1.MattermostProps{
+			Card: in.Props.Card,
+		}
+	}
+
+	if in.Priority != nil {
+		out.Priority = &v1alpha1.MattermostPriority{
+			Priority:                in.Priority.Priority,
+			RequestedAck:            in.Priority.RequestedAck,
+			PersistentNotifications: in.Priority.PersistentNotifications,
+		}
+	}
+
+	return out
+}
+
+func convertJiraFieldsTo(in []JiraField) []v1alpha1.JiraField {
+	if in == nil {
+		return nil
+	}
+	out := make([]v1alpha1.JiraField, len(in))
+	for i, field := range in {
+		out[i] = v1alpha1.JiraField{
+			Key:   field.Key,
+			Value: field.Value,
+		}
+	}
+	return out
+}
+
+func convertJiraConfigTo(in JiraConfig) v1alpha1.JiraConfig {
+	return v1alpha1.JiraConfig{
+		SendResolved:      in.SendResolved,
+		APIURL:            (*v1alpha1.URL)(in.APIURL),
+		APIType:           in.APIType,
+		Project:           in.Project,
+		IssueType:         in.IssueType,
+		Summary:           in.Summary,
+		Description:       in.Description,
+		Priority:          in.Priority,
+		Labels:            in.Labels,
+		Fields:            convertJiraFieldsTo(in.Fields),
+		ReopenTransition:  in.ReopenTransition,
+		ResolveTransition: in.ResolveTransition,
+		WontFixResolution: in.WontFixResolution,
+		ReopenDuration:    in.ReopenDuration,
+		HTTPConfig:        convertHTTPConfigTo(in.HTTPConfig),
+	}
+}
+
 func convertSlackFieldsTo(in []SlackField) []v1alpha1.SlackField {
 	out := make([]v1alpha1.SlackField, len(in))
 
@@ -453,6 +569,10 @@ func convertEmailConfigTo(in EmailConfig) v1alpha1.EmailConfig {
 }
 
 func convertEmailThreadingConfigTo(in *EmailThreadingConfig) *v1alpha1.EmailThreadingConfig {
+	if in == nil {
+		return nil
+	}
+
 	return &v1alpha1.EmailThreadingConfig{
 		ThreadByDate: v1alpha1.ThreadByDateType(in.ThreadByDate),
 	}
@@ -484,6 +604,7 @@ func convertPushoverConfigTo(in PushoverConfig) v1alpha1.PushoverConfig {
 		Message:      in.Message,
 		URL:          in.URL,
 		URLTitle:     in.URLTitle,
+		TTL:          in.TTL,
 		Device:       in.Device,
 		Sound:        in.Sound,
 		Priority:     in.Priority,
@@ -664,6 +785,27 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 			)
 		}
 
+		for _, in := range in.JiraConfigs {
+			out.JiraConfigs = append(
+				out.JiraConfigs,
+				convertJiraConfigTo(in),
+			)
+		}
+
+		for _, in := range in.MattermostConfigs {
+			out.MattermostConfigs = append(
+				out.MattermostConfigs,
+				convertMattermostConfigTo(in),
+			)
+		}
+
+		for _, in := range in.IncidentioConfigs {
+			out.IncidentioConfigs = append(
+				out.IncidentioConfigs,
+				convertIncidentioConfigTo(in),
+			)
+		}
+
 		dst.Spec.Receivers = append(dst.Spec.Receivers, out)
 	}
 
@@ -689,6 +831,8 @@ func (src *AlertmanagerConfig) ConvertTo(dstRaw conversion.Hub) error {
 		)
 	}
 
+	dst.Spec.Templates = src.Spec.Templates
+
 	r, err := convertRouteTo(src.Spec.Route)
 	if err != nil {
 		return err
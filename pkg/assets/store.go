@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -44,6 +46,11 @@ type StoreBuilder struct {
 	refTracker RefTracker
 
 	tlsAssetKeys map[tlsAssetKey]struct{}
+
+	// When offline is true, the store doesn't fetch the missing ConfigMaps
+	// and Secrets but records the references in unresolved.
+	offline    bool
+	unresolved map[string]struct{}
 }
 
 // NewTestStoreBuilder returns a *StoreBuilder already initialized with the
@@ -69,6 +76,27 @@ func NewStoreBuilder(cmClient typedcorev1.ConfigMapsGetter, sClient typedcorev1.
 	return sb
 }
 
+// NewOfflineStoreBuilder returns a *StoreBuilder which never fetches data
+// from ConfigMaps and Secrets. A reference missing from the store returns an
+// empty value instead of an error and it is reported by
+// UnresolvedReferences().
+//
+// It is used to validate objects without access to the referenced data (for
+// instance in the admission webhook).
+func NewOfflineStoreBuilder(objects ...any) *StoreBuilder {
+	sb := NewTestStoreBuilder(objects...)
+	sb.offline = true
+	sb.unresolved = make(map[string]struct{})
+
+	return sb
+}
+
+// UnresolvedReferences returns the sorted list of ConfigMap and Secret keys
+// which couldn't be resolved by an offline store.
+func (s *StoreBuilder) UnresolvedReferences() []string {
+	return slices.Sorted(maps.Keys(s.unresolved))
+}
+
 func newStoreBuilder() *StoreBuilder {
 	return &StoreBuilder{
 		objStore:     cache.NewStore(assetKeyFunc),
@@ -292,6 +320,11 @@ func (s *StoreBuilder) GetConfigMapKey(ctx context.Context, namespace string, se
 		return "", fmt.Errorf("unexpected store error when getting configmap %q: %w", sel.Name, err)
 	}
 
+	if !exists && s.offline {
+		s.unresolved[fmt.Sprintf("configmap %s/%s (key %q)", namespace, sel.Name, sel.Key)] = struct{}{}
+		return "", nil
+	}
+
 	if !exists {
 		cm, err := s.cmClient.ConfigMaps(namespace).Get(ctx, sel.Name, metav1.GetOptions{})
 		if err != nil {
@@ -329,6 +362,11 @@ func (s *StoreBuilder) GetSecretKey(ctx context.Context, namespace string, sel c
 		return "", fmt.Errorf("unexpected store error when getting secret %q: %w", sel.Name, err)
 	}
 
+	if !exists && s.offline {
+		s.unresolved[fmt.Sprintf("secret %s/%s (key %q)", namespace, sel.Name, sel.Key)] = struct{}{}
+		return "", nil
+	}
+
 	if !exists {
 		secret, err := s.sClient.Secrets(namespace).Get(ctx, sel.Name, metav1.GetOptions{})
 		if err != nil {
@@ -141,6 +141,66 @@ func TestGetSecretKey(t *testing.T) {
 	}
 }
 
+func TestOfflineStoreBuilder(t *testing.T) {
+	store := NewOfflineStoreBuilder(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret",
+				Namespace: "ns1",
+			},
+			Data: map[string][]byte{
+				"key1": []byte("val1"),
+			},
+		},
+	)
+
+	s, err := store.GetSecretKey(context.Background(), "ns1", corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+		Key:                  "key1",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "val1", s)
+
+	// Missing key in a known secret.
+	_, err = store.GetSecretKey(context.Background(), "ns1", corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+		Key:                  "key2",
+	})
+	require.Error(t, err)
+
+	err = store.AddBasicAuth(context.Background(), "ns2", &monitoringv1.BasicAuth{
+		Username: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "auth"},
+			Key:                  "user",
+		},
+		Password: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "auth"},
+			Key:                  "password",
+		},
+	})
+	require.NoError(t, err)
+
+	err = store.AddSafeTLSConfig(context.Background(), "ns2", &monitoringv1.SafeTLSConfig{
+		CA: monitoringv1.SecretOrConfigMap{
+			ConfigMap: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "tls"},
+				Key:                  "ca.crt",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t,
+		[]string{
+			`configmap ns2/tls (key "ca.crt")`,
+			`secret ns2/auth (key "password")`,
+			`secret ns2/auth (key "user")`,
+		},
+		store.UnresolvedReferences(),
+	)
+	require.Empty(t, store.TLSAssets())
+}
+
 func TestAddBasicAuth(t *testing.T) {
 	c := fake.NewClientset(
 		&corev1.Secret{
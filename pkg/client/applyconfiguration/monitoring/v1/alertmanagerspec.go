@@ -239,6 +239,20 @@ type AlertmanagerSpecApplyConfiguration struct {
 	// alertmanagerConfigMatcherStrategy defines how AlertmanagerConfig objects
 	// process incoming alerts.
 	AlertmanagerConfigMatcherStrategy *AlertmanagerConfigMatcherStrategyApplyConfiguration `json:"alertmanagerConfigMatcherStrategy,omitempty"`
+	// silenceSelector defines the selector to be used to select the Silence
+	// objects applied to Alertmanager.
+	//
+	// If nil, no Silence object is selected.
+	//
+	// The namespace matchers of the silences are enforced according to
+	// `alertmanagerConfigMatcherStrategy`.
+	//
+	// It requires the `SilenceCustomResourceDefinition` feature gate to be
+	// enabled.
+	SilenceSelector *metav1.LabelSelectorApplyConfiguration `json:"silenceSelector,omitempty"`
+	// silenceNamespaceSelector defines the namespaces to be selected for
+	// Silence discovery. If nil, only check own namespace.
+	SilenceNamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"silenceNamespaceSelector,omitempty"`
 	// minReadySeconds defines the minimum number of seconds for which a newly
 	// created pod should be ready without any of its container crashing for it
 	// to be considered available.
@@ -252,6 +266,25 @@ type AlertmanagerSpecApplyConfiguration struct {
 	// possible to override this behavior passing a custom value via
 	// `.spec.additionalArgs`.
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+	// podDisruptionBudget defines the PodDisruptionBudget created by the
+	// operator for the Alertmanager pods.
+	//
+	// When the Alertmanager cluster has more than one replica, the operator
+	// ensures that the PodDisruptionBudget keeps a majority of the peers
+	// available: the effective `minAvailable` value is at least
+	// `replicas/2 + 1`, capped to `replicas - 1` so that at least one pod can
+	// always be evicted (e.g. with 2 replicas, `minAvailable` is at least 1).
+	//
+	// If unset, the operator doesn't create any PodDisruptionBudget.
+	PodDisruptionBudget *PodDisruptionBudgetSpecApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	// exposure defines how the operator exposes the Alertmanager web
+	// endpoint outside of the cluster with an Ingress or a Gateway API
+	// HTTPRoute object.
+	//
+	// The `PerShard` hostname policy isn't supported.
+	//
+	// If unset, the operator doesn't expose Alertmanager.
+	Exposure *ExposureApplyConfiguration `json:"exposure,omitempty"`
 	// hostAliases Pods configuration
 	HostAliases []HostAliasApplyConfiguration `json:"hostAliases,omitempty"`
 	// hostNetwork controls whether the pod may use the node network namespace.
@@ -749,6 +782,22 @@ func (b *AlertmanagerSpecApplyConfiguration) WithAlertmanagerConfigMatcherStrate
 	return b
 }
 
+// WithSilenceSelector sets the SilenceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithSilenceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.SilenceSelector = value
+	return b
+}
+
+// WithSilenceNamespaceSelector sets the SilenceNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SilenceNamespaceSelector field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithSilenceNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.SilenceNamespaceSelector = value
+	return b
+}
+
 // WithMinReadySeconds sets the MinReadySeconds field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the MinReadySeconds field is set to the value of the last call.
@@ -757,6 +806,22 @@ func (b *AlertmanagerSpecApplyConfiguration) WithMinReadySeconds(value int32) *A
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetSpecApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithExposure sets the Exposure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exposure field is set to the value of the last call.
+func (b *AlertmanagerSpecApplyConfiguration) WithExposure(value *ExposureApplyConfiguration) *AlertmanagerSpecApplyConfiguration {
+	b.Exposure = value
+	return b
+}
+
 // WithHostAliases adds the given value to the HostAliases field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the HostAliases field.
@@ -110,6 +110,27 @@ type CommonPrometheusFieldsApplyConfiguration struct {
 	// Note that the ScrapeConfig custom resource definition is currently at Alpha level
 	// and will be graduated to Beta in a future release.
 	ScrapeConfigNamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"scrapeConfigNamespaceSelector,omitempty"`
+	// remoteWriteSelector defines the RemoteWrite resources to be selected for
+	// the remote write configuration. An empty label selector matches all
+	// objects. A null label selector matches no objects.
+	//
+	// The remote write endpoints defined by the selected resources are
+	// appended to the endpoints defined in `spec.remoteWrite`.
+	//
+	// It requires the `RemoteWriteCustomResourceDefinition` feature gate to
+	// be enabled.
+	//
+	// Note that the RemoteWrite custom resource definition is currently at Alpha level.
+	RemoteWriteSelector *metav1.LabelSelectorApplyConfiguration `json:"remoteWriteSelector,omitempty"`
+	// remoteWriteNamespaceSelector defines the namespaces to match for RemoteWrite discovery. An empty label selector
+	// matches all namespaces. A null label selector matches the current
+	// namespace only.
+	//
+	// It requires the `RemoteWriteCustomResourceDefinition` feature gate to
+	// be enabled.
+	//
+	// Note that the RemoteWrite custom resource definition is currently at Alpha level.
+	RemoteWriteNamespaceSelector *metav1.LabelSelectorApplyConfiguration `json:"remoteWriteNamespaceSelector,omitempty"`
 	// version of Prometheus being deployed. The operator uses this information
 	// to generate the Prometheus StatefulSet + configuration files.
 	//
@@ -772,6 +793,22 @@ func (b *CommonPrometheusFieldsApplyConfiguration) WithScrapeConfigNamespaceSele
 	return b
 }
 
+// WithRemoteWriteSelector sets the RemoteWriteSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteSelector field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithRemoteWriteSelector(value *metav1.LabelSelectorApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.RemoteWriteSelector = value
+	return b
+}
+
+// WithRemoteWriteNamespaceSelector sets the RemoteWriteNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteNamespaceSelector field is set to the value of the last call.
+func (b *CommonPrometheusFieldsApplyConfiguration) WithRemoteWriteNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *CommonPrometheusFieldsApplyConfiguration {
+	b.RemoteWriteNamespaceSelector = value
+	return b
+}
+
 // WithVersion sets the Version field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Version field is set to the value of the last call.
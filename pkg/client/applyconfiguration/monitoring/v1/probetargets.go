@@ -20,18 +20,28 @@ package v1
 // with apply.
 //
 // ProbeTargets defines how to discover the probed targets.
-// One of the `staticConfig` or `ingress` must be defined.
-// If both are defined, `staticConfig` takes precedence.
+// One of the `staticConfig`, `ingress` or `httpRoute` must be defined.
+// If several are defined, `staticConfig` takes precedence over `ingress`
+// which takes precedence over `httpRoute`.
 type ProbeTargetsApplyConfiguration struct {
 	// staticConfig defines the static list of targets to probe and the
 	// relabeling configuration.
-	// If `ingress` is also defined, `staticConfig` takes precedence.
+	// If `ingress` or `httpRoute` is also defined, `staticConfig` takes precedence.
 	// More info: https://prometheus.io/docs/prometheus/latest/configuration/configuration/#static_config.
 	StaticConfig *ProbeTargetStaticConfigApplyConfiguration `json:"staticConfig,omitempty"`
 	// ingress defines the Ingress objects to probe and the relabeling
 	// configuration.
 	// If `staticConfig` is also defined, `staticConfig` takes precedence.
+	// If `httpRoute` is also defined, `ingress` takes precedence.
 	Ingress *ProbeTargetIngressApplyConfiguration `json:"ingress,omitempty"`
+	// httpRoute defines the Gateway API HTTPRoute objects to probe and the
+	// relabeling configuration.
+	// If `staticConfig` or `ingress` is also defined, they take precedence.
+	//
+	// It requires the Gateway API HTTPRoute and Gateway resources
+	// (`gateway.networking.k8s.io/v1`) to be installed in the cluster and the
+	// operator to have permissions to list and watch them.
+	HTTPRoute *ProbeTargetHTTPRouteApplyConfiguration `json:"httpRoute,omitempty"`
 }
 
 // ProbeTargetsApplyConfiguration constructs a declarative configuration of the ProbeTargets type for use with
@@ -55,3 +65,11 @@ func (b *ProbeTargetsApplyConfiguration) WithIngress(value *ProbeTargetIngressAp
 	b.Ingress = value
 	return b
 }
+
+// WithHTTPRoute sets the HTTPRoute field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTPRoute field is set to the value of the last call.
+func (b *ProbeTargetsApplyConfiguration) WithHTTPRoute(value *ProbeTargetHTTPRouteApplyConfiguration) *ProbeTargetsApplyConfiguration {
+	b.HTTPRoute = value
+	return b
+}
@@ -46,6 +46,24 @@ type PrometheusSpecApplyConfiguration struct {
 	//
 	// (Beta) Using this mode requires the `PrometheusShardRetentionPolicy` feature gate (enabled by default).
 	ShardRetentionPolicy *ShardRetentionPolicyApplyConfiguration `json:"shardRetentionPolicy,omitempty"`
+	// podDisruptionBudget defines the PodDisruptionBudget created by the
+	// operator for the Prometheus pods.
+	//
+	// When Prometheus is sharded, the operator creates one
+	// PodDisruptionBudget per shard and the values apply to the pods of
+	// each shard.
+	//
+	// If unset, the operator doesn't create any PodDisruptionBudget.
+	PodDisruptionBudget *PodDisruptionBudgetSpecApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	// exposure defines how the operator exposes the Prometheus web endpoint
+	// outside of the cluster with an Ingress or a Gateway API HTTPRoute
+	// object.
+	//
+	// When Prometheus is sharded, the `PerShard` hostname policy exposes
+	// each shard behind its own hostname.
+	//
+	// If unset, the operator doesn't expose Prometheus.
+	Exposure *ExposureApplyConfiguration `json:"exposure,omitempty"`
 	// disableCompaction when true, the Prometheus compaction is disabled.
 	//
 	// When `spec.thanos.objectStorageConfig` or `spec.thanos.objectStorageConfigFile` are defined, the operator's
@@ -226,6 +244,22 @@ func (b *PrometheusSpecApplyConfiguration) WithScrapeConfigNamespaceSelector(val
 	return b
 }
 
+// WithRemoteWriteSelector sets the RemoteWriteSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithRemoteWriteSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.RemoteWriteSelector = value
+	return b
+}
+
+// WithRemoteWriteNamespaceSelector sets the RemoteWriteNamespaceSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RemoteWriteNamespaceSelector field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithRemoteWriteNamespaceSelector(value *metav1.LabelSelectorApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.CommonPrometheusFieldsApplyConfiguration.RemoteWriteNamespaceSelector = value
+	return b
+}
+
 // WithVersion sets the Version field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Version field is set to the value of the last call.
@@ -1092,6 +1126,22 @@ func (b *PrometheusSpecApplyConfiguration) WithShardRetentionPolicy(value *Shard
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetSpecApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithExposure sets the Exposure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exposure field is set to the value of the last call.
+func (b *PrometheusSpecApplyConfiguration) WithExposure(value *ExposureApplyConfiguration) *PrometheusSpecApplyConfiguration {
+	b.Exposure = value
+	return b
+}
+
 // WithDisableCompaction sets the DisableCompaction field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DisableCompaction field is set to the value of the last call.
@@ -18,6 +18,8 @@ package v1
 
 import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
 // ScrapeClassApplyConfiguration represents a declarative configuration of the ScrapeClass type for use
@@ -65,6 +67,93 @@ type ScrapeClassApplyConfiguration struct {
 	// When the scrape object defines its own configuration, it takes
 	// precedence over the scrape class configuration.
 	AttachMetadata *AttachMetadataApplyConfiguration `json:"attachMetadata,omitempty"`
+	// scrapeInterval defines the default interval between scrapes for the
+	// scrape objects using this class.
+	// It will only apply if the scrape resource doesn't specify any interval.
+	ScrapeInterval *monitoringv1.Duration `json:"scrapeInterval,omitempty"`
+	// scrapeTimeout defines the default timeout for the scrape requests of
+	// the scrape objects using this class.
+	// It will only apply if the scrape resource doesn't specify any timeout
+	// and if the value isn't greater than the effective scrape interval.
+	ScrapeTimeout *monitoringv1.Duration `json:"scrapeTimeout,omitempty"`
+	// sampleLimit defines the default per-scrape limit on the number of
+	// scraped samples that will be accepted.
+	// It will only apply if the scrape resource doesn't specify any sample
+	// limit. The `spec.enforcedSampleLimit` field still takes precedence.
+	SampleLimit *int64 `json:"sampleLimit,omitempty"`
+	// targetLimit defines the default limit on the number of scraped targets
+	// that will be accepted.
+	// It will only apply if the scrape resource doesn't specify any target
+	// limit. The `spec.enforcedTargetLimit` field still takes precedence.
+	TargetLimit *int64 `json:"targetLimit,omitempty"`
+	// labelLimit defines the default per-scrape limit on the number of
+	// labels that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any label
+	// limit. The `spec.enforcedLabelLimit` field still takes precedence.
+	LabelLimit *int64 `json:"labelLimit,omitempty"`
+	// labelNameLengthLimit defines the default per-scrape limit on the
+	// length of label names that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any label
+	// name length limit. The `spec.enforcedLabelNameLengthLimit` field
+	// still takes precedence.
+	LabelNameLengthLimit *int64 `json:"labelNameLengthLimit,omitempty"`
+	// labelValueLengthLimit defines the default per-scrape limit on the
+	// length of label values that will be accepted for a sample.
+	// It will only apply if the scrape resource doesn't specify any label
+	// value length limit. The `spec.enforcedLabelValueLengthLimit` field
+	// still takes precedence.
+	LabelValueLengthLimit *int64 `json:"labelValueLengthLimit,omitempty"`
+	// bodySizeLimit defines the default limit on the size of uncompressed
+	// response body that will be accepted by Prometheus.
+	// It will only apply if the scrape resource doesn't specify any body
+	// size limit. The `spec.enforcedBodySizeLimit` field still takes
+	// precedence.
+	BodySizeLimit *monitoringv1.ByteSize `json:"bodySizeLimit,omitempty"`
+	// scrapeProtocols defines the default protocols to negotiate during a
+	// scrape.
+	// It will only apply if the scrape resource doesn't specify any scrape
+	// protocols.
+	ScrapeProtocols []monitoringv1.ScrapeProtocol `json:"scrapeProtocols,omitempty"`
+	// honorLabels defines the default value of the `honorLabels` field.
+	//
+	// For ScrapeConfig resources, the `honorLabels` field of the resource
+	// takes precedence when it is set.
+	// It applies to the Probe resources too. For ServiceMonitor and PodMonitor
+	// endpoints, the labels are honored if either the endpoint or the scrape
+	// class enables it: the endpoints can only opt in because they can't
+	// distinguish between an unset field and `false`.
+	HonorLabels *bool `json:"honorLabels,omitempty"`
+	// honorTimestamps defines the default value of the `honorTimestamps`
+	// field.
+	// It will only apply if the scrape resource doesn't specify any value.
+	HonorTimestamps *bool `json:"honorTimestamps,omitempty"`
+	// The native histogram settings apply individually when the scrape
+	// resource doesn't specify them.
+	NativeHistogramConfigApplyConfiguration `json:",inline"`
+	// The proxy settings apply only if the scrape resource doesn't configure
+	// any proxy field. The secrets referenced by `proxyConnectHeader` must
+	// be in the same namespace as the Prometheus object.
+	ProxyConfigApplyConfiguration `json:",inline"`
+	// basicAuth defines the default basic authentication settings.
+	// It will only apply if the scrape resource doesn't specify any
+	// authentication method (authorization, basic auth, OAuth2 or bearer
+	// token).
+	// The referenced secrets must be in the same namespace as the Prometheus
+	// object.
+	//
+	// Cannot be set at the same time as `authorization` or `oauth2`.
+	BasicAuth *BasicAuthApplyConfiguration `json:"basicAuth,omitempty"`
+	// oauth2 defines the default OAuth2 settings.
+	// It will only apply if the scrape resource doesn't specify any
+	// authentication method (authorization, basic auth, OAuth2 or bearer
+	// token).
+	// The referenced secrets and config maps must be in the same namespace as
+	// the Prometheus object.
+	//
+	// Cannot be set at the same time as `authorization` or `basicAuth`.
+	//
+	// It requires Prometheus >= v2.27.0.
+	OAuth2 *OAuth2ApplyConfiguration `json:"oauth2,omitempty"`
 }
 
 // ScrapeClassApplyConfiguration constructs a declarative configuration of the ScrapeClass type for use with
@@ -146,3 +235,187 @@ func (b *ScrapeClassApplyConfiguration) WithAttachMetadata(value *AttachMetadata
 	b.AttachMetadata = value
 	return b
 }
+
+// WithScrapeInterval sets the ScrapeInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeInterval field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeInterval(value monitoringv1.Duration) *ScrapeClassApplyConfiguration {
+	b.ScrapeInterval = &value
+	return b
+}
+
+// WithScrapeTimeout sets the ScrapeTimeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeTimeout field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeTimeout(value monitoringv1.Duration) *ScrapeClassApplyConfiguration {
+	b.ScrapeTimeout = &value
+	return b
+}
+
+// WithSampleLimit sets the SampleLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SampleLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithSampleLimit(value int64) *ScrapeClassApplyConfiguration {
+	b.SampleLimit = &value
+	return b
+}
+
+// WithTargetLimit sets the TargetLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithTargetLimit(value int64) *ScrapeClassApplyConfiguration {
+	b.TargetLimit = &value
+	return b
+}
+
+// WithLabelLimit sets the LabelLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelLimit(value int64) *ScrapeClassApplyConfiguration {
+	b.LabelLimit = &value
+	return b
+}
+
+// WithLabelNameLengthLimit sets the LabelNameLengthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelNameLengthLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelNameLengthLimit(value int64) *ScrapeClassApplyConfiguration {
+	b.LabelNameLengthLimit = &value
+	return b
+}
+
+// WithLabelValueLengthLimit sets the LabelValueLengthLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LabelValueLengthLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithLabelValueLengthLimit(value int64) *ScrapeClassApplyConfiguration {
+	b.LabelValueLengthLimit = &value
+	return b
+}
+
+// WithBodySizeLimit sets the BodySizeLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BodySizeLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithBodySizeLimit(value monitoringv1.ByteSize) *ScrapeClassApplyConfiguration {
+	b.BodySizeLimit = &value
+	return b
+}
+
+// WithScrapeProtocols adds the given value to the ScrapeProtocols field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ScrapeProtocols field.
+func (b *ScrapeClassApplyConfiguration) WithScrapeProtocols(values ...monitoringv1.ScrapeProtocol) *ScrapeClassApplyConfiguration {
+	for i := range values {
+		b.ScrapeProtocols = append(b.ScrapeProtocols, values[i])
+	}
+	return b
+}
+
+// WithHonorLabels sets the HonorLabels field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HonorLabels field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithHonorLabels(value bool) *ScrapeClassApplyConfiguration {
+	b.HonorLabels = &value
+	return b
+}
+
+// WithHonorTimestamps sets the HonorTimestamps field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HonorTimestamps field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithHonorTimestamps(value bool) *ScrapeClassApplyConfiguration {
+	b.HonorTimestamps = &value
+	return b
+}
+
+// WithScrapeNativeHistograms sets the ScrapeNativeHistograms field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeNativeHistograms field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeNativeHistograms(value bool) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.ScrapeNativeHistograms = &value
+	return b
+}
+
+// WithScrapeClassicHistograms sets the ScrapeClassicHistograms field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ScrapeClassicHistograms field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithScrapeClassicHistograms(value bool) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.ScrapeClassicHistograms = &value
+	return b
+}
+
+// WithNativeHistogramBucketLimit sets the NativeHistogramBucketLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeHistogramBucketLimit field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNativeHistogramBucketLimit(value int64) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.NativeHistogramBucketLimit = &value
+	return b
+}
+
+// WithNativeHistogramMinBucketFactor sets the NativeHistogramMinBucketFactor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NativeHistogramMinBucketFactor field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNativeHistogramMinBucketFactor(value resource.Quantity) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.NativeHistogramMinBucketFactor = &value
+	return b
+}
+
+// WithConvertClassicHistogramsToNHCB sets the ConvertClassicHistogramsToNHCB field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConvertClassicHistogramsToNHCB field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithConvertClassicHistogramsToNHCB(value bool) *ScrapeClassApplyConfiguration {
+	b.NativeHistogramConfigApplyConfiguration.ConvertClassicHistogramsToNHCB = &value
+	return b
+}
+
+// WithProxyURL sets the ProxyURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyURL field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithProxyURL(value string) *ScrapeClassApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyURL = &value
+	return b
+}
+
+// WithNoProxy sets the NoProxy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NoProxy field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithNoProxy(value string) *ScrapeClassApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.NoProxy = &value
+	return b
+}
+
+// WithProxyFromEnvironment sets the ProxyFromEnvironment field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProxyFromEnvironment field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithProxyFromEnvironment(value bool) *ScrapeClassApplyConfiguration {
+	b.ProxyConfigApplyConfiguration.ProxyFromEnvironment = &value
+	return b
+}
+
+// WithProxyConnectHeader puts the entries into the ProxyConnectHeader field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ProxyConnectHeader field,
+// overwriting an existing map entries in ProxyConnectHeader field with the same key.
+func (b *ScrapeClassApplyConfiguration) WithProxyConnectHeader(entries map[string][]corev1.SecretKeySelector) *ScrapeClassApplyConfiguration {
+	if b.ProxyConfigApplyConfiguration.ProxyConnectHeader == nil && len(entries) > 0 {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader = make(map[string][]corev1.SecretKeySelector, len(entries))
+	}
+	for k, v := range entries {
+		b.ProxyConfigApplyConfiguration.ProxyConnectHeader[k] = v
+	}
+	return b
+}
+
+// WithBasicAuth sets the BasicAuth field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BasicAuth field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithBasicAuth(value *BasicAuthApplyConfiguration) *ScrapeClassApplyConfiguration {
+	b.BasicAuth = value
+	return b
+}
+
+// WithOAuth2 sets the OAuth2 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OAuth2 field is set to the value of the last call.
+func (b *ScrapeClassApplyConfiguration) WithOAuth2(value *OAuth2ApplyConfiguration) *ScrapeClassApplyConfiguration {
+	b.OAuth2 = value
+	return b
+}
@@ -25,7 +25,8 @@ import (
 //
 // ShardingStrategy defines the sharding strategy for Prometheus.
 type ShardingStrategyApplyConfiguration struct {
-	// mode defines the sharding mode. Can be 'Address' or 'Topology'.
+	// mode defines the sharding mode. Can be 'Address', 'Topology' or
+	// 'ConsistentHash'.
 	//
 	// 'Address' is the default mode and distributes targets across shards
 	// based on a hash of the target address.
@@ -34,10 +35,17 @@ type ShardingStrategyApplyConfiguration struct {
 	// specific topology zone and only scrapes targets in that zone.
 	// (Alpha) Using the 'Topology' mode requires the `PrometheusTopologySharding`
 	// feature gate to be enabled.
+	//
+	// 'ConsistentHash' distributes targets based on a hash of the target
+	// address like 'Address' but it minimizes the number of targets moving to
+	// another shard when the number of shards changes.
 	Mode *monitoringv1.ShardingStrategyMode `json:"mode,omitempty"`
 	// topology defines the configuration for topology-aware sharding.
 	// This field is only valid when mode is set to 'Topology'.
 	Topology *TopologyShardingStrategyApplyConfiguration `json:"topology,omitempty"`
+	// consistentHash defines the configuration for consistent-hash sharding.
+	// This field is only valid when mode is set to 'ConsistentHash'.
+	ConsistentHash *ConsistentHashShardingStrategyApplyConfiguration `json:"consistentHash,omitempty"`
 }
 
 // ShardingStrategyApplyConfiguration constructs a declarative configuration of the ShardingStrategy type for use with
@@ -61,3 +69,11 @@ func (b *ShardingStrategyApplyConfiguration) WithTopology(value *TopologyShardin
 	b.Topology = value
 	return b
 }
+
+// WithConsistentHash sets the ConsistentHash field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConsistentHash field is set to the value of the last call.
+func (b *ShardingStrategyApplyConfiguration) WithConsistentHash(value *ConsistentHashShardingStrategyApplyConfiguration) *ShardingStrategyApplyConfiguration {
+	b.ConsistentHash = value
+	return b
+}
@@ -31,6 +31,12 @@ type ShardStatusApplyConfiguration struct {
 	AvailableReplicas *int32 `json:"availableReplicas,omitempty"`
 	// unavailableReplicas defines the Total number of unavailable pods targeted by this shard.
 	UnavailableReplicas *int32 `json:"unavailableReplicas,omitempty"`
+	// health defines the runtime health of the shard as reported by the
+	// HTTP API of its ready pods.
+	//
+	// It is only populated when the `PrometheusRuntimeHealth` feature gate
+	// is enabled and at least one pod of the shard answered.
+	Health *ShardHealthApplyConfiguration `json:"health,omitempty"`
 }
 
 // ShardStatusApplyConfiguration constructs a declarative configuration of the ShardStatus type for use with
@@ -78,3 +84,11 @@ func (b *ShardStatusApplyConfiguration) WithUnavailableReplicas(value int32) *Sh
 	b.UnavailableReplicas = &value
 	return b
 }
+
+// WithHealth sets the Health field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Health field is set to the value of the last call.
+func (b *ShardStatusApplyConfiguration) WithHealth(value *ShardHealthApplyConfiguration) *ShardStatusApplyConfiguration {
+	b.Health = value
+	return b
+}
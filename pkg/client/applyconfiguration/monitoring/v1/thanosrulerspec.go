@@ -52,7 +52,25 @@ type ThanosRulerSpecApplyConfiguration struct {
 	// will be performed on the underlying objects.
 	Paused *bool `json:"paused,omitempty"`
 	// replicas defines the number of thanos ruler instances to deploy.
+	//
+	// When `spec.shards` is greater than 1, it is the number of replicas of
+	// each shard.
 	Replicas *int32 `json:"replicas,omitempty"`
+	// shards defines the number of shards to distribute the rule groups onto.
+	//
+	// `spec.replicas` multiplied by `spec.shards` is the total number of Pods
+	// being created. Each shard is deployed as a separate StatefulSet and
+	// evaluates a deterministic subset of the rule groups selected by the
+	// ThanosRuler resource. The assignment of a rule group to a shard is
+	// computed from the hash of the PrometheusRule namespace, name and the
+	// rule group name, hence changing the number of shards moves rule groups
+	// between shards.
+	//
+	// When the value is greater than 1, the shard's pods have the
+	// `thanos_ruler_shard` external label set to the shard's index.
+	//
+	// Default: 1
+	Shards *int32 `json:"shards,omitempty"`
 	// nodeSelector defines which Nodes the Pods are scheduled on.
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	// schedulerName defines the scheduler to use for Pod scheduling. If not specified, the default scheduler is used.
@@ -130,10 +148,21 @@ type ThanosRulerSpecApplyConfiguration struct {
 	UpdateStrategy *StatefulSetUpdateStrategyApplyConfiguration `json:"updateStrategy,omitempty"`
 	// queryEndpoints defines the list of Thanos Query endpoints from which to query metrics.
 	//
-	// For Thanos >= v0.11.0, it is recommended to use `queryConfig` instead.
+	// For Thanos >= v0.11.0, it is recommended to use `queryAPIEndpoints` instead.
 	//
-	// `queryConfig` takes precedence over this field.
+	// `queryConfig` and `queryAPIEndpoints` take precedence over this field.
 	QueryEndpoints []string `json:"queryEndpoints,omitempty"`
+	// queryAPIEndpoints defines the Thanos Query services from which to query
+	// metrics.
+	//
+	// The operator generates the Thanos query configuration from the list.
+	// The Secrets and ConfigMaps referenced for authentication and TLS must
+	// be in the same namespace as the ThanosRuler object.
+	//
+	// It requires Thanos >= v0.11.0.
+	//
+	// `queryConfig` takes precedence over this field.
+	QueryAPIEndpoints []ThanosQueryEndpointApplyConfiguration `json:"queryAPIEndpoints,omitempty"`
 	// queryConfig defines the list of Thanos Query endpoints from which to query metrics.
 	//
 	// The configuration format is defined at https://thanos.io/tip/components/rule.md/#query-api
@@ -142,13 +171,15 @@ type ThanosRulerSpecApplyConfiguration struct {
 	//
 	// The operator performs no validation of the configuration.
 	//
-	// This field takes precedence over `queryEndpoints`.
+	// This field takes precedence over `queryAPIEndpoints` and `queryEndpoints`.
 	QueryConfig *corev1.SecretKeySelector `json:"queryConfig,omitempty"`
 	// alertmanagersUrl defines the list of Alertmanager endpoints to send alerts to.
 	//
-	// For Thanos >= v0.10.0, it is recommended to use `alertmanagersConfig` instead.
+	// For Thanos >= v0.10.0, it is recommended to use `alertmanagers` or
+	// `alertmanagerSelector` instead.
 	//
-	// `alertmanagersConfig` takes precedence over this field.
+	// `alertmanagersConfig`, `alertmanagers` and `alertmanagerSelector` take
+	// precedence over this field.
 	AlertManagersURL []string `json:"alertmanagersUrl,omitempty"`
 	// alertmanagersConfig defines the list of Alertmanager endpoints to send alerts to.
 	//
@@ -158,8 +189,39 @@ type ThanosRulerSpecApplyConfiguration struct {
 	//
 	// The operator performs no validation of the configuration.
 	//
-	// This field takes precedence over `alertmanagersUrl`.
+	// This field takes precedence over `alertmanagers`, `alertmanagerSelector`
+	// and `alertmanagersUrl`.
 	AlertManagersConfig *corev1.SecretKeySelector `json:"alertmanagersConfig,omitempty"`
+	// alertmanagers defines the list of Alertmanager endpoints to send alerts to.
+	//
+	// Each endpoint references a Kubernetes service which is resolved using
+	// DNS: a named port is resolved with a DNS SRV query and a numeric port
+	// with a DNS A/AAAA query. It is recommended to reference headless
+	// services so that the alerts are sent to all the Alertmanager replicas.
+	//
+	// The credentials and TLS assets must be in the same namespace as the
+	// ThanosRuler object. The `sigv4`, `relabelings`, `alertRelabelings`,
+	// `enableHttp2` and `bearerTokenFile` fields as well as the proxy fields
+	// other than `proxyUrl` aren't supported by Thanos.
+	//
+	// It requires Thanos >= v0.10.0.
+	Alertmanagers []AlertmanagerEndpointsApplyConfiguration `json:"alertmanagers,omitempty"`
+	// alertmanagerSelector defines the Alertmanager objects to send alerts
+	// to. The Alertmanager objects are selected from the namespace of the
+	// ThanosRuler object and each of their pods is resolved from its
+	// hostname under the governing service (`alertmanager-operated` unless
+	// `serviceName` is set). Scaling a selected Alertmanager restarts the
+	// Thanos Ruler pods.
+	//
+	// The selected Alertmanagers are combined with the endpoints defined in
+	// `alertmanagers`. Alertmanagers which listen on localhost only are
+	// ignored and Alertmanagers with web TLS aren't supported.
+	//
+	// An empty label selector matches all objects. A null label selector
+	// matches no objects.
+	//
+	// It requires Thanos >= v0.10.0.
+	AlertmanagerSelector *metav1.LabelSelectorApplyConfiguration `json:"alertmanagerSelector,omitempty"`
 	// ruleSelector defines the PrometheusRule objects to be selected for rule evaluation. An empty
 	// label selector matches all objects. A null label selector matches no
 	// objects.
@@ -283,6 +345,20 @@ type ThanosRulerSpecApplyConfiguration struct {
 	//
 	// If unset, pods will be considered available as soon as they are ready.
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+	// podDisruptionBudget defines the PodDisruptionBudget created by the
+	// operator for the Thanos Ruler pods.
+	//
+	// If unset, the operator doesn't create any PodDisruptionBudget.
+	PodDisruptionBudget *PodDisruptionBudgetSpecApplyConfiguration `json:"podDisruptionBudget,omitempty"`
+	// exposure defines how the operator exposes the Thanos Ruler web
+	// endpoint outside of the cluster with an Ingress or a Gateway API
+	// HTTPRoute object.
+	//
+	// Thanos Ruler has no external URL: the hostname must be defined
+	// explicitly to route a specific hostname.
+	//
+	// If unset, the operator doesn't expose Thanos Ruler.
+	Exposure *ExposureApplyConfiguration `json:"exposure,omitempty"`
 	// alertRelabelConfigs defines the alert relabeling in Thanos Ruler.
 	//
 	// Alert relabel configuration must have the form as specified in the
@@ -411,6 +487,14 @@ func (b *ThanosRulerSpecApplyConfiguration) WithReplicas(value int32) *ThanosRul
 	return b
 }
 
+// WithShards sets the Shards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shards field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithShards(value int32) *ThanosRulerSpecApplyConfiguration {
+	b.Shards = &value
+	return b
+}
+
 // WithNodeSelector puts the entries into the NodeSelector field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the NodeSelector field,
@@ -603,6 +687,19 @@ func (b *ThanosRulerSpecApplyConfiguration) WithQueryEndpoints(values ...string)
 	return b
 }
 
+// WithQueryAPIEndpoints adds the given value to the QueryAPIEndpoints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the QueryAPIEndpoints field.
+func (b *ThanosRulerSpecApplyConfiguration) WithQueryAPIEndpoints(values ...*ThanosQueryEndpointApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithQueryAPIEndpoints")
+		}
+		b.QueryAPIEndpoints = append(b.QueryAPIEndpoints, *values[i])
+	}
+	return b
+}
+
 // WithQueryConfig sets the QueryConfig field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the QueryConfig field is set to the value of the last call.
@@ -629,6 +726,27 @@ func (b *ThanosRulerSpecApplyConfiguration) WithAlertManagersConfig(value corev1
 	return b
 }
 
+// WithAlertmanagers adds the given value to the Alertmanagers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Alertmanagers field.
+func (b *ThanosRulerSpecApplyConfiguration) WithAlertmanagers(values ...*AlertmanagerEndpointsApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAlertmanagers")
+		}
+		b.Alertmanagers = append(b.Alertmanagers, *values[i])
+	}
+	return b
+}
+
+// WithAlertmanagerSelector sets the AlertmanagerSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AlertmanagerSelector field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithAlertmanagerSelector(value *metav1.LabelSelectorApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.AlertmanagerSelector = value
+	return b
+}
+
 // WithRuleSelector sets the RuleSelector field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RuleSelector field is set to the value of the last call.
@@ -859,6 +977,22 @@ func (b *ThanosRulerSpecApplyConfiguration) WithMinReadySeconds(value int32) *Th
 	return b
 }
 
+// WithPodDisruptionBudget sets the PodDisruptionBudget field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDisruptionBudget field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithPodDisruptionBudget(value *PodDisruptionBudgetSpecApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.PodDisruptionBudget = value
+	return b
+}
+
+// WithExposure sets the Exposure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Exposure field is set to the value of the last call.
+func (b *ThanosRulerSpecApplyConfiguration) WithExposure(value *ExposureApplyConfiguration) *ThanosRulerSpecApplyConfiguration {
+	b.Exposure = value
+	return b
+}
+
 // WithAlertRelabelConfigs sets the AlertRelabelConfigs field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the AlertRelabelConfigs field is set to the value of the last call.
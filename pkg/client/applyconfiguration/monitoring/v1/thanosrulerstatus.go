@@ -39,6 +39,10 @@ type ThanosRulerStatusApplyConfiguration struct {
 	UnavailableReplicas *int32 `json:"unavailableReplicas,omitempty"`
 	// conditions defines the current state of the ThanosRuler object.
 	Conditions []ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// shards defines the most recently observed number of shards.
+	Shards *int32 `json:"shards,omitempty"`
+	// selector used to match the pods targeted by this ThanosRuler resource.
+	Selector *string `json:"selector,omitempty"`
 }
 
 // ThanosRulerStatusApplyConfiguration constructs a declarative configuration of the ThanosRulerStatus type for use with
@@ -99,3 +103,19 @@ func (b *ThanosRulerStatusApplyConfiguration) WithConditions(values ...*Conditio
 	}
 	return b
 }
+
+// WithShards sets the Shards field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Shards field is set to the value of the last call.
+func (b *ThanosRulerStatusApplyConfiguration) WithShards(value int32) *ThanosRulerStatusApplyConfiguration {
+	b.Shards = &value
+	return b
+}
+
+// WithSelector sets the Selector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Selector field is set to the value of the last call.
+func (b *ThanosRulerStatusApplyConfiguration) WithSelector(value string) *ThanosRulerStatusApplyConfiguration {
+	b.Selector = &value
+	return b
+}
@@ -16,6 +16,10 @@
 
 package v1beta1
 
+import (
+	v1 "github.com/prometheus-operator/prometheus-operator/pkg/client/applyconfiguration/monitoring/v1"
+)
+
 // AlertmanagerConfigSpecApplyConfiguration represents a declarative configuration of the AlertmanagerConfigSpec type for use
 // with apply.
 //
@@ -34,6 +38,18 @@ type AlertmanagerConfigSpecApplyConfiguration struct {
 	InhibitRules []InhibitRuleApplyConfiguration `json:"inhibitRules,omitempty"`
 	// timeIntervals defines the list of timeIntervals specifying when the routes should be muted.
 	TimeIntervals []TimeIntervalApplyConfiguration `json:"timeIntervals,omitempty"`
+	// templates defines the custom notification templates. The ConfigMaps
+	// and Secrets must be in the same namespace as the AlertmanagerConfig
+	// resource.
+	//
+	// To avoid conflicts between resources, the templates defined in these
+	// files (with `define` and `block` actions) are renamed to
+	// `<namespace>/<name>/<template name>` where `<namespace>` and `<name>`
+	// are the namespace and name of the AlertmanagerConfig resource. The
+	// references within the files are updated accordingly while the
+	// receivers should use the prefixed name (e.g. `{{ template
+	// "default/example/slack.title" . }}`).
+	Templates []v1.SecretOrConfigMapApplyConfiguration `json:"templates,omitempty"`
 }
 
 // AlertmanagerConfigSpecApplyConfiguration constructs a declarative configuration of the AlertmanagerConfigSpec type for use with
@@ -88,3 +104,16 @@ func (b *AlertmanagerConfigSpecApplyConfiguration) WithTimeIntervals(values ...*
 	}
 	return b
 }
+
+// WithTemplates adds the given value to the Templates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Templates field.
+func (b *AlertmanagerConfigSpecApplyConfiguration) WithTemplates(values ...*v1.SecretOrConfigMapApplyConfiguration) *AlertmanagerConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTemplates")
+		}
+		b.Templates = append(b.Templates, *values[i])
+	}
+	return b
+}
@@ -86,6 +86,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ConfigResourceConditionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ConfigResourceStatus"):
 		return &monitoringv1.ConfigResourceStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConsistentHashShardingStrategy"):
+		return &monitoringv1.ConsistentHashShardingStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("CoreV1TopologySpreadConstraint"):
 		return &monitoringv1.CoreV1TopologySpreadConstraintApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("EmbeddedObjectMetadata"):
@@ -96,6 +98,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.EndpointApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("Exemplars"):
 		return &monitoringv1.ExemplarsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("Exposure"):
+		return &monitoringv1.ExposureApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("GatewayParentReference"):
+		return &monitoringv1.GatewayParentReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalJiraConfig"):
 		return &monitoringv1.GlobalJiraConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("GlobalMattermostConfig"):
@@ -128,6 +134,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.HTTPConfigWithProxyAndTLSFilesApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HTTPConfigWithTLSFiles"):
 		return &monitoringv1.HTTPConfigWithTLSFilesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("HTTPRouteExposure"):
+		return &monitoringv1.HTTPRouteExposureApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IngressExposure"):
+		return &monitoringv1.IngressExposureApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ManagedIdentity"):
 		return &monitoringv1.ManagedIdentityApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("MetadataConfig"):
@@ -142,6 +152,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ObjectReferenceApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("OTLPConfig"):
 		return &monitoringv1.OTLPConfigApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PodDisruptionBudgetSpec"):
+		return &monitoringv1.PodDisruptionBudgetSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodDNSConfig"):
 		return &monitoringv1.PodDNSConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("PodDNSConfigOption"):
@@ -160,6 +172,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ProberSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeSpec"):
 		return &monitoringv1.ProbeSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ProbeTargetHTTPRoute"):
+		return &monitoringv1.ProbeTargetHTTPRouteApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargetIngress"):
 		return &monitoringv1.ProbeTargetIngressApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ProbeTargets"):
@@ -218,6 +232,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.ServiceMonitorApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServiceMonitorSpec"):
 		return &monitoringv1.ServiceMonitorSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ShardHealth"):
+		return &monitoringv1.ShardHealthApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardingStrategy"):
 		return &monitoringv1.ShardingStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ShardRetentionPolicy"):
@@ -230,6 +246,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1.StatefulSetUpdateStrategyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("StorageSpec"):
 		return &monitoringv1.StorageSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ThanosQueryEndpoint"):
+		return &monitoringv1.ThanosQueryEndpointApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRuler"):
 		return &monitoringv1.ThanosRulerApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ThanosRulerSpec"):
@@ -306,10 +324,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.HTTPConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HTTPSDConfig"):
 		return &monitoringv1alpha1.HTTPSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IncidentioConfig"):
+		return &monitoringv1alpha1.IncidentioConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1alpha1.InhibitRuleApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("IonosSDConfig"):
 		return &monitoringv1alpha1.IonosSDConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1alpha1.JiraConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("JiraField"):
+		return &monitoringv1alpha1.JiraFieldApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("K8SSelectorConfig"):
 		return &monitoringv1alpha1.K8SSelectorConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("KeyValue"):
@@ -324,6 +348,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.LinodeSDConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1alpha1.MatcherApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostAttachment"):
+		return &monitoringv1alpha1.MattermostAttachmentApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostConfig"):
+		return &monitoringv1alpha1.MattermostConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostField"):
+		return &monitoringv1alpha1.MattermostFieldApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostPriority"):
+		return &monitoringv1alpha1.MattermostPriorityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MattermostProps"):
+		return &monitoringv1alpha1.MattermostPropsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
 		return &monitoringv1alpha1.MSTeamsConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
@@ -358,6 +392,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.PushoverConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Receiver"):
 		return &monitoringv1alpha1.ReceiverApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RemoteWrite"):
+		return &monitoringv1alpha1.RemoteWriteApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatActionConfig"):
 		return &monitoringv1alpha1.RocketChatActionConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("RocketChatConfig"):
@@ -372,6 +408,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1alpha1.ScrapeConfigApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ScrapeConfigSpec"):
 		return &monitoringv1alpha1.ScrapeConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Silence"):
+		return &monitoringv1alpha1.SilenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SilenceAlertmanagerStatus"):
+		return &monitoringv1alpha1.SilenceAlertmanagerStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SilenceSpec"):
+		return &monitoringv1alpha1.SilenceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SilenceStatus"):
+		return &monitoringv1alpha1.SilenceStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackAction"):
 		return &monitoringv1alpha1.SlackActionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SlackConfig"):
@@ -414,12 +458,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &monitoringv1beta1.EmailThreadingConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("HTTPConfig"):
 		return &monitoringv1beta1.HTTPConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("IncidentioConfig"):
+		return &monitoringv1beta1.IncidentioConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("InhibitRule"):
 		return &monitoringv1beta1.InhibitRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraConfig"):
+		return &monitoringv1beta1.JiraConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("JiraField"):
+		return &monitoringv1beta1.JiraFieldApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("KeyValue"):
 		return &monitoringv1beta1.KeyValueApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("Matcher"):
 		return &monitoringv1beta1.MatcherApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostAttachment"):
+		return &monitoringv1beta1.MattermostAttachmentApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostConfig"):
+		return &monitoringv1beta1.MattermostConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostField"):
+		return &monitoringv1beta1.MattermostFieldApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostPriority"):
+		return &monitoringv1beta1.MattermostPriorityApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("MattermostProps"):
+		return &monitoringv1beta1.MattermostPropsApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsConfig"):
 		return &monitoringv1beta1.MSTeamsConfigApplyConfiguration{}
 	case v1beta1.SchemeGroupVersion.WithKind("MSTeamsV2Config"):
@@ -26,8 +26,12 @@ type Interface interface {
 	AlertmanagerConfigs() AlertmanagerConfigInformer
 	// PrometheusAgents returns a PrometheusAgentInformer.
 	PrometheusAgents() PrometheusAgentInformer
+	// RemoteWrites returns a RemoteWriteInformer.
+	RemoteWrites() RemoteWriteInformer
 	// ScrapeConfigs returns a ScrapeConfigInformer.
 	ScrapeConfigs() ScrapeConfigInformer
+	// Silences returns a SilenceInformer.
+	Silences() SilenceInformer
 }
 
 type version struct {
@@ -51,7 +55,17 @@ func (v *version) PrometheusAgents() PrometheusAgentInformer {
 	return &prometheusAgentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RemoteWrites returns a RemoteWriteInformer.
+func (v *version) RemoteWrites() RemoteWriteInformer {
+	return &remoteWriteInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScrapeConfigs returns a ScrapeConfigInformer.
 func (v *version) ScrapeConfigs() ScrapeConfigInformer {
 	return &scrapeConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// Silences returns a SilenceInformer.
+func (v *version) Silences() SilenceInformer {
+	return &silenceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
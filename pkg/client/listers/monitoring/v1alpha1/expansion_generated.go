@@ -32,6 +32,14 @@ type PrometheusAgentListerExpansion interface{}
 // PrometheusAgentNamespaceLister.
 type PrometheusAgentNamespaceListerExpansion interface{}
 
+// RemoteWriteListerExpansion allows custom methods to be added to
+// RemoteWriteLister.
+type RemoteWriteListerExpansion interface{}
+
+// RemoteWriteNamespaceListerExpansion allows custom methods to be added to
+// RemoteWriteNamespaceLister.
+type RemoteWriteNamespaceListerExpansion interface{}
+
 // ScrapeConfigListerExpansion allows custom methods to be added to
 // ScrapeConfigLister.
 type ScrapeConfigListerExpansion interface{}
@@ -39,3 +47,11 @@ type ScrapeConfigListerExpansion interface{}
 // ScrapeConfigNamespaceListerExpansion allows custom methods to be added to
 // ScrapeConfigNamespaceLister.
 type ScrapeConfigNamespaceListerExpansion interface{}
+
+// SilenceListerExpansion allows custom methods to be added to
+// SilenceLister.
+type SilenceListerExpansion interface{}
+
+// SilenceNamespaceListerExpansion allows custom methods to be added to
+// SilenceNamespaceLister.
+type SilenceNamespaceListerExpansion interface{}
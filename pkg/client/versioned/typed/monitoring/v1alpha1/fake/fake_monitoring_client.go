@@ -34,10 +34,18 @@ func (c *FakeMonitoringV1alpha1) PrometheusAgents(namespace string) v1alpha1.Pro
 	return newFakePrometheusAgents(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) RemoteWrites(namespace string) v1alpha1.RemoteWriteInterface {
+	return newFakeRemoteWrites(c, namespace)
+}
+
 func (c *FakeMonitoringV1alpha1) ScrapeConfigs(namespace string) v1alpha1.ScrapeConfigInterface {
 	return newFakeScrapeConfigs(c, namespace)
 }
 
+func (c *FakeMonitoringV1alpha1) Silences(namespace string) v1alpha1.SilenceInterface {
+	return newFakeSilences(c, namespace)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitoringV1alpha1) RESTClient() rest.Interface {
@@ -20,4 +20,8 @@ type AlertmanagerConfigExpansion interface{}
 
 type PrometheusAgentExpansion interface{}
 
+type RemoteWriteExpansion interface{}
+
 type ScrapeConfigExpansion interface{}
+
+type SilenceExpansion interface{}
@@ -28,7 +28,9 @@ type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AlertmanagerConfigsGetter
 	PrometheusAgentsGetter
+	RemoteWritesGetter
 	ScrapeConfigsGetter
+	SilencesGetter
 }
 
 // MonitoringV1alpha1Client is used to interact with features provided by the monitoring.coreos.com group.
@@ -44,10 +46,18 @@ func (c *MonitoringV1alpha1Client) PrometheusAgents(namespace string) Prometheus
 	return newPrometheusAgents(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) RemoteWrites(namespace string) RemoteWriteInterface {
+	return newRemoteWrites(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) ScrapeConfigs(namespace string) ScrapeConfigInterface {
 	return newScrapeConfigs(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) Silences(namespace string) SilenceInterface {
+	return newSilences(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,55 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+)
+
+// NewDynamicInformerFactories creates dynamicinformer factories for resources
+// which have no typed client (e.g. third-party custom resources) for the
+// given allowed, and denied namespaces (these parameters being mutually exclusive).
+// dynamicClient, defaultResync, and tweakListOptions are passed to the underlying informer factory.
+func NewDynamicInformerFactories(
+	allowNamespaces, denyNamespaces map[string]struct{},
+	dynamicClient dynamic.Interface,
+	defaultResync time.Duration,
+	tweakListOptions func(*metav1.ListOptions),
+) FactoriesForNamespaces {
+	tweaks, namespaces := newInformerOptions(allowNamespaces, denyNamespaces, tweakListOptions)
+
+	ret := dynamicInformersForNamespaces{}
+	for _, namespace := range namespaces {
+		ret[namespace] = dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, defaultResync, namespace, tweaks)
+	}
+
+	return ret
+}
+
+type dynamicInformersForNamespaces map[string]dynamicinformer.DynamicSharedInformerFactory
+
+func (i dynamicInformersForNamespaces) Namespaces() sets.Set[string] {
+	return sets.KeySet(i)
+}
+
+func (i dynamicInformersForNamespaces) ForResource(namespace string, resource schema.GroupVersionResource) (InformLister, error) {
+	return i[namespace].ForResource(resource), nil
+}
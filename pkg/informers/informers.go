@@ -133,6 +133,32 @@ func PartialObjectMetadataStrip(gvk schema.GroupVersionKind) cache.TransformFunc
 	}
 }
 
+// OwnedObjectMetadataStrip is similar to PartialObjectMetadataStrip except
+// that it preserves the labels and the owner references. It is meant for the
+// informers watching the objects created by the operator which need to be
+// selected by labels and checked for ownership.
+func OwnedObjectMetadataStrip(gvk schema.GroupVersionKind) cache.TransformFunc {
+	strip := PartialObjectMetadataStrip(gvk)
+
+	return func(obj any) (any, error) {
+		partialMeta, ok := obj.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return strip(obj)
+		}
+
+		lbls, ownerRefs := partialMeta.Labels, partialMeta.OwnerReferences
+		ret, err := strip(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		partialMeta.Labels = lbls
+		partialMeta.OwnerReferences = ownerRefs
+
+		return ret, nil
+	}
+}
+
 // Start starts all underlying informers, passing the given stop channel to each of them.
 func (w *ForResource) Start(stopCh <-chan struct{}) {
 	for _, i := range w.informers {
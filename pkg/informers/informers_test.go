@@ -487,3 +487,34 @@ func TestPartialObjectMetadataStripOnDeletedFinalStateUnknown(t *testing.T) {
 	// 1 object should have been added.
 	require.Equal(t, uint64(1), addCount.Load())
 }
+
+func TestOwnedObjectMetadataStrip(t *testing.T) {
+	obj := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Labels:          map[string]string{"app": "foo"},
+			Annotations:     map[string]string{"foo": "bar"},
+			Finalizers:      []string{"foo"},
+			OwnerReferences: []metav1.OwnerReference{{Name: "owner", UID: "uid"}},
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "foo"}},
+		},
+	}
+
+	ret, err := OwnedObjectMetadataStrip(schema.GroupVersionKind{Group: "policy", Version: "v1", Kind: "PodDisruptionBudget"})(obj)
+	require.NoError(t, err)
+
+	require.Equal(t, &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Labels:          map[string]string{"app": "foo"},
+			OwnerReferences: []metav1.OwnerReference{{Name: "owner", UID: "uid"}},
+		},
+	}, ret)
+
+	// Other objects are returned unmodified.
+	tombstone := cache.DeletedFinalStateUnknown{Key: "foo"}
+	ret, err = OwnedObjectMetadataStrip(schema.GroupVersionKind{Version: "v1", Kind: "Secret"})(tombstone)
+	require.NoError(t, err)
+	require.Equal(t, tombstone, ret)
+}
@@ -26,6 +26,10 @@ import (
 // collect status bindings on configuration resources.
 const StatusCleanupFinalizerName = "monitoring.coreos.com/status-cleanup"
 
+// SilenceExpirationFinalizerName is the name of the finalizer used to expire
+// the Alertmanager silences before deleting Silence objects.
+const SilenceExpirationFinalizerName = "monitoring.coreos.com/silence-expiration"
+
 // FinalizerAddPatch generates the JSON patch payload which adds the finalizer to the object's metadata.
 // If the finalizer is already present, it returns an empty []byte slice.
 func FinalizerAddPatch(finalizers []string, finalizerName string) ([]byte, error) {
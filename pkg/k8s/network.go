@@ -16,15 +16,20 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientdiscoveryv1 "k8s.io/client-go/kubernetes/typed/discovery/v1"
+	clientnetworkingv1 "k8s.io/client-go/kubernetes/typed/networking/v1"
 	"k8s.io/client-go/util/retry"
 )
 
@@ -60,6 +65,75 @@ func CreateOrUpdateService(ctx context.Context, sclient typedcorev1.ServiceInter
 	return ret, err
 }
 
+// DeleteServices deletes the given Service resources except the ones listed
+// in keep. The objects are expected to come from an informer's cache so that
+// no request is sent to the API server when there's nothing to delete.
+func DeleteServices(ctx context.Context, sclient typedcorev1.ServiceInterface, svcs []metav1.Object, keep sets.Set[string]) error {
+	var errs []error
+	for _, svc := range svcs {
+		if keep.Has(svc.GetName()) {
+			continue
+		}
+
+		err := sclient.Delete(ctx, svc.GetName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: new(svc.GetUID())},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete Service %q: %w", svc.GetName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CreateOrUpdateIngress creates or updates an Ingress resource.
+func CreateOrUpdateIngress(ctx context.Context, iclient clientnetworkingv1.IngressInterface, desired *networkingv1.Ingress) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := iclient.Get(ctx, desired.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			_, err = iclient.Create(ctx, desired, metav1.CreateOptions{})
+			return err
+		}
+
+		mutated := existing.DeepCopy()
+		desired.SetOwnerReferences(mergeOwnerReferences(mutated.GetOwnerReferences(), desired.GetOwnerReferences()))
+		mergeMetadata(&desired.ObjectMeta, mutated.ObjectMeta)
+		if apiequality.Semantic.DeepEqual(existing.ObjectMeta, desired.ObjectMeta) &&
+			apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+			return nil
+		}
+
+		_, err = iclient.Update(ctx, desired, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// DeleteIngresses deletes the given Ingress resources except the ones listed
+// in keep. The objects are expected to come from an informer's cache so that
+// no request is sent to the API server when there's nothing to delete.
+func DeleteIngresses(ctx context.Context, iclient clientnetworkingv1.IngressInterface, ingresses []metav1.Object, keep sets.Set[string]) error {
+	var errs []error
+	for _, ingress := range ingresses {
+		if keep.Has(ingress.GetName()) {
+			continue
+		}
+
+		err := iclient.Delete(ctx, ingress.GetName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: new(ingress.GetUID())},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete Ingress %q: %w", ingress.GetName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func mergeOwnerReferences(oldObj []metav1.OwnerReference, newObj []metav1.OwnerReference) []metav1.OwnerReference {
 	existing := make(map[metav1.OwnerReference]bool)
 	for _, ownerRef := range oldObj {
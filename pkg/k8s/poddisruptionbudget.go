@@ -0,0 +1,79 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientpolicyv1 "k8s.io/client-go/kubernetes/typed/policy/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateOrUpdatePodDisruptionBudget creates or updates a PodDisruptionBudget
+// resource.
+func CreateOrUpdatePodDisruptionBudget(ctx context.Context, pdbClient clientpolicyv1.PodDisruptionBudgetInterface, desired *policyv1.PodDisruptionBudget) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := pdbClient.Get(ctx, desired.Name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			_, err = pdbClient.Create(ctx, desired, metav1.CreateOptions{})
+			return err
+		}
+
+		mutated := existing.DeepCopy()
+		desired.SetOwnerReferences(mergeOwnerReferences(mutated.GetOwnerReferences(), desired.GetOwnerReferences()))
+		mergeMetadata(&desired.ObjectMeta, mutated.ObjectMeta)
+		if apiequality.Semantic.DeepEqual(existing.ObjectMeta, desired.ObjectMeta) &&
+			apiequality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+			return nil
+		}
+
+		_, err = pdbClient.Update(ctx, desired, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// DeletePodDisruptionBudgets deletes the given PodDisruptionBudget resources
+// which are controlled by owner, except the ones listed in keep. The objects
+// are expected to come from an informer's cache so that no request is sent to
+// the API server when there's nothing to delete.
+func DeletePodDisruptionBudgets(ctx context.Context, pdbClient clientpolicyv1.PodDisruptionBudgetInterface, owner metav1.Object, pdbs []metav1.Object, keep sets.Set[string]) error {
+	var errs []error
+	for _, pdb := range pdbs {
+		if keep.Has(pdb.GetName()) || !metav1.IsControlledBy(pdb, owner) {
+			continue
+		}
+
+		err := pdbClient.Delete(ctx, pdb.GetName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: new(pdb.GetUID())},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete PodDisruptionBudget %q: %w", pdb.GetName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,112 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func newPodDisruptionBudget(name string, l map[string]string, maxUnavailable int) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			Labels:    l,
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: ptr.To(intstr.FromInt(maxUnavailable)),
+			Selector:       &metav1.LabelSelector{MatchLabels: l},
+		},
+	}
+}
+
+func TestCreateOrUpdatePodDisruptionBudget(t *testing.T) {
+	ctx := context.Background()
+	l := map[string]string{"app": "prometheus"}
+	pdbClient := fake.NewClientset(newPodDisruptionBudget("other", l, 1)).PolicyV1().PodDisruptionBudgets("ns")
+
+	require.NoError(t, CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, newPodDisruptionBudget("prometheus", l, 1)))
+
+	pdb, err := pdbClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, intstr.FromInt(1), *pdb.Spec.MaxUnavailable)
+
+	require.NoError(t, CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, newPodDisruptionBudget("prometheus", l, 2)))
+
+	pdb, err = pdbClient.Get(ctx, "prometheus", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, intstr.FromInt(2), *pdb.Spec.MaxUnavailable)
+}
+
+func TestDeletePodDisruptionBudgets(t *testing.T) {
+	ctx := context.Background()
+	l := map[string]string{"app": "prometheus"}
+
+	owner := &metav1.ObjectMeta{Name: "prometheus", UID: "owner-uid"}
+	controlledBy := func(pdb *policyv1.PodDisruptionBudget, uid types.UID, controller bool) *policyv1.PodDisruptionBudget {
+		pdb.UID = types.UID(pdb.Name + "-uid")
+		pdb.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "monitoring.coreos.com/v1",
+			Kind:       "Prometheus",
+			Name:       "prometheus",
+			UID:        uid,
+			Controller: ptr.To(controller),
+		}}
+		return pdb
+	}
+
+	pdbs := []*policyv1.PodDisruptionBudget{
+		controlledBy(newPodDisruptionBudget("shard-0", l, 1), owner.UID, true),
+		controlledBy(newPodDisruptionBudget("shard-1", l, 1), owner.UID, true),
+		controlledBy(newPodDisruptionBudget("shard-2", l, 1), owner.UID, true),
+		// Same name and labels but created by a previous object.
+		controlledBy(newPodDisruptionBudget("previous-owner", l, 1), "other-uid", true),
+		// Not a controller reference.
+		controlledBy(newPodDisruptionBudget("not-controller", l, 1), owner.UID, false),
+		newPodDisruptionBudget("not-owned", l, 1),
+	}
+
+	var (
+		objects    []runtime.Object
+		candidates []metav1.Object
+	)
+	for _, pdb := range pdbs {
+		objects = append(objects, pdb)
+		candidates = append(candidates, pdb)
+	}
+	pdbClient := fake.NewClientset(objects...).PolicyV1().PodDisruptionBudgets("ns")
+
+	require.NoError(t, DeletePodDisruptionBudgets(ctx, pdbClient, owner, candidates, sets.New("shard-0")))
+
+	list, err := pdbClient.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+
+	var names []string
+	for _, pdb := range list.Items {
+		names = append(names, pdb.Name)
+	}
+	require.ElementsMatch(t, []string{"shard-0", "previous-owner", "not-controller", "not-owned"}, names)
+}
@@ -0,0 +1,92 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+)
+
+// CreateOrUpdateUnstructured creates or updates a resource for which the
+// operator has no typed client (e.g. Gateway API resources).
+// The spec of the existing object is replaced by the desired one.
+func CreateOrUpdateUnstructured(ctx context.Context, c dynamic.ResourceInterface, desired *unstructured.Unstructured) error {
+	// As stated in the RetryOnConflict's documentation, the returned error shouldn't be wrapped.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing, err := c.Get(ctx, desired.GetName(), metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			_, err = c.Create(ctx, desired, metav1.CreateOptions{})
+			return err
+		}
+
+		existingMeta := metav1.ObjectMeta{
+			ResourceVersion: existing.GetResourceVersion(),
+			Labels:          existing.GetLabels(),
+			Annotations:     existing.GetAnnotations(),
+		}
+		desiredMeta := metav1.ObjectMeta{
+			Labels:      desired.GetLabels(),
+			Annotations: desired.GetAnnotations(),
+		}
+		mergeMetadata(&desiredMeta, existingMeta)
+
+		updated := existing.DeepCopy()
+		updated.SetLabels(desiredMeta.Labels)
+		updated.SetAnnotations(desiredMeta.Annotations)
+		updated.SetOwnerReferences(mergeOwnerReferences(existing.GetOwnerReferences(), desired.GetOwnerReferences()))
+		updated.Object["spec"] = desired.Object["spec"]
+
+		if apiequality.Semantic.DeepEqual(existing.Object, updated.Object) {
+			return nil
+		}
+
+		_, err = c.Update(ctx, updated, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// DeleteUnstructured deletes the given resources except the ones listed in
+// keep. The objects are expected to come from an informer's cache so that no
+// request is sent to the API server when there's nothing to delete.
+func DeleteUnstructured(ctx context.Context, c dynamic.ResourceInterface, objs []metav1.Object, keep sets.Set[string]) error {
+	var errs []error
+	for _, obj := range objs {
+		if keep.Has(obj.GetName()) {
+			continue
+		}
+
+		err := c.Delete(ctx, obj.GetName(), metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: new(obj.GetUID())},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete object %q: %w", obj.GetName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
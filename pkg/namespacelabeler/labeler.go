@@ -150,3 +150,26 @@ func (l *Labeler) GetRelabelingConfigs(monitorTypeMeta metav1.TypeMeta, monitorO
 		},
 	)
 }
+
+// GetRemoteWriteRelabelingConfigs - prepend the namespace enforcement
+// relabeling rule to the write relabel configs of a remote write endpoint.
+func (l *Labeler) GetRemoteWriteRelabelingConfigs(remoteWriteTypeMeta metav1.TypeMeta, remoteWriteObjectMeta metav1.ObjectMeta, rc []monitoringv1.RelabelConfig) []monitoringv1.RelabelConfig {
+
+	if l.IsExcluded(remoteWriteTypeMeta, remoteWriteObjectMeta) {
+		return rc
+	}
+
+	// The namespace enforcement relabeling comes first to ensure that only
+	// the series from the namespace of the remote write object are sent,
+	// whatever the other relabelings do.
+	return append(
+		[]monitoringv1.RelabelConfig{
+			{
+				SourceLabels: []monitoringv1.LabelName{monitoringv1.LabelName(l.GetEnforcedNamespaceLabel())},
+				Regex:        remoteWriteObjectMeta.GetNamespace(),
+				Action:       "keep",
+			},
+		},
+		rc...,
+	)
+}
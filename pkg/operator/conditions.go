@@ -15,6 +15,10 @@
 package operator
 
 import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
 
@@ -50,3 +54,41 @@ func UpdateConditions(conditions []monitoringv1.Condition, newConditions ...moni
 
 	return ret
 }
+
+// CombinedConditionStatus returns the status aggregating the given statuses.
+// A single False status makes the combined status False. Otherwise Degraded
+// takes precedence over Unknown which takes precedence over True.
+func CombinedConditionStatus(statuses []monitoringv1.ConditionStatus) monitoringv1.ConditionStatus {
+	var status monitoringv1.ConditionStatus
+	for _, s := range statuses {
+		switch s {
+		case monitoringv1.ConditionFalse:
+			return monitoringv1.ConditionFalse
+		case monitoringv1.ConditionDegraded:
+			status = monitoringv1.ConditionDegraded
+		case monitoringv1.ConditionUnknown:
+			if status == "" {
+				status = monitoringv1.ConditionUnknown
+			}
+		}
+	}
+
+	if status == "" {
+		return monitoringv1.ConditionTrue
+	}
+
+	return status
+}
+
+// CombinedConditionReason returns the reason aggregating the given reasons.
+// The non-empty reasons are deduplicated, sorted and joined with "And".
+func CombinedConditionReason(reasons []string) string {
+	uniqReasons := sets.New[string]()
+	for _, r := range reasons {
+		if r != "" {
+			uniqReasons.Insert(r)
+		}
+	}
+
+	return strings.Join(sets.List(uniqReasons), "And")
+}
@@ -0,0 +1,149 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestCombinedConditionStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		statuses []monitoringv1.ConditionStatus
+		exp      monitoringv1.ConditionStatus
+	}{
+		{
+			name:     "nil slice",
+			statuses: nil,
+			exp:      monitoringv1.ConditionTrue,
+		},
+		{
+			name:     "empty slice",
+			statuses: []monitoringv1.ConditionStatus{},
+			exp:      monitoringv1.ConditionTrue,
+		},
+		{
+			name:     "all True",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionTrue},
+			exp:      monitoringv1.ConditionTrue,
+		},
+		{
+			name:     "single False",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionFalse},
+			exp:      monitoringv1.ConditionFalse,
+		},
+		{
+			name:     "False short-circuits remaining statuses",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionFalse, monitoringv1.ConditionDegraded},
+			exp:      monitoringv1.ConditionFalse,
+		},
+		{
+			name:     "single Degraded",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionDegraded},
+			exp:      monitoringv1.ConditionDegraded,
+		},
+		{
+			name:     "Degraded with True",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionDegraded},
+			exp:      monitoringv1.ConditionDegraded,
+		},
+		{
+			name:     "single Unknown",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionUnknown},
+			exp:      monitoringv1.ConditionUnknown,
+		},
+		{
+			name:     "Unknown with True",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionUnknown},
+			exp:      monitoringv1.ConditionUnknown,
+		},
+		{
+			name:     "Degraded overrides Unknown",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionUnknown, monitoringv1.ConditionDegraded},
+			exp:      monitoringv1.ConditionDegraded,
+		},
+		{
+			name:     "Degraded then Unknown stays Degraded",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionDegraded, monitoringv1.ConditionUnknown},
+			exp:      monitoringv1.ConditionDegraded,
+		},
+		{
+			name:     "False takes priority over Degraded",
+			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionDegraded, monitoringv1.ConditionFalse},
+			exp:      monitoringv1.ConditionFalse,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, CombinedConditionStatus(tc.statuses))
+		})
+	}
+}
+
+func TestCombinedConditionReason(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		reasons []string
+		exp     string
+	}{
+		{
+			name:    "nil slice",
+			reasons: nil,
+			exp:     "",
+		},
+		{
+			name:    "empty slice",
+			reasons: []string{},
+			exp:     "",
+		},
+		{
+			name:    "all empty strings",
+			reasons: []string{"", ""},
+			exp:     "",
+		},
+		{
+			name:    "single reason",
+			reasons: []string{"ReasonA"},
+			exp:     "ReasonA",
+		},
+		{
+			name:    "duplicate reasons",
+			reasons: []string{"ReasonA", "ReasonA"},
+			exp:     "ReasonA",
+		},
+		{
+			name:    "two distinct reasons joined alphabetically",
+			reasons: []string{"ReasonB", "ReasonA"},
+			exp:     "ReasonAAndReasonB",
+		},
+		{
+			name:    "empty strings are ignored",
+			reasons: []string{"", "ReasonA", ""},
+			exp:     "ReasonA",
+		},
+		{
+			name:    "distinct reasons with duplicates and empty strings",
+			reasons: []string{"ReasonB", "", "ReasonA", "ReasonB"},
+			exp:     "ReasonAAndReasonB",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, CombinedConditionReason(tc.reasons))
+		})
+	}
+}
@@ -74,6 +74,11 @@ type Config struct {
 	// Event recorder factory.
 	EventRecorderFactory EventRecorderFactory
 
+	// LeaderElected is closed when the operator instance becomes the leader.
+	// When nil, leader election is disabled and the controllers reconcile
+	// objects as soon as their caches are synced.
+	LeaderElected <-chan struct{}
+
 	// Feature gates.
 	Gates *FeatureGates
 
@@ -118,6 +123,14 @@ func DefaultConfig(cpu, memory string) Config {
 				description: "Enables the RemoteWrite CRD support",
 				enabled:     false,
 			},
+			SilenceCustomResourceDefinitionFeature: FeatureGate{
+				description: "Enables the Silence CRD support",
+				enabled:     false,
+			},
+			PrometheusRuntimeHealthFeature: FeatureGate{
+				description: "Reports the runtime health of Prometheus and PrometheusAgent shards by querying the pods",
+				enabled:     false,
+			},
 		},
 		RepairPolicy: NoneRepairPolicy,
 	}
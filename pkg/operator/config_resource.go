@@ -46,7 +46,7 @@ const (
 // ConfigurationResource is a type constraint that permits only the specific pointer types for configuration resources
 // selectable by Prometheus, PrometheusAgent, Alertmanager or ThanosRuler.
 type ConfigurationResource interface {
-	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig | *monitoringv1.PrometheusRule
+	*monitoringv1.ServiceMonitor | *monitoringv1.PodMonitor | *monitoringv1.Probe | *monitoringv1alpha1.ScrapeConfig | *monitoringv1.PrometheusRule | *monitoringv1alpha1.RemoteWrite | *monitoringv1alpha1.AlertmanagerConfig
 }
 
 // TypedConfigurationResource is a generic type that holds a configuration resource with its validation status.
@@ -57,7 +57,7 @@ type TypedConfigurationResource[T ConfigurationResource] struct {
 	generation int64  // Generation of the desired state (spec).
 }
 
-// TypedResourcesSelection represents a map of configuration resources selected by Prometheus or PrometheusAgent.
+// TypedResourcesSelection represents a map of configuration resources selected by Prometheus, PrometheusAgent, Alertmanager or ThanosRuler.
 type TypedResourcesSelection[T ConfigurationResource] map[string]TypedConfigurationResource[T]
 
 func NewTypedConfigurationResource[T ConfigurationResource](res T, err error, reason string, generation int64) TypedConfigurationResource[T] {
@@ -0,0 +1,189 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// WorkloadDebugInfo describes the outcome of the last reconciliation of a
+// workload object (Prometheus, PrometheusAgent, Alertmanager or ThanosRuler).
+type WorkloadDebugInfo struct {
+	// Time of the last reconciliation.
+	LastReconciliation time.Time `json:"lastReconciliation"`
+	// Namespaces considered for the selection of configuration resources,
+	// indexed by resource kind.
+	Namespaces map[string][]string `json:"namespaces,omitempty"`
+	// Configuration resources selected by the workload, indexed by resource
+	// kind.
+	Resources map[string][]ResourceDebugInfo `json:"resources,omitempty"`
+	// Names of the ConfigMaps holding the rule files.
+	RuleConfigMaps []string `json:"ruleConfigMaps,omitempty"`
+	// Generated configuration with the secret values redacted.
+	Configuration string `json:"configuration,omitempty"`
+}
+
+// ResourceDebugInfo describes a configuration resource selected by a workload.
+type ResourceDebugInfo struct {
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	Generation int64  `json:"generation"`
+	Accepted   bool   `json:"accepted"`
+	Reason     string `json:"reason,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// NewResourcesDebugInfo returns the debug information of the selected
+// configuration resources, sorted by namespace and name.
+func NewResourcesDebugInfo[T ConfigurationResource](selection TypedResourcesSelection[T]) []ResourceDebugInfo {
+	res := make([]ResourceDebugInfo, 0, len(selection))
+	for _, r := range selection {
+		o := any(r.resource).(RuntimeObject)
+		info := ResourceDebugInfo{
+			Namespace:  o.GetNamespace(),
+			Name:       o.GetName(),
+			Generation: r.generation,
+			Accepted:   r.err == nil,
+			Reason:     r.reason,
+		}
+		if r.err != nil {
+			info.Message = r.err.Error()
+		}
+
+		res = append(res, info)
+	}
+
+	slices.SortFunc(res, func(a, b ResourceDebugInfo) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return res
+}
+
+// DebugStore keeps the debug information of the last reconciliation of
+// workload objects. It only uses their `<namespace>/<name>` key to identify
+// objects.
+//
+// The information is held in memory which means that it's available even when
+// the operator doesn't update the status of the configuration resources.
+//
+// The zero DebugStore is ready to use. A nil DebugStore discards the
+// information.
+type DebugStore struct {
+	mtx       sync.RWMutex
+	workloads map[string]WorkloadDebugInfo
+}
+
+// Set records the debug information for the object identified by key.
+func (ds *DebugStore) Set(key string, info WorkloadDebugInfo) {
+	if ds == nil {
+		return
+	}
+
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	if ds.workloads == nil {
+		ds.workloads = map[string]WorkloadDebugInfo{}
+	}
+
+	ds.workloads[key] = info
+}
+
+// Get returns the debug information for the object identified by key.
+func (ds *DebugStore) Get(key string) (WorkloadDebugInfo, bool) {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+
+	info, found := ds.workloads[key]
+	return info, found
+}
+
+// Keys returns the sorted keys of the objects known by the store.
+func (ds *DebugStore) Keys() []string {
+	ds.mtx.RLock()
+	defer ds.mtx.RUnlock()
+
+	keys := make([]string, 0, len(ds.workloads))
+	for k := range ds.workloads {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// ForgetObject removes the debug information of the object identified by key.
+func (ds *DebugStore) ForgetObject(key string) {
+	if ds == nil {
+		return
+	}
+
+	ds.mtx.Lock()
+	defer ds.mtx.Unlock()
+
+	delete(ds.workloads, key)
+}
+
+// NewDebugHandler returns an HTTP handler exposing the debug information of
+// the stores, indexed by resource name (e.g. "prometheuses").
+//
+// The handler serves the following paths (relative to the prefix):
+// * `/`: the list of workload objects per resource.
+// * `/{resource}/{namespace}/{name}`: the debug information of a workload object.
+func NewDebugHandler(prefix string, stores map[string]*DebugStore) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET "+prefix+"/{$}", func(w http.ResponseWriter, _ *http.Request) {
+		workloads := make(map[string][]string, len(stores))
+		for resource, ds := range stores {
+			workloads[resource] = ds.Keys()
+		}
+
+		writeJSON(w, http.StatusOK, workloads)
+	})
+
+	mux.HandleFunc("GET "+prefix+"/{resource}/{namespace}/{name}", func(w http.ResponseWriter, req *http.Request) {
+		ds, found := stores[req.PathValue("resource")]
+		if !found {
+			http.Error(w, "unknown resource", http.StatusNotFound)
+			return
+		}
+
+		info, found := ds.Get(req.PathValue("namespace") + "/" + req.PathValue("name"))
+		if !found {
+			http.Error(w, "object not found", http.StatusNotFound)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, info)
+	})
+
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
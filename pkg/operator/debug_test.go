@@ -0,0 +1,99 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestNewResourcesDebugInfo(t *testing.T) {
+	selection := TypedResourcesSelection[*monitoringv1.ServiceMonitor]{
+		"ns2/a": NewTypedConfigurationResource(
+			&monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "a"}},
+			nil, "", 1,
+		),
+		"ns1/b": NewTypedConfigurationResource(
+			&monitoringv1.ServiceMonitor{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "b"}},
+			errors.New("invalid"), InvalidConfiguration, 2,
+		),
+	}
+
+	require.Equal(t, []ResourceDebugInfo{
+		{Namespace: "ns1", Name: "b", Generation: 2, Reason: InvalidConfiguration, Message: "invalid"},
+		{Namespace: "ns2", Name: "a", Generation: 1, Accepted: true},
+	}, NewResourcesDebugInfo(selection))
+}
+
+func TestDebugHandler(t *testing.T) {
+	var ds DebugStore
+	ds.Set("default/foo", WorkloadDebugInfo{RuleConfigMaps: []string{"cm"}})
+	ds.Set("default/bar", WorkloadDebugInfo{})
+	ds.Set("default/baz", WorkloadDebugInfo{})
+	ds.ForgetObject("default/baz")
+
+	h := NewDebugHandler("/debug/workloads", map[string]*DebugStore{"prometheuses": &ds})
+
+	for _, tc := range []struct {
+		path string
+
+		code     int
+		expected any
+	}{
+		{
+			path:     "/debug/workloads/",
+			code:     http.StatusOK,
+			expected: map[string]any{"prometheuses": []any{"default/bar", "default/foo"}},
+		},
+		{
+			path: "/debug/workloads/prometheuses/default/foo",
+			code: http.StatusOK,
+			expected: map[string]any{
+				"lastReconciliation": "0001-01-01T00:00:00Z",
+				"ruleConfigMaps":     []any{"cm"},
+			},
+		},
+		{
+			path: "/debug/workloads/prometheuses/default/baz",
+			code: http.StatusNotFound,
+		},
+		{
+			path: "/debug/workloads/alertmanagers/default/foo",
+			code: http.StatusNotFound,
+		},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			require.Equal(t, tc.code, rec.Code)
+			if tc.expected == nil {
+				return
+			}
+
+			var got any
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,629 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
+)
+
+// exposureLabelKey is the label identifying the objects created by the
+// operator to expose a workload.
+const exposureLabelKey = "operator.prometheus.io/exposure"
+
+// HTTPRouteGVR is the Gateway API HTTPRoute resource.
+var HTTPRouteGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+
+// ExposedShard describes the pods of a workload's shard.
+type ExposedShard struct {
+	// StatefulSetName is the name of the shard's statefulset.
+	StatefulSetName string
+	// Replicas is the number of pods of the shard.
+	Replicas int32
+	// Selector selects the pods of the shard.
+	Selector map[string]string
+}
+
+// ExposedWorkload describes the workload exposed by the operator.
+type ExposedWorkload struct {
+	// Name is the prefix of the created objects' names (e.g.
+	// "prometheus-example").
+	Name      string
+	Namespace string
+
+	// ApplicationName and InstanceName identify the workload. They are used
+	// as values of the "app.kubernetes.io/name" and
+	// "app.kubernetes.io/instance" labels.
+	ApplicationName string
+	InstanceName    string
+
+	// ExternalURL is the URL (or only the path) under which the workload is
+	// reachable from the outside.
+	ExternalURL string
+	// RoutePrefix is the path prefix served by the pods.
+	RoutePrefix string
+
+	PortName string
+	Port     int32
+
+	// Selector selects all the pods of the workload.
+	Selector map[string]string
+	// Shards lists the shards of the workload indexed by shard number.
+	Shards []ExposedShard
+}
+
+// ExposureObjects holds the objects exposing a workload.
+type ExposureObjects struct {
+	Services   []*corev1.Service
+	Ingress    *networkingv1.Ingress
+	HTTPRoutes []*unstructured.Unstructured
+}
+
+// exposureTarget is a set of pods exposed behind a hostname.
+type exposureTarget struct {
+	name     string
+	hostname string
+	selector map[string]string
+}
+
+// MakeExposure returns the Services and the Ingress or HTTPRoute objects
+// exposing the workload.
+func MakeExposure(w ExposedWorkload, exposure monitoringv1.Exposure, opts ...ObjectOption) (*ExposureObjects, error) {
+	if (exposure.Ingress == nil) == (exposure.HTTPRoute == nil) {
+		return nil, errors.New("exactly one of ingress and httpRoute must be set")
+	}
+
+	hostname := ptr.Deref(exposure.Hostname, "")
+	routePrefix := cleanRoutePath(w.RoutePrefix)
+	exposedPath := routePrefix
+	if w.ExternalURL != "" {
+		u, err := url.Parse(w.ExternalURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the external URL %q: %w", w.ExternalURL, err)
+		}
+
+		if hostname == "" {
+			hostname = u.Hostname()
+		}
+
+		if u.Path != "" {
+			exposedPath = cleanRoutePath(u.Path)
+		}
+	}
+
+	targets, err := exposureTargets(w, hostname, ptr.Deref(exposure.HostnamePolicy, monitoringv1.SharedExposureHostnamePolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	objs := &ExposureObjects{}
+	for _, t := range targets {
+		objs.Services = append(objs.Services, makeExposureService(w, t, opts...))
+	}
+
+	objectOpts := append([]ObjectOption{
+		WithLabels(exposure.Labels),
+		WithAnnotations(exposure.Annotations),
+		WithLabels(exposureLabels(w)),
+	}, opts...)
+
+	if exposure.Ingress != nil {
+		if exposedPath != routePrefix {
+			return nil, fmt.Errorf("ingress: the path of the external URL (%q) must be equal to the route prefix (%q)", exposedPath, routePrefix)
+		}
+
+		objs.Ingress = makeIngress(w, *exposure.Ingress, targets, exposedPath, objectOpts...)
+		return objs, nil
+	}
+
+	for _, t := range targets {
+		route, err := makeHTTPRoute(w, *exposure.HTTPRoute, t, exposedPath, routePrefix, objectOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		objs.HTTPRoutes = append(objs.HTTPRoutes, route)
+	}
+
+	return objs, nil
+}
+
+func cleanRoutePath(p string) string {
+	return path.Clean("/" + p)
+}
+
+func exposureLabels(w ExposedWorkload) map[string]string {
+	return map[string]string{
+		ManagedByLabelKey:           ManagedByLabelValue,
+		ApplicationNameLabelKey:     w.ApplicationName,
+		ApplicationInstanceLabelKey: w.InstanceName,
+		exposureLabelKey:            "true",
+	}
+}
+
+// exposureTargets returns the targets exposed for the given hostname policy.
+// The first target always exposes all the pods.
+func exposureTargets(w ExposedWorkload, hostname string, policy monitoringv1.ExposureHostnamePolicy) ([]exposureTarget, error) {
+	targets := []exposureTarget{{
+		name:     w.Name + "-exposure",
+		hostname: hostname,
+		selector: w.Selector,
+	}}
+
+	if policy != monitoringv1.SharedExposureHostnamePolicy && hostname == "" {
+		return nil, fmt.Errorf("the %s hostname policy requires a hostname", policy)
+	}
+
+	switch policy {
+	case monitoringv1.SharedExposureHostnamePolicy:
+	case monitoringv1.PerShardExposureHostnamePolicy:
+		for i, shard := range w.Shards {
+			targets = append(targets, exposureTarget{
+				name:     fmt.Sprintf("%s-shard-%d-exposure", w.Name, i),
+				hostname: fmt.Sprintf("shard-%d.%s", i, hostname),
+				selector: shard.Selector,
+			})
+		}
+	case monitoringv1.PerReplicaExposureHostnamePolicy:
+		for _, shard := range w.Shards {
+			for i := range shard.Replicas {
+				podName := fmt.Sprintf("%s-%d", shard.StatefulSetName, i)
+
+				selector := maps.Clone(shard.Selector)
+				selector[appsv1.StatefulSetPodNameLabel] = podName
+
+				targets = append(targets, exposureTarget{
+					name:     podName + "-exposure",
+					hostname: podName + "." + hostname,
+					selector: selector,
+				})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported hostname policy %q", policy)
+	}
+
+	for _, t := range targets {
+		if errs := validation.IsDNS1035Label(t.name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid object name %q: %s", t.name, strings.Join(errs, ", "))
+		}
+	}
+
+	return targets, nil
+}
+
+func makeExposureService(w ExposedWorkload, t exposureTarget, opts ...ObjectOption) *corev1.Service {
+	svc := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       w.PortName,
+					Port:       w.Port,
+					TargetPort: intstr.FromString(w.PortName),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Selector: t.selector,
+		},
+	}
+
+	UpdateObject(
+		svc,
+		append([]ObjectOption{
+			WithName(t.name),
+			WithNamespace(w.Namespace),
+			WithLabels(exposureLabels(w)),
+		}, opts...)...,
+	)
+
+	return svc
+}
+
+func makeIngress(w ExposedWorkload, spec monitoringv1.IngressExposure, targets []exposureTarget, exposedPath string, opts ...ObjectOption) *networkingv1.Ingress {
+	ingress := &networkingv1.Ingress{
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: spec.IngressClassName,
+		},
+	}
+
+	var hosts []string
+	for _, t := range targets {
+		if t.hostname != "" {
+			hosts = append(hosts, t.hostname)
+		}
+
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{
+			Host: t.hostname,
+			IngressRuleValue: networkingv1.IngressRuleValue{
+				HTTP: &networkingv1.HTTPIngressRuleValue{
+					Paths: []networkingv1.HTTPIngressPath{
+						{
+							Path:     exposedPath,
+							PathType: ptr.To(networkingv1.PathTypePrefix),
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: t.name,
+									Port: networkingv1.ServiceBackendPort{Name: w.PortName},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	if spec.TLSSecretName != nil {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      hosts,
+				SecretName: *spec.TLSSecretName,
+			},
+		}
+	}
+
+	UpdateObject(
+		ingress,
+		append([]ObjectOption{
+			WithName(targets[0].name),
+			WithNamespace(w.Namespace),
+		}, opts...)...,
+	)
+
+	return ingress
+}
+
+// The following types are the subset of the Gateway API v1 HTTPRoute resource
+// written by the operator. The operator doesn't depend on the Gateway API
+// module and writes the objects as unstructured data.
+type httpRouteSpec struct {
+	ParentRefs []httpRouteParentReference `json:"parentRefs"`
+	Hostnames  []string                   `json:"hostnames,omitempty"`
+	Rules      []httpRouteRule            `json:"rules"`
+}
+
+type httpRouteParentReference struct {
+	Name        string  `json:"name"`
+	Namespace   *string `json:"namespace,omitempty"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type httpRouteRule struct {
+	Matches     []httpRouteMatch      `json:"matches"`
+	Filters     []httpRouteFilter     `json:"filters,omitempty"`
+	BackendRefs []httpRouteBackendRef `json:"backendRefs"`
+}
+
+type httpRouteMatch struct {
+	Path httpPathMatch `json:"path"`
+}
+
+type httpPathMatch struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type httpRouteFilter struct {
+	Type       string          `json:"type"`
+	URLRewrite *httpURLRewrite `json:"urlRewrite,omitempty"`
+}
+
+type httpURLRewrite struct {
+	Path httpPathModifier `json:"path"`
+}
+
+type httpPathModifier struct {
+	Type               string `json:"type"`
+	ReplacePrefixMatch string `json:"replacePrefixMatch"`
+}
+
+type httpRouteBackendRef struct {
+	Name string `json:"name"`
+	Port int32  `json:"port"`
+}
+
+func makeHTTPRoute(w ExposedWorkload, spec monitoringv1.HTTPRouteExposure, t exposureTarget, exposedPath, routePrefix string, opts ...ObjectOption) (*unstructured.Unstructured, error) {
+	rule := httpRouteRule{
+		Matches: []httpRouteMatch{
+			{
+				Path: httpPathMatch{
+					Type:  "PathPrefix",
+					Value: exposedPath,
+				},
+			},
+		},
+		BackendRefs: []httpRouteBackendRef{
+			{
+				Name: t.name,
+				Port: w.Port,
+			},
+		},
+	}
+
+	if exposedPath != routePrefix {
+		rule.Filters = []httpRouteFilter{
+			{
+				Type: "URLRewrite",
+				URLRewrite: &httpURLRewrite{
+					Path: httpPathModifier{
+						Type:               "ReplacePrefixMatch",
+						ReplacePrefixMatch: routePrefix,
+					},
+				},
+			},
+		}
+	}
+
+	routeSpec := httpRouteSpec{
+		Rules: []httpRouteRule{rule},
+	}
+
+	if t.hostname != "" {
+		routeSpec.Hostnames = []string{t.hostname}
+	}
+
+	for _, ref := range spec.ParentRefs {
+		routeSpec.ParentRefs = append(routeSpec.ParentRefs, httpRouteParentReference{
+			Name:        ref.Name,
+			Namespace:   ref.Namespace,
+			SectionName: ref.SectionName,
+			Port:        ref.Port,
+		})
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&routeSpec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert HTTPRoute %q: %w", t.name, err)
+	}
+
+	route := &unstructured.Unstructured{Object: map[string]any{"spec": content}}
+	route.SetAPIVersion(HTTPRouteGVR.GroupVersion().String())
+	route.SetKind("HTTPRoute")
+
+	UpdateObject(
+		route,
+		append([]ObjectOption{
+			WithName(t.name),
+			WithNamespace(w.Namespace),
+		}, opts...)...,
+	)
+
+	return route, nil
+}
+
+// ExposureSyncer reconciles the objects exposing the workloads outside of
+// the cluster.
+type ExposureSyncer struct {
+	kclient            kubernetes.Interface
+	dclient            dynamic.Interface
+	ingressSupported   bool
+	httpRouteSupported bool
+
+	// infs caches the metadata of the objects created by the syncer.
+	infs map[schema.GroupVersionResource]*informers.ForResource
+	gvks map[schema.GroupVersionResource]schema.GroupVersionKind
+	// listObjects returns the existing objects of the given resource.
+	listObjects func(gvr schema.GroupVersionResource, namespace string, selector labels.Selector) ([]metav1.Object, error)
+}
+
+// NewExposureSyncer returns a new ExposureSyncer.
+// The dynamic client is only used when httpRouteSupported is true.
+//
+// The syncer looks up the objects to delete from informers watching the
+// namespaces of the allow list: they must be started with Start() and synced
+// before calling Sync().
+func NewExposureSyncer(
+	kclient kubernetes.Interface,
+	dclient dynamic.Interface,
+	mdClient metadata.Interface,
+	allowList, denyList map[string]struct{},
+	resyncPeriod time.Duration,
+	ingressSupported, httpRouteSupported bool,
+) (*ExposureSyncer, error) {
+	s := &ExposureSyncer{
+		kclient:            kclient,
+		dclient:            dclient,
+		ingressSupported:   ingressSupported,
+		httpRouteSupported: httpRouteSupported,
+		infs:               map[schema.GroupVersionResource]*informers.ForResource{},
+		gvks:               map[schema.GroupVersionResource]schema.GroupVersionKind{},
+	}
+	s.listObjects = s.listFromInformers
+
+	if ingressSupported || httpRouteSupported {
+		s.gvks[corev1.SchemeGroupVersion.WithResource("services")] = corev1.SchemeGroupVersion.WithKind("Service")
+	}
+	if ingressSupported {
+		s.gvks[networkingv1.SchemeGroupVersion.WithResource("ingresses")] = networkingv1.SchemeGroupVersion.WithKind("Ingress")
+	}
+	if httpRouteSupported {
+		s.gvks[HTTPRouteGVR] = HTTPRouteGVR.GroupVersion().WithKind("HTTPRoute")
+	}
+
+	for gvr, gvk := range s.gvks {
+		infs, err := informers.NewInformersForResourceWithTransform(
+			informers.NewMetadataInformerFactory(
+				allowList,
+				denyList,
+				mdClient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = labels.SelectorFromSet(labels.Set{exposureLabelKey: "true"}).String()
+				},
+			),
+			gvr,
+			informers.OwnedObjectMetadataStrip(gvk),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating %s informers: %w", gvr.Resource, err)
+		}
+
+		s.infs[gvr] = infs
+	}
+
+	return s, nil
+}
+
+// Start starts the informers of the syncer.
+func (s *ExposureSyncer) Start(stopCh <-chan struct{}) {
+	for _, infs := range s.infs {
+		go infs.Start(stopCh)
+	}
+}
+
+// Informers returns the informers of the syncer indexed by name (e.g.
+// "ExposureService").
+func (s *ExposureSyncer) Informers() map[string]*informers.ForResource {
+	ret := make(map[string]*informers.ForResource, len(s.infs))
+	for gvr, infs := range s.infs {
+		ret["Exposure"+s.gvks[gvr].Kind] = infs
+	}
+
+	return ret
+}
+
+func (s *ExposureSyncer) listFromInformers(gvr schema.GroupVersionResource, namespace string, selector labels.Selector) ([]metav1.Object, error) {
+	var objs []metav1.Object
+	err := s.infs[gvr].ListAllByNamespace(namespace, selector, func(obj any) {
+		objs = append(objs, obj.(metav1.Object))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+	}
+
+	return objs, nil
+}
+
+// Check returns an error if the operator can't manage the objects required
+// by the exposure.
+func (s *ExposureSyncer) Check(exposure *monitoringv1.Exposure) error {
+	switch {
+	case exposure == nil:
+		return nil
+	case exposure.Ingress != nil && !s.ingressSupported:
+		return errors.New("exposure: the operator isn't allowed to manage Ingress objects")
+	case exposure.HTTPRoute != nil && !s.httpRouteSupported:
+		return errors.New("exposure: the HTTPRoute CRD isn't installed or the operator isn't allowed to manage HTTPRoute objects")
+	}
+
+	return nil
+}
+
+// Sync creates or updates the objects exposing the workload and deletes the
+// objects which aren't needed anymore (all of them if exposure is nil).
+func (s *ExposureSyncer) Sync(ctx context.Context, w ExposedWorkload, exposure *monitoringv1.Exposure, opts ...ObjectOption) error {
+	if err := s.Check(exposure); err != nil {
+		return err
+	}
+
+	if !s.ingressSupported && !s.httpRouteSupported {
+		return nil
+	}
+
+	objs := &ExposureObjects{}
+	if exposure != nil {
+		var err error
+		objs, err = MakeExposure(w, *exposure, opts...)
+		if err != nil {
+			return fmt.Errorf("exposure: %w", err)
+		}
+	}
+
+	svcClient := s.kclient.CoreV1().Services(w.Namespace)
+	services := sets.New[string]()
+	for _, svc := range objs.Services {
+		if _, err := k8s.CreateOrUpdateService(ctx, svcClient, svc); err != nil {
+			return fmt.Errorf("failed to synchronize Service %q: %w", svc.Name, err)
+		}
+		services.Insert(svc.Name)
+	}
+
+	selector := labels.SelectorFromSet(exposureLabels(w))
+
+	if s.ingressSupported {
+		ingressClient := s.kclient.NetworkingV1().Ingresses(w.Namespace)
+		ingresses := sets.New[string]()
+		if objs.Ingress != nil {
+			if err := k8s.CreateOrUpdateIngress(ctx, ingressClient, objs.Ingress); err != nil {
+				return fmt.Errorf("failed to synchronize Ingress %q: %w", objs.Ingress.Name, err)
+			}
+			ingresses.Insert(objs.Ingress.Name)
+		}
+
+		existing, err := s.listObjects(networkingv1.SchemeGroupVersion.WithResource("ingresses"), w.Namespace, selector)
+		if err != nil {
+			return err
+		}
+
+		if err := k8s.DeleteIngresses(ctx, ingressClient, existing, ingresses); err != nil {
+			return err
+		}
+	}
+
+	if s.httpRouteSupported {
+		routeClient := s.dclient.Resource(HTTPRouteGVR).Namespace(w.Namespace)
+		routes := sets.New[string]()
+		for _, route := range objs.HTTPRoutes {
+			if err := k8s.CreateOrUpdateUnstructured(ctx, routeClient, route); err != nil {
+				return fmt.Errorf("failed to synchronize HTTPRoute %q: %w", route.GetName(), err)
+			}
+			routes.Insert(route.GetName())
+		}
+
+		existing, err := s.listObjects(HTTPRouteGVR, w.Namespace, selector)
+		if err != nil {
+			return err
+		}
+
+		if err := k8s.DeleteUnstructured(ctx, routeClient, existing, routes); err != nil {
+			return err
+		}
+	}
+
+	existing, err := s.listObjects(corev1.SchemeGroupVersion.WithResource("services"), w.Namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	return k8s.DeleteServices(ctx, svcClient, existing, services)
+}
@@ -0,0 +1,524 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func newExposedWorkload() ExposedWorkload {
+	return ExposedWorkload{
+		Name:            "prometheus-test",
+		Namespace:       "ns",
+		ApplicationName: "prometheus",
+		InstanceName:    "test",
+		ExternalURL:     "https://prometheus.example.com/",
+		PortName:        "web",
+		Port:            9090,
+		Selector:        map[string]string{"prometheus": "test"},
+		Shards: []ExposedShard{
+			{
+				StatefulSetName: "prometheus-test",
+				Replicas:        2,
+				Selector:        map[string]string{"prometheus": "test", "shard": "0"},
+			},
+			{
+				StatefulSetName: "prometheus-test-shard-1",
+				Replicas:        2,
+				Selector:        map[string]string{"prometheus": "test", "shard": "1"},
+			},
+		},
+	}
+}
+
+func TestMakeExposureIngress(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		externalURL    string
+		routePrefix    string
+		exposure       monitoringv1.Exposure
+		expectedHosts  []string
+		expectedPath   string
+		expectedTLS    bool
+		expectedLabels map[string]string
+		expectedErr    bool
+	}{
+		{
+			name:          "hostname from the external URL",
+			externalURL:   "https://prometheus.example.com/",
+			exposure:      monitoringv1.Exposure{Ingress: &monitoringv1.IngressExposure{}},
+			expectedHosts: []string{"prometheus.example.com"},
+			expectedPath:  "/",
+		},
+		{
+			name:        "explicit hostname and TLS",
+			externalURL: "https://prometheus.example.com/",
+			exposure: monitoringv1.Exposure{
+				Ingress: &monitoringv1.IngressExposure{
+					IngressClassName: ptr.To("nginx"),
+					TLSSecretName:    ptr.To("tls"),
+				},
+				Hostname: ptr.To("prom.example.org"),
+				Labels:   map[string]string{"team": "a"},
+			},
+			expectedHosts: []string{"prom.example.org"},
+			expectedPath:  "/",
+			expectedTLS:   true,
+			expectedLabels: map[string]string{
+				"team": "a",
+			},
+		},
+		{
+			name:          "no hostname",
+			exposure:      monitoringv1.Exposure{Ingress: &monitoringv1.IngressExposure{}},
+			expectedHosts: []string{""},
+			expectedPath:  "/",
+		},
+		{
+			name:          "route prefix",
+			externalURL:   "https://example.com/prometheus",
+			routePrefix:   "/prometheus/",
+			exposure:      monitoringv1.Exposure{Ingress: &monitoringv1.IngressExposure{}},
+			expectedHosts: []string{"example.com"},
+			expectedPath:  "/prometheus",
+		},
+		{
+			name:        "path of the external URL different from the route prefix",
+			externalURL: "https://example.com/prometheus",
+			exposure:    monitoringv1.Exposure{Ingress: &monitoringv1.IngressExposure{}},
+			expectedErr: true,
+		},
+		{
+			name:        "per-shard hostnames",
+			externalURL: "https://prometheus.example.com/",
+			exposure: monitoringv1.Exposure{
+				Ingress:        &monitoringv1.IngressExposure{},
+				HostnamePolicy: ptr.To(monitoringv1.PerShardExposureHostnamePolicy),
+			},
+			expectedHosts: []string{
+				"prometheus.example.com",
+				"shard-0.prometheus.example.com",
+				"shard-1.prometheus.example.com",
+			},
+			expectedPath: "/",
+		},
+		{
+			name:        "per-replica hostnames",
+			externalURL: "https://prometheus.example.com/",
+			exposure: monitoringv1.Exposure{
+				Ingress:        &monitoringv1.IngressExposure{},
+				HostnamePolicy: ptr.To(monitoringv1.PerReplicaExposureHostnamePolicy),
+			},
+			expectedHosts: []string{
+				"prometheus.example.com",
+				"prometheus-test-0.prometheus.example.com",
+				"prometheus-test-1.prometheus.example.com",
+				"prometheus-test-shard-1-0.prometheus.example.com",
+				"prometheus-test-shard-1-1.prometheus.example.com",
+			},
+			expectedPath: "/",
+		},
+		{
+			name: "per-shard hostnames without hostname",
+			exposure: monitoringv1.Exposure{
+				Ingress:        &monitoringv1.IngressExposure{},
+				HostnamePolicy: ptr.To(monitoringv1.PerShardExposureHostnamePolicy),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "both ingress and httpRoute",
+			exposure: monitoringv1.Exposure{
+				Ingress:   &monitoringv1.IngressExposure{},
+				HTTPRoute: &monitoringv1.HTTPRouteExposure{},
+			},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newExposedWorkload()
+			w.ExternalURL = tc.externalURL
+			w.RoutePrefix = tc.routePrefix
+
+			objs, err := MakeExposure(w, tc.exposure)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Nil(t, objs.HTTPRoutes)
+			require.Len(t, objs.Services, len(tc.expectedHosts))
+			require.Equal(t, "prometheus-test-exposure", objs.Ingress.Name)
+			require.Equal(t, "ns", objs.Ingress.Namespace)
+			require.Equal(t, "true", objs.Ingress.Labels[exposureLabelKey])
+			for k, v := range tc.expectedLabels {
+				require.Equal(t, v, objs.Ingress.Labels[k])
+			}
+			require.Equal(t, tc.exposure.Ingress.IngressClassName, objs.Ingress.Spec.IngressClassName)
+
+			var hosts []string
+			for i, rule := range objs.Ingress.Spec.Rules {
+				hosts = append(hosts, rule.Host)
+
+				require.Len(t, rule.HTTP.Paths, 1)
+				require.Equal(t, tc.expectedPath, rule.HTTP.Paths[0].Path)
+				require.Equal(t, networkingv1.PathTypePrefix, *rule.HTTP.Paths[0].PathType)
+				require.Equal(t, objs.Services[i].Name, rule.HTTP.Paths[0].Backend.Service.Name)
+				require.Equal(t, "web", rule.HTTP.Paths[0].Backend.Service.Port.Name)
+			}
+			require.Equal(t, tc.expectedHosts, hosts)
+
+			if tc.expectedTLS {
+				require.Len(t, objs.Ingress.Spec.TLS, 1)
+				require.Equal(t, tc.expectedHosts, objs.Ingress.Spec.TLS[0].Hosts)
+				require.Equal(t, *tc.exposure.Ingress.TLSSecretName, objs.Ingress.Spec.TLS[0].SecretName)
+			} else {
+				require.Empty(t, objs.Ingress.Spec.TLS)
+			}
+		})
+	}
+}
+
+func TestMakeExposureServices(t *testing.T) {
+	objs, err := MakeExposure(newExposedWorkload(), monitoringv1.Exposure{
+		Ingress:        &monitoringv1.IngressExposure{},
+		HostnamePolicy: ptr.To(monitoringv1.PerReplicaExposureHostnamePolicy),
+	})
+	require.NoError(t, err)
+
+	selectors := map[string]map[string]string{}
+	for _, svc := range objs.Services {
+		require.Equal(t, "true", svc.Labels[exposureLabelKey])
+		require.Len(t, svc.Spec.Ports, 1)
+		require.Equal(t, int32(9090), svc.Spec.Ports[0].Port)
+		require.Equal(t, "web", svc.Spec.Ports[0].TargetPort.String())
+
+		selectors[svc.Name] = svc.Spec.Selector
+	}
+
+	require.Equal(t, map[string]map[string]string{
+		"prometheus-test-exposure": {"prometheus": "test"},
+		"prometheus-test-0-exposure": {
+			"prometheus":                         "test",
+			"shard":                              "0",
+			"statefulset.kubernetes.io/pod-name": "prometheus-test-0",
+		},
+		"prometheus-test-1-exposure": {
+			"prometheus":                         "test",
+			"shard":                              "0",
+			"statefulset.kubernetes.io/pod-name": "prometheus-test-1",
+		},
+		"prometheus-test-shard-1-0-exposure": {
+			"prometheus":                         "test",
+			"shard":                              "1",
+			"statefulset.kubernetes.io/pod-name": "prometheus-test-shard-1-0",
+		},
+		"prometheus-test-shard-1-1-exposure": {
+			"prometheus":                         "test",
+			"shard":                              "1",
+			"statefulset.kubernetes.io/pod-name": "prometheus-test-shard-1-1",
+		},
+	}, selectors)
+}
+
+func TestMakeExposureHTTPRoute(t *testing.T) {
+	w := newExposedWorkload()
+	w.ExternalURL = "https://example.com/prometheus/"
+
+	objs, err := MakeExposure(w, monitoringv1.Exposure{
+		HTTPRoute: &monitoringv1.HTTPRouteExposure{
+			ParentRefs: []monitoringv1.GatewayParentReference{
+				{
+					Name:        "gw",
+					Namespace:   ptr.To("gateway"),
+					SectionName: ptr.To("https"),
+				},
+			},
+		},
+		HostnamePolicy: ptr.To(monitoringv1.PerShardExposureHostnamePolicy),
+		Annotations:    map[string]string{"foo": "bar"},
+	})
+	require.NoError(t, err)
+
+	require.Nil(t, objs.Ingress)
+	require.Len(t, objs.Services, 3)
+	require.Len(t, objs.HTTPRoutes, 3)
+
+	route := objs.HTTPRoutes[1]
+	require.Equal(t, "gateway.networking.k8s.io/v1", route.GetAPIVersion())
+	require.Equal(t, "HTTPRoute", route.GetKind())
+	require.Equal(t, "prometheus-test-shard-0-exposure", route.GetName())
+	require.Equal(t, "ns", route.GetNamespace())
+	require.Equal(t, "bar", route.GetAnnotations()["foo"])
+	require.Equal(t, "true", route.GetLabels()[exposureLabelKey])
+
+	require.Equal(t, map[string]any{
+		"parentRefs": []any{
+			map[string]any{
+				"name":        "gw",
+				"namespace":   "gateway",
+				"sectionName": "https",
+			},
+		},
+		"hostnames": []any{"shard-0.example.com"},
+		"rules": []any{
+			map[string]any{
+				"matches": []any{
+					map[string]any{
+						"path": map[string]any{
+							"type":  "PathPrefix",
+							"value": "/prometheus",
+						},
+					},
+				},
+				"filters": []any{
+					map[string]any{
+						"type": "URLRewrite",
+						"urlRewrite": map[string]any{
+							"path": map[string]any{
+								"type":               "ReplacePrefixMatch",
+								"replacePrefixMatch": "/",
+							},
+						},
+					},
+				},
+				"backendRefs": []any{
+					map[string]any{
+						"name": "prometheus-test-shard-0-exposure",
+						"port": int64(9090),
+					},
+				},
+			},
+		},
+	}, route.Object["spec"])
+}
+
+func TestExposureSyncer(t *testing.T) {
+	ctx := context.Background()
+	owner := &monitoringv1.Prometheus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PrometheusesKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+			UID:       "uid",
+		},
+	}
+
+	kclient := fake.NewClientset()
+	dclient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{HTTPRouteGVR: "HTTPRouteList"},
+	)
+	syncer, err := NewExposureSyncer(kclient, dclient, metadatafake.NewSimpleMetadataClient(metadatafake.NewTestScheme()), nil, nil, 0, true, true)
+	require.NoError(t, err)
+
+	// Read the existing objects from the API rather than from the informers
+	// so that the objects created by the syncer are visible immediately.
+	syncer.listObjects = func(gvr schema.GroupVersionResource, namespace string, selector labels.Selector) ([]metav1.Object, error) {
+		var objs []metav1.Object
+		opts := metav1.ListOptions{LabelSelector: selector.String()}
+		switch gvr.Resource {
+		case "services":
+			list, err := kclient.CoreV1().Services(namespace).List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+		case "ingresses":
+			list, err := kclient.NetworkingV1().Ingresses(namespace).List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+		default:
+			list, err := dclient.Resource(gvr).Namespace(namespace).List(ctx, opts)
+			if err != nil {
+				return nil, err
+			}
+			for i := range list.Items {
+				objs = append(objs, &list.Items[i])
+			}
+		}
+
+		return objs, nil
+	}
+
+	listNames := func() ([]string, []string, []string) {
+		t.Helper()
+
+		var services, ingresses, routes []string
+
+		svcs, err := kclient.CoreV1().Services("ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		for _, svc := range svcs.Items {
+			require.Equal(t, "uid", string(svc.OwnerReferences[0].UID))
+			services = append(services, svc.Name)
+		}
+
+		ings, err := kclient.NetworkingV1().Ingresses("ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		for _, ing := range ings.Items {
+			ingresses = append(ingresses, ing.Name)
+		}
+
+		list, err := dclient.Resource(HTTPRouteGVR).Namespace("ns").List(ctx, metav1.ListOptions{})
+		require.NoError(t, err)
+		for _, route := range list.Items {
+			routes = append(routes, route.GetName())
+		}
+
+		return services, ingresses, routes
+	}
+
+	w := newExposedWorkload()
+
+	// Expose the pods with an Ingress object and per-shard hostnames.
+	require.NoError(t, syncer.Sync(ctx, w, &monitoringv1.Exposure{
+		Ingress:        &monitoringv1.IngressExposure{},
+		HostnamePolicy: ptr.To(monitoringv1.PerShardExposureHostnamePolicy),
+	}, WithOwner(owner)))
+
+	services, ingresses, routes := listNames()
+	require.ElementsMatch(t, []string{"prometheus-test-exposure", "prometheus-test-shard-0-exposure", "prometheus-test-shard-1-exposure"}, services)
+	require.Equal(t, []string{"prometheus-test-exposure"}, ingresses)
+	require.Empty(t, routes)
+
+	// Switch to HTTPRoute objects without per-shard hostnames.
+	require.NoError(t, syncer.Sync(ctx, w, &monitoringv1.Exposure{
+		HTTPRoute: &monitoringv1.HTTPRouteExposure{
+			ParentRefs: []monitoringv1.GatewayParentReference{{Name: "gw"}},
+		},
+	}, WithOwner(owner)))
+
+	services, ingresses, routes = listNames()
+	require.Equal(t, []string{"prometheus-test-exposure"}, services)
+	require.Empty(t, ingresses)
+	require.Equal(t, []string{"prometheus-test-exposure"}, routes)
+
+	// Updating the exposure updates the existing HTTPRoute object.
+	require.NoError(t, syncer.Sync(ctx, w, &monitoringv1.Exposure{
+		HTTPRoute: &monitoringv1.HTTPRouteExposure{
+			ParentRefs: []monitoringv1.GatewayParentReference{{Name: "other"}},
+		},
+	}, WithOwner(owner)))
+
+	route, err := dclient.Resource(HTTPRouteGVR).Namespace("ns").Get(ctx, "prometheus-test-exposure", metav1.GetOptions{})
+	require.NoError(t, err)
+	parentRefs, _, err := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	require.NoError(t, err)
+	require.Equal(t, []any{map[string]any{"name": "other"}}, parentRefs)
+
+	// Removing the exposure deletes all the objects.
+	require.NoError(t, syncer.Sync(ctx, w, nil, WithOwner(owner)))
+
+	services, ingresses, routes = listNames()
+	require.Empty(t, services)
+	require.Empty(t, ingresses)
+	require.Empty(t, routes)
+}
+
+func TestExposureSyncerCleanup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	w := newExposedWorkload()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "prometheus-test-exposure",
+			Namespace: "ns",
+			UID:       "svc-uid",
+			Labels:    exposureLabels(w),
+		},
+	}
+
+	kclient := fake.NewClientset(svc)
+	scheme := metadatafake.NewTestScheme()
+	for _, gvk := range []schema.GroupVersionKind{
+		corev1.SchemeGroupVersion.WithKind("Service"),
+		networkingv1.SchemeGroupVersion.WithKind("Ingress"),
+	} {
+		scheme.AddKnownTypeWithName(gvk, &metav1.PartialObjectMetadata{})
+		scheme.AddKnownTypeWithName(gvk.GroupVersion().WithKind(gvk.Kind+"List"), &metav1.PartialObjectMetadataList{})
+	}
+	mdClient := metadatafake.NewSimpleMetadataClient(
+		scheme,
+		&metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+			ObjectMeta: svc.ObjectMeta,
+		},
+	)
+
+	syncer, err := NewExposureSyncer(kclient, nil, mdClient, map[string]struct{}{metav1.NamespaceAll: {}}, nil, 0, true, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ExposureIngress", "ExposureService"}, slices.Sorted(maps.Keys(syncer.Informers())))
+
+	syncer.Start(ctx.Done())
+	require.Eventually(t, func() bool {
+		for _, infs := range syncer.Informers() {
+			if !infs.HasSynced() {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// No request is sent when there's nothing to delete.
+	other := newExposedWorkload()
+	other.InstanceName = "other"
+	require.NoError(t, syncer.Sync(ctx, other, nil))
+	require.Empty(t, kclient.Actions())
+
+	// The objects which aren't needed anymore are deleted.
+	require.NoError(t, syncer.Sync(ctx, w, nil))
+	_, err = kclient.CoreV1().Services("ns").Get(ctx, svc.Name, metav1.GetOptions{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestExposureSyncerCheck(t *testing.T) {
+	syncer, err := NewExposureSyncer(nil, nil, nil, nil, nil, 0, true, false)
+	require.NoError(t, err)
+
+	require.NoError(t, syncer.Check(nil))
+	require.NoError(t, syncer.Check(&monitoringv1.Exposure{Ingress: &monitoringv1.IngressExposure{}}))
+	require.Error(t, syncer.Check(&monitoringv1.Exposure{HTTPRoute: &monitoringv1.HTTPRouteExposure{}}))
+}
@@ -37,6 +37,12 @@ const (
 
 	// RemoteWriteCustomResourceDefinitionFeature enables the RemoteWrite CRD support.
 	RemoteWriteCustomResourceDefinitionFeature FeatureGateName = "RemoteWriteCustomResourceDefinition"
+
+	// SilenceCustomResourceDefinitionFeature enables the Silence CRD support.
+	SilenceCustomResourceDefinitionFeature FeatureGateName = "SilenceCustomResourceDefinition"
+
+	// PrometheusRuntimeHealthFeature enables the reporting of the Prometheus runtime health in the status subresource.
+	PrometheusRuntimeHealthFeature FeatureGateName = "PrometheusRuntimeHealth"
 )
 
 type FeatureGateName string
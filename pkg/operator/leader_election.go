@@ -0,0 +1,206 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// LeaderElectionConfig defines the parameters of the leader election.
+type LeaderElectionConfig struct {
+	// Enabled tells whether leader election is enabled.
+	Enabled bool
+	// LeaseName is the name of the Lease object used for leader election.
+	LeaseName string
+	// LeaseNamespace is the namespace of the Lease object. When empty, it
+	// defaults to the namespace of the operator's pod.
+	LeaseNamespace string
+	// LeaseDuration is the duration that non-leader candidates will wait
+	// before attempting to acquire the leadership.
+	LeaseDuration time.Duration
+	// RenewDeadline is the duration that the leader will retry refreshing
+	// the leadership before giving up.
+	RenewDeadline time.Duration
+	// RetryPeriod is the duration between leader election actions.
+	RetryPeriod time.Duration
+}
+
+// DefaultLeaderElectionConfig returns the default leader election
+// configuration.
+func DefaultLeaderElectionConfig() LeaderElectionConfig {
+	return LeaderElectionConfig{
+		LeaseName:     "prometheus-operator",
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	}
+}
+
+// LeaderElector runs the leader election between the operator instances.
+//
+// The controllers should start their informers before the instance is
+// elected so that the caches are warm when the leadership changes but they
+// should wait for the Elected() channel to be closed before reconciling
+// objects.
+type LeaderElector struct {
+	logger   *slog.Logger
+	elector  *leaderelection.LeaderElector
+	watchdog *leaderelection.HealthzAdaptor
+
+	elected  chan struct{}
+	isLeader atomic.Bool
+	leader   prometheus.Gauge
+}
+
+// NewLeaderElector returns a leader elector using a Lease object as the lock.
+func NewLeaderElector(logger *slog.Logger, kclient kubernetes.Interface, cfg LeaderElectionConfig, r prometheus.Registerer) (*LeaderElector, error) {
+	namespace := cfg.LeaseNamespace
+	if namespace == "" {
+		b, err := os.ReadFile(serviceAccountNamespaceFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine the namespace of the lease (hint: set it explicitly): %w", err)
+		}
+		namespace = strings.TrimSpace(string(b))
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+	identity := hostname + "_" + uuid.NewString()
+
+	le := &LeaderElector{
+		logger:   logger.With("component", "leader-election", "lease", namespace+"/"+cfg.LeaseName, "identity", identity),
+		watchdog: leaderelection.NewLeaderHealthzAdaptor(20 * time.Second),
+		elected:  make(chan struct{}),
+		leader: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "prometheus_operator_leader",
+			Help: "1 when the operator instance is the leader, 0 otherwise.",
+		}),
+	}
+
+	le.elector, err = leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Name:      cfg.LeaseName,
+				Namespace: namespace,
+			},
+			Client: kclient.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		Name:            cfg.LeaseName,
+		LeaseDuration:   cfg.LeaseDuration,
+		RenewDeadline:   cfg.RenewDeadline,
+		RetryPeriod:     cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		WatchDog:        le.watchdog,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(context.Context) {
+				le.logger.Info("acquired the leadership")
+				le.isLeader.Store(true)
+				le.leader.Set(1)
+				close(le.elected)
+			},
+			OnStoppedLeading: func() {
+				le.isLeader.Store(false)
+				le.leader.Set(0)
+			},
+			OnNewLeader: func(id string) {
+				if id != identity {
+					le.logger.Info("new leader elected", "leader", id)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid leader election configuration: %w", err)
+	}
+	le.watchdog.SetLeaderElection(le.elector)
+
+	r.MustRegister(le.leader)
+
+	return le, nil
+}
+
+// Run runs the leader election until the context is canceled.
+// It returns an error if the instance loses the leadership: the process
+// should exit because the controllers can't be stopped gracefully.
+func (le *LeaderElector) Run(ctx context.Context) error {
+	le.logger.Info("starting leader election")
+	le.elector.Run(ctx)
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	select {
+	case <-le.elected:
+		return errors.New("leader election lost")
+	default:
+		return nil
+	}
+}
+
+// Elected returns a channel which is closed when the instance becomes the
+// leader.
+func (le *LeaderElector) Elected() <-chan struct{} {
+	return le.elected
+}
+
+// IsLeader returns true if the instance is the leader.
+func (le *LeaderElector) IsLeader() bool {
+	return le.isLeader.Load()
+}
+
+// Check returns an error if the instance is the leader but failed to renew
+// the lease for too long.
+func (le *LeaderElector) Check(req *http.Request) error {
+	return le.watchdog.Check(req)
+}
+
+// WaitForLeadership blocks until the elected channel is closed or the
+// context is canceled. It returns false if the context has been canceled.
+// A nil channel means that leader election is disabled.
+func WaitForLeadership(ctx context.Context, elected <-chan struct{}) bool {
+	if elected == nil {
+		return true
+	}
+
+	select {
+	case <-elected:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWaitForLeadership(t *testing.T) {
+	require.True(t, WaitForLeadership(context.Background(), nil))
+
+	elected := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.False(t, WaitForLeadership(ctx, elected))
+
+	close(elected)
+	require.True(t, WaitForLeadership(context.Background(), elected))
+}
+
+func TestLeaderElector(t *testing.T) {
+	kclient := fake.NewClientset()
+	reg := prometheus.NewRegistry()
+
+	cfg := DefaultLeaderElectionConfig()
+	cfg.LeaseNamespace = "monitoring"
+	cfg.LeaseDuration = 2 * time.Second
+	cfg.RenewDeadline = time.Second
+	cfg.RetryPeriod = 100 * time.Millisecond
+
+	le, err := NewLeaderElector(slog.New(slog.DiscardHandler), kclient, cfg, reg)
+	require.NoError(t, err)
+	require.False(t, le.IsLeader())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- le.Run(ctx) }()
+
+	select {
+	case <-le.Elected():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the leadership")
+	}
+
+	require.True(t, le.IsLeader())
+	require.NoError(t, le.Check(httptest.NewRequest("GET", "/healthz", nil)))
+	require.Equal(t, 1.0, testutil.ToFloat64(le.leader))
+
+	lease, err := kclient.CoordinationV1().Leases("monitoring").Get(context.Background(), "prometheus-operator", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, lease.Spec.HolderIdentity)
+
+	// Stopping the leader election isn't an error.
+	cancel()
+	require.NoError(t, <-errCh)
+	require.False(t, le.IsLeader())
+	require.Equal(t, 0.0, testutil.ToFloat64(le.leader))
+}
+
+func TestLeaderElectorInvalidConfig(t *testing.T) {
+	cfg := DefaultLeaderElectionConfig()
+	cfg.LeaseNamespace = "monitoring"
+	cfg.RenewDeadline = cfg.LeaseDuration
+
+	_, err := NewLeaderElector(slog.New(slog.DiscardHandler), fake.NewClientset(), cfg, prometheus.NewRegistry())
+	require.Error(t, err)
+}
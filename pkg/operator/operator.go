@@ -25,6 +25,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -583,6 +584,12 @@ func ConfigMapGVK() schema.GroupVersionKind {
 	return corev1.SchemeGroupVersion.WithKind("ConfigMap")
 }
 
+// PodDisruptionBudgetGVK returns the GroupVersionKind representing
+// PodDisruptionBudget objects.
+func PodDisruptionBudgetGVK() schema.GroupVersionKind {
+	return policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
+}
+
 // SecretGVK returns the GroupVersionKind representing Secret objects.
 func SecretGVK() schema.GroupVersionKind {
 	return corev1.SchemeGroupVersion.WithKind("Secret")
@@ -0,0 +1,122 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package operatortest provides helpers to test the controllers. It must only
+// be imported by test files so that the fake clients aren't linked in the
+// operator's binaries.
+package operatortest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+// BindingsNamespace is the namespace of the workload and configuration
+// resources used by the binding tests.
+const BindingsNamespace = "default"
+
+// NewWorkloadBinding returns the binding of a workload resource with an
+// Accepted condition of the given status.
+func NewWorkloadBinding(workload schema.GroupResource, name string, status monitoringv1.ConditionStatus) monitoringv1.WorkloadBinding {
+	return monitoringv1.WorkloadBinding{
+		Group:      workload.Group,
+		Resource:   workload.Resource,
+		Namespace:  BindingsNamespace,
+		Name:       name,
+		Conditions: []monitoringv1.ConfigResourceCondition{{Type: monitoringv1.Accepted, Status: status}},
+	}
+}
+
+// BindingsClients holds the fake clients used to test the updates of the
+// bindings in the status of the configuration resources. The configuration
+// resources are read from the informers and the status is updated with the
+// dynamic client.
+type BindingsClients struct {
+	DynamicClient *dynamicfake.FakeDynamicClient
+	factory       informers.FactoriesForNamespaces
+}
+
+// NewBindingsClients returns fake clients holding the given configuration
+// resources.
+func NewBindingsClients(t *testing.T, objs ...runtime.Object) *BindingsClients {
+	scheme := runtime.NewScheme()
+	require.NoError(t, monitoringv1.AddToScheme(scheme))
+	require.NoError(t, monitoringv1alpha1.AddToScheme(scheme))
+
+	var (
+		dobjs = make([]runtime.Object, 0, len(objs))
+		mobjs = make([]runtime.Object, 0, len(objs))
+	)
+	for _, o := range objs {
+		dobjs = append(dobjs, o.DeepCopyObject())
+		mobjs = append(mobjs, o.DeepCopyObject())
+	}
+
+	return &BindingsClients{
+		DynamicClient: dynamicfake.NewSimpleDynamicClient(scheme, dobjs...),
+		factory: informers.NewMonitoringInformerFactories(
+			map[string]struct{}{BindingsNamespace: {}},
+			nil,
+			monitoringfake.NewClientset(mobjs...),
+			0,
+			nil,
+		),
+	}
+}
+
+// Informers returns the synced informers of the given configuration resource.
+func (c *BindingsClients) Informers(ctx context.Context, t *testing.T, gvr schema.GroupVersionResource) *informers.ForResource {
+	infs, err := informers.NewInformersForResource(c.factory, gvr)
+	require.NoError(t, err)
+
+	infs.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), infs.HasSynced))
+
+	return infs
+}
+
+// RequireBindings checks the bindings in the status of the configuration
+// resource. Only the type and status of the conditions are compared.
+func RequireBindings(ctx context.Context, t *testing.T, dclient dynamic.Interface, gvr schema.GroupVersionResource, name string, expected []monitoringv1.WorkloadBinding) {
+	u, err := dclient.Resource(gvr).Namespace(BindingsNamespace).Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	var status monitoringv1.ConfigResourceStatus
+	if s, found := u.Object["status"]; found {
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(s.(map[string]any), &status))
+	}
+
+	bindings := status.Bindings
+	for i := range bindings {
+		require.Len(t, bindings[i].Conditions, 1)
+		bindings[i].Conditions = []monitoringv1.ConfigResourceCondition{{
+			Type:   bindings[i].Conditions[0].Type,
+			Status: bindings[i].Conditions[0].Status,
+		}}
+	}
+	require.Equal(t, expected, bindings)
+}
@@ -0,0 +1,60 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+// MakePodDisruptionBudget returns the PodDisruptionBudget protecting the pods
+// of the statefulset. The PodDisruptionBudget has the same name, namespace,
+// labels and pod selector as the statefulset.
+//
+// The options should include a controller owner reference (see
+// WithManagingOwner) since only the controlled PodDisruptionBudgets are
+// deleted by k8s.DeletePodDisruptionBudgets().
+func MakePodDisruptionBudget(sset *appsv1.StatefulSet, spec monitoringv1.PodDisruptionBudgetSpec, opts ...ObjectOption) *policyv1.PodDisruptionBudget {
+	pdb := &policyv1.PodDisruptionBudget{
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   copyIntOrString(spec.MinAvailable),
+			MaxUnavailable: copyIntOrString(spec.MaxUnavailable),
+			Selector:       sset.Spec.Selector.DeepCopy(),
+		},
+	}
+
+	UpdateObject(
+		pdb,
+		append([]ObjectOption{
+			WithName(sset.Name),
+			WithNamespace(sset.Namespace),
+			WithLabels(sset.Labels),
+		}, opts...)...,
+	)
+
+	return pdb
+}
+
+func copyIntOrString(v *intstr.IntOrString) *intstr.IntOrString {
+	if v == nil {
+		return nil
+	}
+
+	c := *v
+	return &c
+}
@@ -0,0 +1,43 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+// RenderObjects provides the objects read by the controllers when they
+// generate the resources of a workload resource without a Kubernetes API
+// server.
+//
+// The implementation holding the objects in memory lives in the render
+// package which isn't linked by the operator's other binaries.
+type RenderObjects interface {
+	KubeClient() kubernetes.Interface
+	MonitoringClient() monitoringclient.Interface
+	// NamespaceInformer returns an informer holding the namespaces. The
+	// informer doesn't need to be started.
+	NamespaceInformer() cache.SharedIndexInformer
+	// NewInformersForResource returns synced informers for the given
+	// monitoring resource in all namespaces.
+	NewInformersForResource(context.Context, schema.GroupVersionResource) (*informers.ForResource, error)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"log/slog"
 	"maps"
 	"reflect"
@@ -77,8 +78,9 @@ type PrometheusRuleSelector struct {
 }
 
 type PrometheusRuleSelection struct {
-	selection TypedResourcesSelection[*monitoringv1.PrometheusRule] // PrometheusRules selected.
-	ruleFiles map[string]string                                     // Map of rule configuration files serialized to the Prometheus format (key=filename).
+	selection  TypedResourcesSelection[*monitoringv1.PrometheusRule] // PrometheusRules selected.
+	ruleFiles  map[string]string                                     // Map of rule configuration files serialized to the Prometheus format (key=filename).
+	namespaces []string                                              // Namespaces considered for the selection.
 }
 
 func (prs *PrometheusRuleSelection) RuleFiles() map[string]string {
@@ -89,6 +91,11 @@ func (prs *PrometheusRuleSelection) Selected() TypedResourcesSelection[*monitori
 	return prs.selection
 }
 
+// Namespaces returns the namespaces considered for the selection.
+func (prs *PrometheusRuleSelection) Namespaces() []string {
+	return prs.namespaces
+}
+
 func (prs *PrometheusRuleSelection) SelectedLen() int {
 	return len(prs.selection)
 }
@@ -97,6 +104,81 @@ func (prs *PrometheusRuleSelection) RejectedLen() int {
 	return len(prs.selection) - len(prs.ruleFiles)
 }
 
+// ShardedRuleFiles distributes the rule groups of the selected rules across
+// the given number of shards and returns the rule configuration files of
+// each shard.
+//
+// A rule group is assigned to a shard based on the hash of the
+// PrometheusRule's namespace and name and of the group's name, which makes
+// the distribution deterministic and independent of the other selected
+// rules. Rule files without groups for a given shard are omitted from the
+// shard's files.
+func (prs *PrometheusRuleSelection) ShardedRuleFiles(shards int) ([]map[string]string, error) {
+	if shards <= 1 {
+		return []map[string]string{prs.ruleFiles}, nil
+	}
+
+	ret := make([]map[string]string, shards)
+	for i := range ret {
+		ret[i] = map[string]string{}
+	}
+
+	for _, res := range prs.selection {
+		promRule := res.resource
+		filename := ruleFileName(promRule)
+
+		content, found := prs.ruleFiles[filename]
+		if !found {
+			// The resource has been rejected.
+			continue
+		}
+
+		var spec monitoringv1.PrometheusRuleSpec
+		if err := yaml.Unmarshal([]byte(content), &spec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rule file %q: %w", filename, err)
+		}
+
+		groups := make([][]monitoringv1.RuleGroup, shards)
+		for _, g := range spec.Groups {
+			shard := ruleGroupShard(promRule.Namespace, promRule.Name, g.Name, shards)
+			groups[shard] = append(groups[shard], g)
+		}
+
+		for shard := range groups {
+			if len(groups[shard]) == 0 {
+				continue
+			}
+
+			spec.Groups = groups[shard]
+			b, err := yaml.Marshal(spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal rule file %q: %w", filename, err)
+			}
+
+			ret[shard][filename] = string(b)
+		}
+	}
+
+	return ret, nil
+}
+
+// ruleGroupShard returns the shard to which the rule group is assigned.
+func ruleGroupShard(namespace, name, group string, shards int) int {
+	h := fnv.New64a()
+	// Writing to a hash never returns an error.
+	_, _ = h.Write([]byte(namespace + "/" + name + "/" + group))
+
+	return int(h.Sum64() % uint64(shards))
+}
+
+// ruleFileName returns the name of the rule configuration file generated for
+// the PrometheusRule resource.
+func ruleFileName(promRule *monitoringv1.PrometheusRule) string {
+	// Generate a truly unique identifier for each PrometheusRule resource.
+	// We use the UID to avoid collisions between foo-bar/fred and foo/bar-fred.
+	return fmt.Sprintf("%v-%v-%v.yaml", promRule.Namespace, promRule.Name, promRule.UID)
+}
+
 // NewPrometheusRuleSelector returns a PrometheusRuleSelector pointer.
 func NewPrometheusRuleSelector(ruleFormat RuleConfigurationFormat, version string, labelSelector *metav1.LabelSelector, nsLabeler *namespacelabeler.Labeler, ruleInformer *informers.ForResource, eventRecorder *EventRecorder, logger *slog.Logger, parserOptions parser.Options) (*PrometheusRuleSelector, error) {
 	componentVersion, err := semver.ParseTolerant(version)
@@ -202,6 +284,13 @@ func (prs *PrometheusRuleSelector) sanitizePrometheusRulesSpec(promRuleSpec moni
 
 // ValidateRule takes PrometheusRuleSpec and validates it using the upstream prometheus rule validator.
 func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec, validationScheme model.ValidationScheme, parserOptions parser.Options) []error {
+	_, errs := ParseRule(promRuleSpec, validationScheme, parserOptions)
+	return errs
+}
+
+// ParseRule validates the PrometheusRuleSpec like ValidateRule and returns
+// the rule groups parsed by the upstream prometheus rule parser.
+func ParseRule(promRuleSpec monitoringv1.PrometheusRuleSpec, validationScheme model.ValidationScheme, parserOptions parser.Options) (*rulefmt.RuleGroups, []error) {
 	for i := range promRuleSpec.Groups {
 		// The upstream Prometheus rule validator doesn't support the
 		// partial_response_strategy field.
@@ -222,17 +311,16 @@ func ValidateRule(promRuleSpec monitoringv1.PrometheusRuleSpec, validationScheme
 
 	content, err := yaml.Marshal(promRuleSpec)
 	if err != nil {
-		return []error{fmt.Errorf("failed to marshal content: %w", err)}
+		return nil, []error{fmt.Errorf("failed to marshal content: %w", err)}
 	}
 
 	// Check if the serialized rules exceed our internal limit.
 	promRuleSize := len(content)
 	if promRuleSize > MaxConfigMapDataSize {
-		return []error{fmt.Errorf("the length of rendered Prometheus Rule is %d bytes which is above the maximum limit of %d bytes", promRuleSize, MaxConfigMapDataSize)}
+		return nil, []error{fmt.Errorf("the length of rendered Prometheus Rule is %d bytes which is above the maximum limit of %d bytes", promRuleSize, MaxConfigMapDataSize)}
 	}
 
-	_, errs := rulefmt.Parse(content, false, validationScheme, parser.NewParser(parserOptions), slog.New(slog.DiscardHandler))
-	return errs
+	return rulefmt.Parse(content, false, validationScheme, parser.NewParser(parserOptions), slog.New(slog.DiscardHandler))
 }
 
 // Select selects PrometheusRules by Prometheus or ThanosRuler.
@@ -247,9 +335,7 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 				return
 			}
 
-			// Generate a truly unique identifier for each PrometheusRule resource.
-			// We use the UID to avoid collisions between foo-bar/fred and foo/bar-fred.
-			promRules[fmt.Sprintf("%v-%v-%v.yaml", promRule.Namespace, promRule.Name, promRule.UID)] = promRule
+			promRules[ruleFileName(promRule)] = promRule
 		})
 		if err != nil {
 			return PrometheusRuleSelection{}, fmt.Errorf("failed to list PrometheusRule objects in namespace %s: %w", ns, err)
@@ -308,8 +394,9 @@ func (prs *PrometheusRuleSelector) Select(namespaces []string) (PrometheusRuleSe
 	)
 
 	return PrometheusRuleSelection{
-		selection: rules,
-		ruleFiles: marshalRules,
+		selection:  rules,
+		ruleFiles:  marshalRules,
+		namespaces: namespaces,
 	}, nil
 }
 
@@ -16,6 +16,7 @@ package operator
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"strings"
@@ -27,8 +28,10 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 )
@@ -830,3 +833,70 @@ func TestPrometheusRuleSync(t *testing.T) {
 		)
 	})
 }
+
+func TestShardedRuleFiles(t *testing.T) {
+	newRule := func(name string, groups ...string) *monitoringv1.PrometheusRule {
+		pr := &monitoringv1.PrometheusRule{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID(name)},
+		}
+		for _, g := range groups {
+			pr.Spec.Groups = append(pr.Spec.Groups, monitoringv1.RuleGroup{
+				Name:  g,
+				Rules: []monitoringv1.Rule{{Record: "foo", Expr: intstr.FromString("vector(1)")}},
+			})
+		}
+
+		return pr
+	}
+
+	prs := PrometheusRuleSelection{
+		selection: TypedResourcesSelection[*monitoringv1.PrometheusRule]{},
+		ruleFiles: map[string]string{},
+	}
+	for _, pr := range []*monitoringv1.PrometheusRule{
+		newRule("a", "a1", "a2", "a3", "a4"),
+		newRule("b", "b1", "b2", "b3", "b4"),
+		newRule("c", "c1"),
+	} {
+		b, err := yaml.Marshal(pr.Spec)
+		require.NoError(t, err)
+
+		prs.selection["ns/"+pr.Name] = NewTypedConfigurationResource(pr, nil, "", 0)
+		prs.ruleFiles[ruleFileName(pr)] = string(b)
+	}
+	// Rejected resources are ignored.
+	prs.selection["ns/d"] = NewTypedConfigurationResource(newRule("d", "d1"), errors.New("invalid"), InvalidConfigurationEvent, 0)
+
+	t.Run("no sharding", func(t *testing.T) {
+		shards, err := prs.ShardedRuleFiles(1)
+		require.NoError(t, err)
+		require.Len(t, shards, 1)
+		require.Equal(t, prs.RuleFiles(), shards[0])
+	})
+
+	t.Run("3 shards", func(t *testing.T) {
+		shards, err := prs.ShardedRuleFiles(3)
+		require.NoError(t, err)
+		require.Len(t, shards, 3)
+
+		groups := map[string]int{}
+		for shard, files := range shards {
+			for filename, content := range files {
+				var spec monitoringv1.PrometheusRuleSpec
+				require.NoError(t, yaml.Unmarshal([]byte(content), &spec))
+				require.NotEmpty(t, spec.Groups, filename)
+
+				for _, g := range spec.Groups {
+					require.NotContains(t, groups, g.Name)
+					groups[g.Name] = shard
+				}
+			}
+		}
+		require.Len(t, groups, 9)
+
+		// The distribution is stable.
+		again, err := prs.ShardedRuleFiles(3)
+		require.NoError(t, err)
+		require.Equal(t, shards, again)
+	})
+}
@@ -17,9 +17,14 @@ package operator
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 
@@ -47,3 +52,268 @@ func CheckStorageClass(ctx context.Context, canReadStorageClass bool, kclient ku
 
 	return nil
 }
+
+// ExpandStatefulSetVolumes resizes the persistent volume claims of the
+// existing statefulset when the storage request of a volume claim template
+// increased in the desired statefulset.
+//
+// It returns true if the claims have been patched and the existing
+// statefulset has been deleted (orphaning its pods) so that it can be
+// recreated with the new volume claim templates. It returns false without
+// error when no template grew or when the storage class of a claim doesn't
+// allow volume expansion, in which case the caller should fall back to the
+// regular update path.
+//
+// When the desired statefulset has more replicas than the existing one, the
+// claims reused by the new replicas are expanded too: they may have been
+// left behind by a scale-down which happened before the storage increase.
+func ExpandStatefulSetVolumes(ctx context.Context, kclient kubernetes.Interface, existing, desired *appsv1.StatefulSet) (bool, error) {
+	if existing == nil || desired == nil {
+		return false, nil
+	}
+
+	var (
+		pvcClient        = kclient.CoreV1().PersistentVolumeClaims(existing.Namespace)
+		existingReplicas = ptr.Deref(existing.Spec.Replicas, 1)
+		replicas         = max(existingReplicas, ptr.Deref(desired.Spec.Replicas, 1))
+		toPatch          = map[string]resource.Quantity{}
+		found            bool
+	)
+	for _, tmpl := range desired.Spec.VolumeClaimTemplates {
+		requested, ok := tmpl.Spec.Resources.Requests[v1.ResourceStorage]
+		if !ok {
+			continue
+		}
+
+		// Without template growth, only the claims of the added replicas
+		// need to be checked.
+		first := existingReplicas
+		current, ok := volumeClaimTemplateStorage(existing, tmpl.Name)
+		grown := ok && requested.Cmp(current) > 0
+		if grown {
+			found = true
+			first = 0
+		}
+
+		for i := first; i < replicas; i++ {
+			name := fmt.Sprintf("%s-%s-%d", tmpl.Name, existing.Name, i)
+			pvc, err := pvcClient.Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return false, fmt.Errorf("failed to get persistent volume claim %q: %w", name, err)
+			}
+
+			if pvc.Spec.Resources.Requests.Storage().Cmp(requested) >= 0 {
+				continue
+			}
+
+			expandable, err := allowsVolumeExpansion(ctx, kclient, pvc)
+			if err != nil {
+				return false, err
+			}
+
+			if !expandable {
+				if grown {
+					return false, nil
+				}
+				continue
+			}
+
+			toPatch[name] = requested
+		}
+	}
+
+	for name, requested := range toPatch {
+		patch := fmt.Sprintf(`{"spec":{"resources":{"requests":{"storage":%q}}}}`, requested.String())
+		if _, err := pvcClient.Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+			return false, fmt.Errorf("failed to expand persistent volume claim %q: %w", name, err)
+		}
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	// The volume claim templates of a statefulset are immutable. Delete the
+	// statefulset without its pods so that it can be recreated.
+	err := kclient.AppsV1().StatefulSets(existing.Namespace).Delete(ctx, existing.Name, metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationOrphan),
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete statefulset %q: %w", existing.Name, err)
+	}
+
+	return true, nil
+}
+
+// VolumeExpansionCondition returns the StorageResized condition for the
+// statefulsets of a workload. It returns nil if the workload doesn't use
+// persistent volume claims.
+//
+// The persistent volume claims aren't read when the current conditions
+// already report that the claims have been resized for the given generation:
+// the requested storage can't change without a new generation and the
+// capacity of a claim never decreases.
+//
+// The last transition time of the current condition is retained if neither
+// the status nor the reason have changed.
+func VolumeExpansionCondition(ctx context.Context, kclient kubernetes.Interface, storage *monitoringv1.StorageSpec, ssets []*appsv1.StatefulSet, conditions []monitoringv1.Condition, generation int64) *monitoringv1.Condition {
+	if storage == nil || storage.EmptyDir != nil || storage.Ephemeral != nil {
+		return nil
+	}
+
+	requested, ok := storage.VolumeClaimTemplate.Spec.Resources.Requests[v1.ResourceStorage]
+	if !ok {
+		return nil
+	}
+
+	current := FindStatusCondition(conditions, monitoringv1.StorageResized)
+	if current != nil && current.Status == monitoringv1.ConditionTrue && current.ObservedGeneration == generation {
+		resized := *current
+		return &resized
+	}
+
+	condition := volumeExpansionCondition(ctx, kclient, requested, ssets, generation)
+	if current != nil && current.Status == condition.Status && current.Reason == condition.Reason {
+		condition.LastTransitionTime = current.LastTransitionTime
+	}
+
+	return condition
+}
+
+func volumeExpansionCondition(ctx context.Context, kclient kubernetes.Interface, requested resource.Quantity, ssets []*appsv1.StatefulSet, generation int64) *monitoringv1.Condition {
+	condition := &monitoringv1.Condition{
+		Type:               monitoringv1.StorageResized,
+		Status:             monitoringv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: generation,
+	}
+
+	var (
+		resizing   []string
+		failed     []string
+		notAllowed []string
+	)
+	for _, sset := range ssets {
+		if sset == nil {
+			continue
+		}
+
+		for _, tmpl := range sset.Spec.VolumeClaimTemplates {
+			for i := range ptr.Deref(sset.Spec.Replicas, 1) {
+				name := fmt.Sprintf("%s-%s-%d", tmpl.Name, sset.Name, i)
+				pvc, err := kclient.CoreV1().PersistentVolumeClaims(sset.Namespace).Get(ctx, name, metav1.GetOptions{})
+				if err != nil {
+					if apierrors.IsNotFound(err) {
+						continue
+					}
+
+					condition.Status = monitoringv1.ConditionUnknown
+					condition.Reason = "PersistentVolumeClaimUnavailable"
+					condition.Message = fmt.Sprintf("failed to get persistent volume claim %q: %s", name, err)
+					return condition
+				}
+
+				if pvc.Status.Capacity.Storage().Cmp(requested) >= 0 {
+					continue
+				}
+
+				switch {
+				case resizeInfeasible(pvc):
+					failed = append(failed, name)
+				case pvc.Spec.Resources.Requests.Storage().Cmp(requested) < 0:
+					expandable, err := allowsVolumeExpansion(ctx, kclient, pvc)
+					if err != nil {
+						condition.Status = monitoringv1.ConditionUnknown
+						condition.Reason = "StorageClassUnavailable"
+						condition.Message = err.Error()
+						return condition
+					}
+
+					if !expandable {
+						notAllowed = append(notAllowed, name)
+						continue
+					}
+
+					resizing = append(resizing, name)
+				case hasPVCCondition(pvc, v1.PersistentVolumeClaimFileSystemResizePending):
+					resizing = append(resizing, name+" (file system resize pending)")
+				default:
+					resizing = append(resizing, name)
+				}
+			}
+		}
+	}
+
+	switch {
+	case len(failed) > 0:
+		condition.Status = monitoringv1.ConditionFalse
+		condition.Reason = "ResizeFailed"
+		condition.Message = fmt.Sprintf("failed to resize persistent volume claims to %s: %s", requested.String(), strings.Join(failed, ", "))
+	case len(notAllowed) > 0:
+		condition.Status = monitoringv1.ConditionFalse
+		condition.Reason = "StorageClassNotExpandable"
+		condition.Message = fmt.Sprintf("the storage class doesn't allow volume expansion for persistent volume claims: %s", strings.Join(notAllowed, ", "))
+	case len(resizing) > 0:
+		condition.Status = monitoringv1.ConditionFalse
+		condition.Reason = "Resizing"
+		condition.Message = fmt.Sprintf("resizing persistent volume claims to %s: %s", requested.String(), strings.Join(resizing, ", "))
+	}
+
+	return condition
+}
+
+// volumeClaimTemplateStorage returns the storage request of the named volume
+// claim template.
+func volumeClaimTemplateStorage(sset *appsv1.StatefulSet, name string) (resource.Quantity, bool) {
+	for _, tmpl := range sset.Spec.VolumeClaimTemplates {
+		if tmpl.Name != name {
+			continue
+		}
+
+		q, ok := tmpl.Spec.Resources.Requests[v1.ResourceStorage]
+		return q, ok
+	}
+
+	return resource.Quantity{}, false
+}
+
+// allowsVolumeExpansion returns true if the storage class of the persistent
+// volume claim allows volume expansion.
+func allowsVolumeExpansion(ctx context.Context, kclient kubernetes.Interface, pvc *v1.PersistentVolumeClaim) (bool, error) {
+	storageClassName := ptr.Deref(pvc.Spec.StorageClassName, "")
+	if storageClassName == "" {
+		return false, nil
+	}
+
+	sc, err := kclient.StorageV1().StorageClasses().Get(ctx, storageClassName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("cannot get %q storageclass: %w", storageClassName, err)
+	}
+
+	return ptr.Deref(sc.AllowVolumeExpansion, false), nil
+}
+
+func resizeInfeasible(pvc *v1.PersistentVolumeClaim) bool {
+	switch pvc.Status.AllocatedResourceStatuses[v1.ResourceStorage] {
+	case v1.PersistentVolumeClaimControllerResizeInfeasible, v1.PersistentVolumeClaimNodeResizeInfeasible:
+		return true
+	}
+
+	return false
+}
+
+func hasPVCCondition(pvc *v1.PersistentVolumeClaim, t v1.PersistentVolumeClaimConditionType) bool {
+	for _, c := range pvc.Status.Conditions {
+		if c.Type == t && c.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
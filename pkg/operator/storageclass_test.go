@@ -0,0 +1,328 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func newTestStorageClass(name string, allowExpansion bool) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: name},
+		AllowVolumeExpansion: ptr.To(allowExpansion),
+	}
+}
+
+func newTestPVC(name, storageClass, request, capacity string) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+		Spec: v1.PersistentVolumeClaimSpec{
+			StorageClassName: ptr.To(storageClass),
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(request)},
+			},
+		},
+		Status: v1.PersistentVolumeClaimStatus{
+			Capacity: v1.ResourceList{v1.ResourceStorage: resource.MustParse(capacity)},
+		},
+	}
+}
+
+func newTestStatefulSet(storage string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus-test", Namespace: "ns"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To(int32(2)),
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{{
+				ObjectMeta: metav1.ObjectMeta{Name: "data"},
+				Spec: v1.PersistentVolumeClaimSpec{
+					Resources: v1.VolumeResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(storage)},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestExpandStatefulSetVolumes(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		storageClass *storagev1.StorageClass
+		desired      string
+		expanded     bool
+	}{
+		{
+			name:         "same size",
+			storageClass: newTestStorageClass("standard", true),
+			desired:      "1Gi",
+		},
+		{
+			name:         "smaller size",
+			storageClass: newTestStorageClass("standard", true),
+			desired:      "500Mi",
+		},
+		{
+			name:         "expansion not allowed",
+			storageClass: newTestStorageClass("standard", false),
+			desired:      "2Gi",
+		},
+		{
+			name:         "expansion allowed",
+			storageClass: newTestStorageClass("standard", true),
+			desired:      "2Gi",
+			expanded:     true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			existing := newTestStatefulSet("1Gi")
+			kclient := fake.NewClientset(
+				tc.storageClass,
+				existing,
+				newTestPVC("data-prometheus-test-0", "standard", "1Gi", "1Gi"),
+				newTestPVC("data-prometheus-test-1", "standard", "1Gi", "1Gi"),
+			)
+
+			expanded, err := ExpandStatefulSetVolumes(ctx, kclient, existing, newTestStatefulSet(tc.desired))
+			require.NoError(t, err)
+			require.Equal(t, tc.expanded, expanded)
+
+			_, err = kclient.AppsV1().StatefulSets("ns").Get(ctx, existing.Name, metav1.GetOptions{})
+			require.Equal(t, tc.expanded, apierrors.IsNotFound(err))
+
+			expected := resource.MustParse("1Gi")
+			if tc.expanded {
+				expected = resource.MustParse(tc.desired)
+			}
+
+			for _, name := range []string{"data-prometheus-test-0", "data-prometheus-test-1"} {
+				pvc, err := kclient.CoreV1().PersistentVolumeClaims("ns").Get(ctx, name, metav1.GetOptions{})
+				require.NoError(t, err)
+				require.Equal(t, 0, pvc.Spec.Resources.Requests.Storage().Cmp(expected), "unexpected request %s", pvc.Spec.Resources.Requests.Storage())
+			}
+		})
+	}
+}
+
+// TestExpandStatefulSetVolumesAfterScaleDown checks that the claims left
+// behind by a scale-down are expanded when the workload scales up again.
+func TestExpandStatefulSetVolumesAfterScaleDown(t *testing.T) {
+	ctx := context.Background()
+
+	withReplicas := func(sset *appsv1.StatefulSet, replicas int32) *appsv1.StatefulSet {
+		sset.Spec.Replicas = ptr.To(replicas)
+		return sset
+	}
+
+	requireRequest := func(t *testing.T, kclient *fake.Clientset, name, request string) {
+		t.Helper()
+
+		pvc, err := kclient.CoreV1().PersistentVolumeClaims("ns").Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Equal(t, 0, pvc.Spec.Resources.Requests.Storage().Cmp(resource.MustParse(request)), "unexpected request %s", pvc.Spec.Resources.Requests.Storage())
+	}
+
+	existing := withReplicas(newTestStatefulSet("1Gi"), 1)
+	kclient := fake.NewClientset(
+		newTestStorageClass("standard", true),
+		existing,
+		newTestPVC("data-prometheus-test-0", "standard", "1Gi", "1Gi"),
+		// Claim of the replica removed by the scale-down.
+		newTestPVC("data-prometheus-test-1", "standard", "1Gi", "1Gi"),
+	)
+
+	// The storage increases while the workload has 1 replica.
+	expanded, err := ExpandStatefulSetVolumes(ctx, kclient, existing, withReplicas(newTestStatefulSet("2Gi"), 1))
+	require.NoError(t, err)
+	require.True(t, expanded)
+	requireRequest(t, kclient, "data-prometheus-test-0", "2Gi")
+	requireRequest(t, kclient, "data-prometheus-test-1", "1Gi")
+
+	// The recreated statefulset scales up and reuses the claim left behind.
+	existing = withReplicas(newTestStatefulSet("2Gi"), 1)
+	_, err = kclient.AppsV1().StatefulSets("ns").Create(ctx, existing, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	expanded, err = ExpandStatefulSetVolumes(ctx, kclient, existing, withReplicas(newTestStatefulSet("2Gi"), 2))
+	require.NoError(t, err)
+	require.False(t, expanded)
+	requireRequest(t, kclient, "data-prometheus-test-1", "2Gi")
+
+	// The statefulset is updated in place.
+	_, err = kclient.AppsV1().StatefulSets("ns").Get(ctx, existing.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+}
+
+func TestVolumeExpansionCondition(t *testing.T) {
+	storage := &monitoringv1.StorageSpec{
+		VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("2Gi")},
+				},
+			},
+		},
+	}
+
+	infeasible := newTestPVC("data-prometheus-test-1", "standard", "2Gi", "1Gi")
+	infeasible.Status.AllocatedResourceStatuses = map[v1.ResourceName]v1.ClaimResourceStatus{
+		v1.ResourceStorage: v1.PersistentVolumeClaimControllerResizeInfeasible,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		storage *monitoringv1.StorageSpec
+		objects []*v1.PersistentVolumeClaim
+		status  monitoringv1.ConditionStatus
+		reason  string
+	}{
+		{
+			name:    "no storage",
+			storage: nil,
+		},
+		{
+			name:    "emptyDir",
+			storage: &monitoringv1.StorageSpec{EmptyDir: &v1.EmptyDirVolumeSource{}},
+		},
+		{
+			name:    "resized",
+			storage: storage,
+			objects: []*v1.PersistentVolumeClaim{
+				newTestPVC("data-prometheus-test-0", "standard", "2Gi", "2Gi"),
+				newTestPVC("data-prometheus-test-1", "standard", "2Gi", "2Gi"),
+			},
+			status: monitoringv1.ConditionTrue,
+		},
+		{
+			name:    "resizing",
+			storage: storage,
+			objects: []*v1.PersistentVolumeClaim{
+				newTestPVC("data-prometheus-test-0", "standard", "2Gi", "2Gi"),
+				newTestPVC("data-prometheus-test-1", "standard", "2Gi", "1Gi"),
+			},
+			status: monitoringv1.ConditionFalse,
+			reason: "Resizing",
+		},
+		{
+			name:    "resize failed",
+			storage: storage,
+			objects: []*v1.PersistentVolumeClaim{
+				newTestPVC("data-prometheus-test-0", "standard", "2Gi", "2Gi"),
+				infeasible,
+			},
+			status: monitoringv1.ConditionFalse,
+			reason: "ResizeFailed",
+		},
+		{
+			name:    "storage class not expandable",
+			storage: storage,
+			objects: []*v1.PersistentVolumeClaim{
+				newTestPVC("data-prometheus-test-0", "fixed", "1Gi", "1Gi"),
+				newTestPVC("data-prometheus-test-1", "fixed", "1Gi", "1Gi"),
+			},
+			status: monitoringv1.ConditionFalse,
+			reason: "StorageClassNotExpandable",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset(
+				newTestStorageClass("standard", true),
+				newTestStorageClass("fixed", false),
+			)
+			for _, pvc := range tc.objects {
+				_, err := kclient.CoreV1().PersistentVolumeClaims("ns").Create(context.Background(), pvc, metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+
+			cond := VolumeExpansionCondition(context.Background(), kclient, tc.storage, []*appsv1.StatefulSet{newTestStatefulSet("2Gi")}, nil, 3)
+			if tc.status == "" {
+				require.Nil(t, cond)
+				return
+			}
+
+			require.NotNil(t, cond)
+			require.Equal(t, monitoringv1.StorageResized, cond.Type)
+			require.Equal(t, tc.status, cond.Status)
+			require.Equal(t, tc.reason, cond.Reason)
+			require.Equal(t, int64(3), cond.ObservedGeneration)
+		})
+	}
+}
+
+func TestVolumeExpansionConditionLastTransitionTime(t *testing.T) {
+	storage := &monitoringv1.StorageSpec{
+		VolumeClaimTemplate: monitoringv1.EmbeddedPersistentVolumeClaim{
+			Spec: v1.PersistentVolumeClaimSpec{
+				Resources: v1.VolumeResourceRequirements{
+					Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("2Gi")},
+				},
+			},
+		},
+	}
+	lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	for _, tc := range []struct {
+		name     string
+		reason   string
+		expected bool
+	}{
+		{
+			name:     "same status and reason",
+			reason:   "Resizing",
+			expected: true,
+		},
+		{
+			name:     "same status and different reason",
+			reason:   "StorageClassNotExpandable",
+			expected: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset(
+				newTestStorageClass("standard", true),
+				newTestPVC("data-prometheus-test-0", "standard", "2Gi", "2Gi"),
+				newTestPVC("data-prometheus-test-1", "standard", "2Gi", "1Gi"),
+			)
+
+			conditions := []monitoringv1.Condition{{
+				Type:               monitoringv1.StorageResized,
+				Status:             monitoringv1.ConditionFalse,
+				Reason:             tc.reason,
+				LastTransitionTime: lastTransitionTime,
+				ObservedGeneration: 3,
+			}}
+
+			cond := VolumeExpansionCondition(context.Background(), kclient, storage, []*appsv1.StatefulSet{newTestStatefulSet("2Gi")}, conditions, 3)
+			require.NotNil(t, cond)
+			require.Equal(t, "Resizing", cond.Reason)
+			require.Equal(t, tc.expected, cond.LastTransitionTime.Equal(&lastTransitionTime))
+		})
+	}
+}
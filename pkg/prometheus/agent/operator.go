@@ -29,6 +29,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
@@ -59,6 +60,7 @@ const (
 // monitoring configurations.
 type Operator struct {
 	kclient  kubernetes.Interface
+	dclient  dynamic.Interface
 	mdClient metadata.Interface
 	mclient  monitoringclient.Interface
 
@@ -66,7 +68,8 @@ type Operator struct {
 
 	accessor *operator.Accessor
 
-	controllerID string
+	controllerID  string
+	leaderElected <-chan struct{}
 
 	nsPromInf cache.SharedIndexInformer
 	nsMonInf  cache.SharedIndexInformer
@@ -76,20 +79,27 @@ type Operator struct {
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
 	sconInfs  *informers.ForResource
+	rwInfs    *informers.ForResource
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
 	dsetInfs  *informers.ForResource
 
+	httpRouteInfs *informers.ForResource
+	gatewayInfs   *informers.ForResource
+
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
+	debugStore      *operator.DebugStore
 
 	config prompkg.Config
 
 	endpointSliceSupported bool // Whether the Kubernetes API supports the EndpointSlice kind.
 	scrapeConfigSupported  bool
+	remoteWriteSupported   bool
+	httpRouteSupported     bool
 	canReadStorageClass    bool
 
 	newEventRecorder operator.NewEventRecorderFunc
@@ -100,10 +110,31 @@ type Operator struct {
 	configResourcesStatusEnabled bool
 	topologyShardingEnabled      bool
 	podTopologyLabelsSupported   bool
+	runtimeHealthEnabled         bool
 
 	finalizerSyncer *operator.FinalizerSyncer
 }
 
+type selectedConfigResources struct {
+	sMons         operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor]
+	pMons         operator.TypedResourcesSelection[*monitoringv1.PodMonitor]
+	bMons         operator.TypedResourcesSelection[*monitoringv1.Probe]
+	scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
+	remoteWrites  operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]
+
+	probeHTTPRouteTargets map[string][]prompkg.HTTPRouteTarget
+
+	debugInfo operator.WorkloadDebugInfo
+}
+
+func (s *selectedConfigResources) Len() int {
+	return len(s.sMons) +
+		len(s.pMons) +
+		len(s.bMons) +
+		len(s.scrapeConfigs) +
+		len(s.remoteWrites)
+}
+
 type ControllerOption func(*Operator)
 
 // WithEndpointSlice tells that the Kubernetes API supports the Endpointslice resource.
@@ -120,6 +151,21 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithRemoteWrite tells that the controller manages RemoteWrite objects.
+func WithRemoteWrite() ControllerOption {
+	return func(o *Operator) {
+		o.remoteWriteSupported = true
+	}
+}
+
+// WithHTTPRoute tells that the controller can discover probe targets from
+// Gateway API HTTPRoute objects.
+func WithHTTPRoute() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteSupported = true
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -144,6 +190,23 @@ func WithPodTopologyLabels() ControllerOption {
 	}
 }
 
+// WithRuntimeHealth tells that the controller can query the HTTP API of the
+// pods to report their runtime health.
+func WithRuntimeHealth() ControllerOption {
+	return func(o *Operator) {
+		o.runtimeHealthEnabled = true
+	}
+}
+
+// WithDebugStore tells that the controller should record the debug
+// information of the last reconciliation of the PrometheusAgent objects in the
+// store. Without a store, the controller doesn't compute it.
+func WithDebugStore(ds *operator.DebugStore) ControllerOption {
+	return func(o *Operator) {
+		o.debugStore = ds
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -153,6 +216,11 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("instantiating kubernetes client failed: %w", err)
 	}
 
+	dclient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating dynamic client failed: %w", err)
+	}
+
 	mdClient, err := metadata.NewForConfig(restConfig)
 	if err != nil {
 		return nil, fmt.Errorf("instantiating metadata client failed: %w", err)
@@ -168,9 +236,11 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 
 	o := &Operator{
 		kclient:  client,
+		dclient:  dclient,
 		mdClient: mdClient,
 		mclient:  mclient,
 		logger:   logger,
+		accessor: operator.NewAccessor(logger),
 		config: prompkg.Config{
 			LocalHost:                      c.LocalHost,
 			ReloaderConfig:                 c.ReloaderConfig,
@@ -183,6 +253,7 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		metrics:                      operator.NewMetrics(r),
 		reconciliations:              &operator.ReconciliationTracker{},
 		controllerID:                 c.ControllerID,
+		leaderElected:                c.LeaderElected,
 		newEventRecorder:             c.EventRecorderFactory(client, controllerName),
 		configResourcesStatusEnabled: c.Gates.Enabled(operator.StatusForConfigurationResourcesFeature),
 		topologyShardingEnabled:      c.Gates.Enabled(operator.PrometheusTopologyShardingFeature),
@@ -289,6 +360,52 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	if o.remoteWriteSupported {
+		o.rwInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating remotewrite informers: %w", err)
+		}
+	}
+
+	if o.httpRouteSupported {
+		o.httpRouteInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dclient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.HTTPRouteGVR,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating httproute informers: %w", err)
+		}
+
+		o.gatewayInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dclient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.GatewayGVR,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating gateway informers: %w", err)
+		}
+	}
+
 	allowList := c.Namespaces.PrometheusAllowList
 	if c.WatchObjectRefsInAllNamespaces {
 		allowList = operator.MergeAllowLists(
@@ -410,12 +527,18 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	var statusReporterOpts []prompkg.StatusReporterOption
+	if o.runtimeHealthEnabled {
+		statusReporterOpts = append(statusReporterOpts, prompkg.WithHealthClient(prompkg.NewPodProxyHealthClient(o.kclient)))
+	}
+
 	o.statusReporter = prompkg.NewStatusReporter(
 		o.kclient,
 		o.reconciliations,
 		o.ssetInfs,
 		o.rr,
 		c.RepairPolicy,
+		statusReporterOpts...,
 	)
 
 	return o, nil
@@ -433,6 +556,13 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.remoteWriteSupported {
+		go c.rwInfs.Start(ctx.Done())
+	}
+	if c.httpRouteSupported {
+		go c.httpRouteInfs.Start(ctx.Done())
+		go c.gatewayInfs.Start(ctx.Done())
+	}
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
@@ -446,6 +576,13 @@ func (c *Operator) Run(ctx context.Context) error {
 	if err := c.waitForCacheSync(ctx); err != nil {
 		return err
 	}
+	c.metrics.Ready().Set(1)
+
+	// Wait for the leadership before reconciling the objects. The informers
+	// keep running in the meantime so that the caches are warm on failover.
+	if !operator.WaitForLeadership(ctx, c.leaderElected) {
+		return nil
+	}
 
 	// Refresh the status of the existing Prometheus agent objects.
 	_ = c.promInfs.ListAll(labels.Everything(), func(obj any) {
@@ -457,7 +594,6 @@ func (c *Operator) Run(ctx context.Context) error {
 	// TODO(simonpasquier): watch for PrometheusAgent pods instead of polling.
 	go operator.StatusPoller(ctx, c)
 
-	c.metrics.Ready().Set(1)
 	<-ctx.Done()
 	return nil
 }
@@ -487,13 +623,16 @@ func (c *Operator) waitForCacheSync(ctx context.Context) error {
 		{"PodMonitor", c.pmonInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"RemoteWrite", c.rwInfs},
+		{"HTTPRoute", c.httpRouteInfs},
+		{"Gateway", c.gatewayInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
 		{"DaemonSet", c.dsetInfs},
 	} {
 		// Skipping informers that were not started. If prerequisites for a CRD were not met, their informer will be
-		// nil. ScrapeConfig is one example.
+		// nil. ScrapeConfig, RemoteWrite and HTTPRoute are examples.
 		if infs.informersForResource == nil {
 			continue
 		}
@@ -589,6 +728,46 @@ func (c *Operator) addHandlers() {
 		))
 	}
 
+	if c.rwInfs != nil {
+		c.rwInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.RemoteWritesKind,
+			c.enqueueForNamespaceFunc(c.nsMonInf.GetStore()),
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
+	if c.httpRouteInfs != nil {
+		for _, infs := range []struct {
+			kind                 string
+			informersForResource *informers.ForResource
+		}{
+			{"HTTPRoute", c.httpRouteInfs},
+			{"Gateway", c.gatewayInfs},
+		} {
+			infs.informersForResource.AddEventHandler(operator.NewEventHandler(
+				c.logger,
+				c.accessor,
+				c.metrics,
+				infs.kind,
+				c.enqueueForProbeSelection,
+				operator.WithFilter(
+					operator.AnyFilter(
+						operator.GenerationChanged,
+						operator.LabelsChanged,
+					),
+				),
+			))
+		}
+	}
+
 	hasRefFunc := operator.HasReferenceFunc(
 		c.promInfs,
 		c.reconciliations,
@@ -633,58 +812,93 @@ func (c *Operator) addHandlers() {
 // Sync implements the operator.Syncer interface.
 func (c *Operator) Sync(ctx context.Context, key string) error {
 	c.reconciliations.ResetStatus(key)
-	err := c.sync(ctx, key)
+
+	closure, err := c.sync(ctx, key)
+	if err != nil {
+		_ = closure(ctx)
+	} else {
+		err = closure(ctx)
+	}
+
 	c.reconciliations.SetStatus(key, err)
 
 	return err
 }
 
-func (c *Operator) sync(ctx context.Context, key string) error {
+func (c *Operator) sync(ctx context.Context, key string) (func(context.Context) error, error) {
+	closure := func(context.Context) error { return nil }
+
 	p, err := operator.GetObjectFromKey[*monitoringv1alpha1.PrometheusAgent](c.promInfs, key)
 	if err != nil {
-		return err
+		return closure, err
 	}
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(key)
+		c.statusReporter.ForgetObject(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
-		return nil
+		return closure, nil
 	}
 
 	logger := c.logger.With("key", key)
 	logger.Info("sync prometheusagent")
 
-	finalizersAdded, err := c.finalizerSyncer.Sync(ctx, p, c.rr.DeletionInProgress(p), func() error { return nil })
+	finalizersAdded, err := c.finalizerSyncer.Sync(ctx, p, c.rr.DeletionInProgress(p), func() error {
+		return c.configResStatusCleanup(ctx, p)
+	})
 	if err != nil {
-		return err
+		return closure, err
 	}
 
 	if finalizersAdded {
 		// Since the object has been updated, let's trigger another sync.
 		c.rr.EnqueueForReconciliation(p)
-		return nil
+		return closure, nil
 	}
 
 	// Check if the Agent instance is marked for deletion.
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
-		return nil
+		c.debugStore.ForgetObject(key)
+		c.statusReporter.ForgetObject(key)
+		return closure, nil
 	}
 
 	if p.Spec.Paused {
 		logger.Info("no action taken (the resource is paused)")
-		return nil
+		return closure, nil
 	}
 
 	if ptr.Deref(p.Spec.Mode, "") == monitoringv1alpha1.DaemonSetPrometheusAgentMode && !c.daemonSetFeatureGateEnabled {
-		return fmt.Errorf("feature gate for Prometheus Agent's DaemonSet mode is not enabled")
+		return closure, fmt.Errorf("feature gate for Prometheus Agent's DaemonSet mode is not enabled")
+	}
+
+	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
+
+	// Select configuration resources.
+	resources, err := c.getSelectedConfigResources(ctx, logger, p, assetStore)
+	if err != nil {
+		return closure, err
+	}
+
+	// Returns updateConfigResourcesStatus as the closure
+	// so that we can call it at the end of each sync.
+	closure = func(ctx context.Context) error {
+		return c.updateConfigResourcesStatus(ctx, p, *resources)
+	}
+
+	// Record the debug information even if the reconciliation fails.
+	defer func() {
+		c.debugStore.Set(key, resources.debugInfo)
+	}()
+
+	if resources.Len() == 0 {
+		c.reconciliations.SetReasonAndMessage(key, operator.NoSelectedResourcesReason, noSelectedResourcesMessage)
 	}
 
 	// Generate the configuration data.
-	var (
-		assetStore = assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
-		opts       = []prompkg.ConfigGeneratorOption{}
-	)
+	opts := []prompkg.ConfigGeneratorOption{}
 	if c.endpointSliceSupported {
 		opts = append(opts, prompkg.WithEndpointSliceSupport())
 	}
@@ -700,21 +914,25 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 
 	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
 	if err != nil {
-		return err
+		return closure, err
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, assetStore); err != nil {
-		return fmt.Errorf("creating config failed: %w", err)
+	conf, err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, assetStore, resources)
+	if err != nil {
+		return closure, fmt.Errorf("creating config failed: %w", err)
+	}
+	if c.debugStore != nil {
+		resources.debugInfo.Configuration = prompkg.RedactedConfig(logger, conf)
 	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
 
 	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
 	if err != nil {
-		return fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+		return closure, fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
 
 	if err := c.createOrUpdateWebConfigSecret(ctx, p); err != nil {
-		return fmt.Errorf("synchronizing web config secret failed: %w", err)
+		return closure, fmt.Errorf("synchronizing web config secret failed: %w", err)
 	}
 
 	switch ptr.Deref(p.Spec.Mode, "") {
@@ -722,13 +940,138 @@ func (c *Operator) sync(ctx context.Context, key string) error {
 		err = c.syncDaemonSet(ctx, key, p, cg, tlsAssets)
 	default:
 		if err := operator.CheckStorageClass(ctx, c.canReadStorageClass, c.kclient, p.Spec.Storage); err != nil {
-			return err
+			return closure, err
 		}
 
 		err = c.syncStatefulSet(ctx, key, p, cg, tlsAssets)
 	}
 
-	return err
+	return closure, err
+}
+
+// updateConfigResourcesStatus updates the status of the selected configuration
+// resources (ServiceMonitor, PodMonitor, ScrapeConfig, RemoteWrite and Probe).
+func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent, resources selectedConfigResources) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	var configResourceSyncer = operator.NewConfigResourceSyncer(p, c.dclient, c.accessor)
+
+	// Update the status of selected serviceMonitors.
+	for key, configResource := range resources.sMons {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update ServiceMonitor %s status: %w", key, err)
+		}
+	}
+
+	// Update the status of selected podMonitors.
+	for key, configResource := range resources.pMons {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update PodMonitor %s status: %w", key, err)
+		}
+	}
+
+	// Update the status of selected probes.
+	for key, configResource := range resources.bMons {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update Probe %s status: %w", key, err)
+		}
+	}
+
+	// Update the status of selected scrapeConfigs.
+	for key, configResource := range resources.scrapeConfigs {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update ScrapeConfig %s status: %w", key, err)
+		}
+	}
+
+	// Update the status of selected remoteWrites.
+	for key, configResource := range resources.remoteWrites {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update RemoteWrite %s status: %w", key, err)
+		}
+	}
+
+	// Remove bindings from serviceMonitors which reference the
+	// workload but aren't selected anymore.
+	if err := operator.CleanupBindings(ctx, c.smonInfs.ListAll, resources.sMons, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for service monitors: %w", err)
+	}
+
+	// Remove bindings from podMonitors which reference the
+	// workload but aren't selected anymore.
+	if err := operator.CleanupBindings(ctx, c.pmonInfs.ListAll, resources.pMons, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for pod monitors: %w", err)
+	}
+
+	// Remove bindings from probes which reference the
+	// workload but aren't selected anymore.
+	if err := operator.CleanupBindings(ctx, c.probeInfs.ListAll, resources.bMons, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for probes: %w", err)
+	}
+
+	// Remove bindings from scrapeConfigs which reference the
+	// workload but aren't selected anymore.
+	// Only cleanup if ScrapeConfig support is enabled (sconInfs is initialized).
+	if c.sconInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.sconInfs.ListAll, resources.scrapeConfigs, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for scrapeConfigs: %w", err)
+		}
+	}
+
+	// Remove bindings from remoteWrites which reference the
+	// workload but aren't selected anymore.
+	// Only cleanup if RemoteWrite support is enabled (rwInfs is initialized).
+	if c.rwInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.rwInfs.ListAll, resources.remoteWrites, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for remoteWrites: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// configResStatusCleanup removes prometheusAgent bindings from the configuration resources (ServiceMonitor, PodMonitor, ScrapeConfig, RemoteWrite and Probe).
+func (c *Operator) configResStatusCleanup(ctx context.Context, p *monitoringv1alpha1.PrometheusAgent) error {
+	if !c.configResourcesStatusEnabled {
+		return nil
+	}
+
+	var configResourceSyncer = operator.NewConfigResourceSyncer(p, c.dclient, c.accessor)
+
+	// Remove bindings from all serviceMonitors which reference the workload.
+	if err := operator.CleanupBindings(ctx, c.smonInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor]{}, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for service monitors: %w", err)
+	}
+
+	// Remove bindings from all podMonitors which reference the workload.
+	if err := operator.CleanupBindings(ctx, c.pmonInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1.PodMonitor]{}, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for pod monitors: %w", err)
+	}
+
+	// Remove bindings from all probes which reference the workload.
+	if err := operator.CleanupBindings(ctx, c.probeInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1.Probe]{}, configResourceSyncer); err != nil {
+		return fmt.Errorf("failed to remove bindings for probes: %w", err)
+	}
+
+	// Remove bindings from all scrapeConfigs which reference the workload.
+	// Only cleanup if ScrapeConfig support is enabled (sconInfs is initialized).
+	if c.sconInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.sconInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]{}, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for scrapeConfigs: %w", err)
+		}
+	}
+
+	// Remove bindings from all remoteWrites which reference the workload.
+	// Only cleanup if RemoteWrite support is enabled (rwInfs is initialized).
+	if c.rwInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.rwInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]{}, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for remoteWrites: %w", err)
+		}
+	}
+
+	return nil
 }
 
 func (c *Operator) syncDaemonSet(ctx context.Context, key string, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, tlsAssets *operator.ShardedSecret) error {
@@ -925,78 +1268,105 @@ func (c *Operator) syncStatefulSet(ctx context.Context, key string, p *monitorin
 	return nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder) error {
-	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
+func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, store *assets.StoreBuilder) (*selectedConfigResources, error) {
+	var rsOpts []prompkg.ResourceSelectorOption
+	if c.httpRouteInfs != nil {
+		rsOpts = append(rsOpts, prompkg.WithHTTPRouteDiscovery(c.httpRouteInfs.ListAllByNamespace, c.gatewayInfs.Get))
+	}
+
+	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p), rsOpts...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	smons, err := resourceSelector.SelectServiceMonitors(ctx, c.smonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting ServiceMonitors failed: %w", err)
+		return nil, fmt.Errorf("selecting ServiceMonitors failed: %w", err)
 	}
 
 	pmons, err := resourceSelector.SelectPodMonitors(ctx, c.pmonInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting PodMonitors failed: %w", err)
+		return nil, fmt.Errorf("selecting PodMonitors failed: %w", err)
 	}
 
 	bmons, err := resourceSelector.SelectProbes(ctx, c.probeInfs.ListAllByNamespace)
 	if err != nil {
-		return fmt.Errorf("selecting Probes failed: %w", err)
+		return nil, fmt.Errorf("selecting Probes failed: %w", err)
 	}
 
 	var scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
 	if c.sconInfs != nil {
 		scrapeConfigs, err = resourceSelector.SelectScrapeConfigs(ctx, c.sconInfs.ListAllByNamespace)
 		if err != nil {
-			return fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
+			return nil, fmt.Errorf("selecting ScrapeConfigs failed: %w", err)
 		}
 	}
 
-	if len(smons)+len(pmons)+len(bmons)+len(scrapeConfigs) == 0 {
-		c.reconciliations.SetReasonAndMessage(operator.KeyForObject(p), operator.NoSelectedResourcesReason, noSelectedResourcesMessage)
+	var remoteWrites operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]
+	if c.rwInfs != nil {
+		remoteWrites, err = resourceSelector.SelectRemoteWrites(ctx, c.rwInfs.ListAllByNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("selecting RemoteWrites failed: %w", err)
+		}
+	}
+
+	var debugInfo operator.WorkloadDebugInfo
+	if c.debugStore != nil {
+		debugInfo = prompkg.NewWorkloadDebugInfo(resourceSelector, smons, pmons, bmons, scrapeConfigs, remoteWrites)
 	}
 
+	return &selectedConfigResources{
+		sMons:                 smons,
+		pMons:                 pmons,
+		bMons:                 bmons,
+		scrapeConfigs:         scrapeConfigs,
+		remoteWrites:          remoteWrites,
+		probeHTTPRouteTargets: resourceSelector.ProbeHTTPRouteTargets(),
+		debugInfo:             debugInfo,
+	}, nil
+}
+
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1alpha1.PrometheusAgent, cg *prompkg.ConfigGenerator, store *assets.StoreBuilder, resources *selectedConfigResources) ([]byte, error) {
 	if err := cg.AddRemoteWriteToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 
 	// Update secret based on the most recent configuration.
-	conf, err := cg.GenerateAgentConfiguration(
-		smons.ValidResources(),
-		pmons.ValidResources(),
-		bmons.ValidResources(),
-		scrapeConfigs.ValidResources(),
+	conf, err := cg.WithProbeHTTPRouteTargets(resources.probeHTTPRouteTargets).GenerateAgentConfiguration(
+		resources.sMons.ValidResources(),
+		resources.pMons.ValidResources(),
+		resources.bMons.ValidResources(),
+		resources.scrapeConfigs.ValidResources(),
+		resources.remoteWrites.ValidResources(),
 		store,
 		additionalScrapeConfigs,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, fmt.Errorf("generating config failed: %w", err)
 	}
 
 	// Compress config to avoid 1mb secret limit for a while
 	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return nil, fmt.Errorf("creating compressed secret failed: %w", err)
 	}
 
 	logger.Debug("updating Prometheus configuration secret")
-	return k8s.CreateOrUpdateSecret(ctx, sClient, s)
+	return conf, k8s.CreateOrUpdateSecret(ctx, sClient, s)
 }
 
 func createSSetInputHash(p monitoringv1alpha1.PrometheusAgent, c prompkg.Config, tlsAssets *operator.ShardedSecret, ssSpec appsv1.StatefulSetSpec) (string, error) {
@@ -1108,6 +1478,21 @@ func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitor
 	return nil
 }
 
+// enqueueForProbeSelection enqueues all PrometheusAgent objects which select
+// Probe objects. It is used for the HTTPRoute and Gateway objects which can be
+// selected by Probes from any namespace.
+func (c *Operator) enqueueForProbeSelection(_ string) {
+	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1alpha1.PrometheusAgent)
+		if p.Spec.ProbeSelector != nil {
+			c.rr.EnqueueForReconciliation(p)
+		}
+	})
+	if err != nil {
+		c.logger.Error("failed to list PrometheusAgent objects", "err", err)
+	}
+}
+
 func (c *Operator) enqueueForNamespaceFunc(gbk operator.GetByKeyer) func(string) {
 	return func(ns string) {
 		c.enqueueForNamespace(gbk, ns)
@@ -1198,6 +1583,21 @@ func (c *Operator) enqueueForNamespace(gbk operator.GetByKeyer, nsName string) {
 			c.rr.EnqueueForReconciliation(p)
 			return
 		}
+
+		// Check for Prometheus instances selecting RemoteWrites in the NS.
+		remoteWriteNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.RemoteWriteNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert RemoteWriteNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if remoteWriteNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
 	})
 	if err != nil {
 		c.logger.Error("listing all Prometheus instances from cache failed",
@@ -1223,13 +1623,14 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo any) {
 	c.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
 
 	// Check for Prometheus Agent instances selecting ServiceMonitors, PodMonitors,
-	// Probes and ScrapeConfigs in the namespace.
+	// Probes, ScrapeConfigs and RemoteWrites in the namespace.
 	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
 		p := obj.(*monitoringv1alpha1.PrometheusAgent)
 
 		for name, selector := range map[string]*metav1.LabelSelector{
 			"PodMonitors":     p.Spec.PodMonitorNamespaceSelector,
 			"Probes":          p.Spec.ProbeNamespaceSelector,
+			"RemoteWrites":    p.Spec.RemoteWriteNamespaceSelector,
 			"ScrapeConfigs":   p.Spec.ScrapeConfigNamespaceSelector,
 			"ServiceMonitors": p.Spec.ServiceMonitorNamespaceSelector,
 		} {
@@ -0,0 +1,392 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheusagent
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/k8s"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator/operatortest"
+)
+
+var (
+	agentResource = monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.PrometheusAgentName)
+
+	agentAccepted = operatortest.NewWorkloadBinding(agentResource.GroupResource(), "agent", monitoringv1.ConditionTrue)
+	agentRejected = operatortest.NewWorkloadBinding(agentResource.GroupResource(), "agent", monitoringv1.ConditionFalse)
+	otherAccepted = operatortest.NewWorkloadBinding(agentResource.GroupResource(), "other", monitoringv1.ConditionTrue)
+)
+
+func newTestAgent() *monitoringv1alpha1.PrometheusAgent {
+	return &monitoringv1alpha1.PrometheusAgent{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.PrometheusAgentsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "agent",
+			Namespace: operatortest.BindingsNamespace,
+		},
+	}
+}
+
+func newConfigResourceMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:       name,
+		Namespace:  operatortest.BindingsNamespace,
+		Generation: 1,
+	}
+}
+
+func newServiceMonitor(name string, bindings ...monitoringv1.WorkloadBinding) *monitoringv1.ServiceMonitor {
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.ServiceMonitorsKind,
+		},
+		ObjectMeta: newConfigResourceMeta(name),
+		Status:     monitoringv1.ConfigResourceStatus{Bindings: bindings},
+	}
+}
+
+func newPodMonitor(name string, bindings ...monitoringv1.WorkloadBinding) *monitoringv1.PodMonitor {
+	return &monitoringv1.PodMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.PodMonitorsKind,
+		},
+		ObjectMeta: newConfigResourceMeta(name),
+		Status:     monitoringv1.ConfigResourceStatus{Bindings: bindings},
+	}
+}
+
+func newProbe(name string, bindings ...monitoringv1.WorkloadBinding) *monitoringv1.Probe {
+	return &monitoringv1.Probe{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.ProbesKind,
+		},
+		ObjectMeta: newConfigResourceMeta(name),
+		Status:     monitoringv1.ConfigResourceStatus{Bindings: bindings},
+	}
+}
+
+func newScrapeConfig(name string, bindings ...monitoringv1.WorkloadBinding) *monitoringv1alpha1.ScrapeConfig {
+	return &monitoringv1alpha1.ScrapeConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.ScrapeConfigsKind,
+		},
+		ObjectMeta: newConfigResourceMeta(name),
+		Status:     monitoringv1.ConfigResourceStatus{Bindings: bindings},
+	}
+}
+
+func newRemoteWrite(name string, bindings ...monitoringv1.WorkloadBinding) *monitoringv1alpha1.RemoteWrite {
+	return &monitoringv1alpha1.RemoteWrite{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1alpha1.RemoteWritesKind,
+		},
+		ObjectMeta: newConfigResourceMeta(name),
+		Status:     monitoringv1.ConfigResourceStatus{Bindings: bindings},
+	}
+}
+
+// newBindingsTestOperator returns an operator which reads the configuration
+// resources from the informers of the given clients.
+func newBindingsTestOperator(ctx context.Context, t *testing.T, clients *operatortest.BindingsClients) *Operator {
+	logger := slog.New(slog.DiscardHandler)
+
+	return &Operator{
+		logger:                       logger,
+		dclient:                      clients.DynamicClient,
+		accessor:                     operator.NewAccessor(logger),
+		smonInfs:                     clients.Informers(ctx, t, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName)),
+		pmonInfs:                     clients.Informers(ctx, t, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName)),
+		probeInfs:                    clients.Informers(ctx, t, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName)),
+		sconInfs:                     clients.Informers(ctx, t, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName)),
+		rwInfs:                       clients.Informers(ctx, t, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName)),
+		configResourcesStatusEnabled: true,
+	}
+}
+
+// newSyncTestOperator returns an operator which can reconcile the
+// PrometheusAgent resources held by the given clients.
+func newSyncTestOperator(ctx context.Context, t *testing.T, clients *operatortest.BindingsClients, kclient *fake.Clientset) *Operator {
+	c := newBindingsTestOperator(ctx, t, clients)
+
+	c.kclient = kclient
+	c.config = defaultTestConfig
+	c.promInfs = clients.Informers(ctx, t, agentResource)
+	c.nsMonInf = cache.NewSharedIndexInformer(&cache.ListWatch{}, nil, 0, nil)
+	c.metrics = operator.NewMetrics(prometheus.NewRegistry())
+	c.newEventRecorder = func(related runtime.Object) *operator.EventRecorder {
+		return operator.NewFakeRecorder(10, related)
+	}
+	c.reconciliations = &operator.ReconciliationTracker{}
+	c.rr = operator.NewResourceReconciler(c.logger, c, c.promInfs, c.metrics, monitoringv1alpha1.PrometheusAgentsKind, prometheus.NewRegistry(), "")
+	c.finalizerSyncer = operator.NewNoopFinalizerSyncer()
+
+	var err error
+	c.dsetInfs, err = informers.NewInformersForResource(
+		informers.NewKubeInformerFactories(
+			map[string]struct{}{operatortest.BindingsNamespace: {}},
+			nil,
+			kclient,
+			0,
+			nil,
+		),
+		appsv1.SchemeGroupVersion.WithResource("daemonsets"),
+	)
+	require.NoError(t, err)
+	c.dsetInfs.Start(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), c.dsetInfs.HasSynced))
+
+	return c
+}
+
+func TestUpdateConfigResourcesStatus(t *testing.T) {
+	sMons := []*monitoringv1.ServiceMonitor{
+		newServiceMonitor("accepted"),
+		newServiceMonitor("rejected", agentAccepted),
+		newServiceMonitor("stale", otherAccepted, agentAccepted),
+	}
+	clients := operatortest.NewBindingsClients(t,
+		sMons[0],
+		sMons[1],
+		sMons[2],
+		newProbe("stale", agentAccepted),
+		newScrapeConfig("stale", agentAccepted, otherAccepted),
+		newRemoteWrite("stale", agentAccepted),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := newBindingsTestOperator(ctx, t, clients)
+
+	err := c.updateConfigResourcesStatus(ctx, newTestAgent(), selectedConfigResources{
+		sMons: operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor]{
+			"default/accepted": operator.NewTypedConfigurationResource(sMons[0], nil, "", 1),
+			"default/rejected": operator.NewTypedConfigurationResource(sMons[1], errors.New("invalid endpoint"), operator.InvalidConfiguration, 1),
+		},
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		resource string
+		name     string
+		expected []monitoringv1.WorkloadBinding
+	}{
+		{
+			resource: monitoringv1.ServiceMonitorName,
+			name:     "accepted",
+			expected: []monitoringv1.WorkloadBinding{agentAccepted},
+		},
+		{
+			resource: monitoringv1.ServiceMonitorName,
+			name:     "rejected",
+			expected: []monitoringv1.WorkloadBinding{agentRejected},
+		},
+		{
+			resource: monitoringv1.ServiceMonitorName,
+			name:     "stale",
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+		{
+			resource: monitoringv1.ProbeName,
+			name:     "stale",
+			expected: []monitoringv1.WorkloadBinding{},
+		},
+		{
+			resource: monitoringv1alpha1.ScrapeConfigName,
+			name:     "stale",
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+		{
+			resource: monitoringv1alpha1.RemoteWriteName,
+			name:     "stale",
+			expected: []monitoringv1.WorkloadBinding{},
+		},
+	} {
+		t.Run(tc.resource+"/"+tc.name, func(t *testing.T) {
+			operatortest.RequireBindings(ctx, t, clients.DynamicClient, configResourceGVR(tc.resource), tc.name, tc.expected)
+		})
+	}
+}
+
+func TestSyncDaemonSet(t *testing.T) {
+	p := newTestAgent()
+	p.Spec.Mode = ptr.To(monitoringv1alpha1.DaemonSetPrometheusAgentMode)
+	p.Spec.ServiceMonitorSelector = &metav1.LabelSelector{}
+
+	for _, tc := range []struct {
+		name        string
+		featureGate bool
+		expectedErr bool
+	}{
+		{
+			name:        "feature gate disabled",
+			featureGate: false,
+			expectedErr: true,
+		},
+		{
+			name:        "feature gate enabled",
+			featureGate: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			clients := operatortest.NewBindingsClients(t,
+				p,
+				newServiceMonitor("selected"),
+				newPodMonitor("stale", agentAccepted, otherAccepted),
+			)
+			kclient := fake.NewClientset()
+			c := newSyncTestOperator(ctx, t, clients, kclient)
+			c.daemonSetFeatureGateEnabled = tc.featureGate
+
+			closure, err := c.sync(ctx, operator.KeyForObject(p))
+			if tc.expectedErr {
+				require.Error(t, err)
+
+				// The bindings aren't updated when the DaemonSet mode isn't
+				// allowed.
+				require.NoError(t, closure(ctx))
+				operatortest.RequireBindings(ctx, t, clients.DynamicClient, configResourceGVR(monitoringv1.ServiceMonitorName), "selected", nil)
+				return
+			}
+			require.NoError(t, err)
+
+			dsets, err := kclient.AppsV1().DaemonSets(p.Namespace).List(ctx, metav1.ListOptions{})
+			require.NoError(t, err)
+			require.Len(t, dsets.Items, 1)
+
+			require.NoError(t, closure(ctx))
+			operatortest.RequireBindings(ctx, t, clients.DynamicClient, configResourceGVR(monitoringv1.ServiceMonitorName), "selected", []monitoringv1.WorkloadBinding{agentAccepted})
+			operatortest.RequireBindings(ctx, t, clients.DynamicClient, configResourceGVR(monitoringv1.PodMonitorName), "stale", []monitoringv1.WorkloadBinding{otherAccepted})
+		})
+	}
+}
+
+func TestConfigResStatusCleanupOnDeletion(t *testing.T) {
+	p := newTestAgent()
+	p.Finalizers = []string{k8s.StatusCleanupFinalizerName}
+	p.DeletionTimestamp = ptr.To(metav1.Now())
+
+	clients := operatortest.NewBindingsClients(t,
+		p,
+		newServiceMonitor("bound", agentAccepted, otherAccepted),
+		newPodMonitor("bound", agentRejected),
+		newProbe("bound", otherAccepted, agentAccepted),
+		newScrapeConfig("bound", agentAccepted),
+		newRemoteWrite("bound", agentAccepted, otherAccepted),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := newSyncTestOperator(ctx, t, clients, fake.NewClientset())
+
+	scheme := metadatafake.NewTestScheme()
+	scheme.AddKnownTypeWithName(monitoringv1alpha1.SchemeGroupVersion.WithKind(monitoringv1alpha1.PrometheusAgentsKind), &metav1.PartialObjectMetadata{})
+	scheme.AddKnownTypeWithName(monitoringv1alpha1.SchemeGroupVersion.WithKind(monitoringv1alpha1.PrometheusAgentsKind+"List"), &metav1.PartialObjectMetadataList{})
+	mdClient := metadatafake.NewSimpleMetadataClient(
+		scheme,
+		&metav1.PartialObjectMetadata{
+			TypeMeta:   p.TypeMeta,
+			ObjectMeta: p.ObjectMeta,
+		},
+	)
+	c.finalizerSyncer = operator.NewFinalizerSyncer(mdClient, agentResource)
+
+	closure, err := c.sync(ctx, operator.KeyForObject(p))
+	require.NoError(t, err)
+	require.NoError(t, closure(ctx))
+
+	for _, tc := range []struct {
+		resource string
+		expected []monitoringv1.WorkloadBinding
+	}{
+		{
+			resource: monitoringv1.ServiceMonitorName,
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+		{
+			resource: monitoringv1.PodMonitorName,
+			expected: []monitoringv1.WorkloadBinding{},
+		},
+		{
+			resource: monitoringv1.ProbeName,
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+		{
+			resource: monitoringv1alpha1.ScrapeConfigName,
+			expected: []monitoringv1.WorkloadBinding{},
+		},
+		{
+			resource: monitoringv1alpha1.RemoteWriteName,
+			expected: []monitoringv1.WorkloadBinding{otherAccepted},
+		},
+	} {
+		t.Run(tc.resource, func(t *testing.T) {
+			operatortest.RequireBindings(ctx, t, clients.DynamicClient, configResourceGVR(tc.resource), "bound", tc.expected)
+		})
+	}
+
+	md, err := mdClient.Resource(agentResource).Namespace(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotContains(t, md.Finalizers, k8s.StatusCleanupFinalizerName)
+}
+
+func TestSelectedConfigResourcesLen(t *testing.T) {
+	resources := selectedConfigResources{
+		remoteWrites: operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]{
+			"default/remote": operator.NewTypedConfigurationResource(&monitoringv1alpha1.RemoteWrite{}, nil, "", 1),
+		},
+	}
+
+	require.Equal(t, 1, resources.Len())
+}
+
+func configResourceGVR(resource string) schema.GroupVersionResource {
+	switch resource {
+	case monitoringv1alpha1.ScrapeConfigName, monitoringv1alpha1.RemoteWriteName:
+		return monitoringv1alpha1.SchemeGroupVersion.WithResource(resource)
+	}
+
+	return monitoringv1.SchemeGroupVersion.WithResource(resource)
+}
@@ -58,15 +58,43 @@ func prometheusStatusApplyConfigurationFromPrometheusStatus(status *monitoringv1
 	}
 
 	for _, shardStatus := range status.ShardStatuses {
-		psac.WithShardStatuses(
-			monitoringv1ac.ShardStatus().
-				WithShardID(shardStatus.ShardID).
-				WithReplicas(shardStatus.Replicas).
-				WithUpdatedReplicas(shardStatus.UpdatedReplicas).
-				WithAvailableReplicas(shardStatus.AvailableReplicas).
-				WithUnavailableReplicas(shardStatus.UnavailableReplicas),
-		)
+		ssac := monitoringv1ac.ShardStatus().
+			WithShardID(shardStatus.ShardID).
+			WithReplicas(shardStatus.Replicas).
+			WithUpdatedReplicas(shardStatus.UpdatedReplicas).
+			WithAvailableReplicas(shardStatus.AvailableReplicas).
+			WithUnavailableReplicas(shardStatus.UnavailableReplicas)
+
+		if shardStatus.Health != nil {
+			ssac.WithHealth(shardHealthApplyConfiguration(shardStatus.Health))
+		}
+
+		psac.WithShardStatuses(ssac)
 	}
 
 	return psac
 }
+
+func shardHealthApplyConfiguration(health *monitoringv1.ShardHealth) *monitoringv1ac.ShardHealthApplyConfiguration {
+	shac := monitoringv1ac.ShardHealth().
+		WithQueriedReplicas(health.QueriedReplicas).
+		WithConfigReloadSuccessful(health.ConfigReloadSuccessful).
+		WithActiveTargets(health.ActiveTargets).
+		WithDroppedTargets(health.DroppedTargets).
+		WithUnhealthyTargets(health.UnhealthyTargets).
+		WithWALCorruptions(health.WALCorruptions)
+
+	if health.LastConfigReloadTime != nil {
+		shac.WithLastConfigReloadTime(*health.LastConfigReloadTime)
+	}
+
+	if health.HeadSeries != nil {
+		shac.WithHeadSeries(*health.HeadSeries)
+	}
+
+	if health.RemoteWriteFailedSamples != nil {
+		shac.WithRemoteWriteFailedSamples(*health.RemoteWriteFailedSamples)
+	}
+
+	return shac
+}
@@ -0,0 +1,407 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// healthCheckTimeout is the maximum duration for querying all the pods of a
+// Prometheus resource. The pods are queried concurrently.
+const healthCheckTimeout = 5 * time.Second
+
+// HealthClient sends GET requests to the web server of Prometheus pods.
+type HealthClient interface {
+	// Get returns the body of the response for the given path which is
+	// relative to the route prefix of the Prometheus resource. The path may
+	// contain a query string.
+	Get(ctx context.Context, p monitoringv1.PrometheusInterface, pod *operator.Pod, path string) ([]byte, error)
+}
+
+// podProxyHealthClient implements HealthClient by sending the requests to the
+// Prometheus pods through the proxy subresource of the Kubernetes API.
+type podProxyHealthClient struct {
+	kclient kubernetes.Interface
+}
+
+// NewPodProxyHealthClient returns a HealthClient which goes through the proxy
+// subresource of the pods.
+func NewPodProxyHealthClient(kclient kubernetes.Interface) HealthClient {
+	return &podProxyHealthClient{kclient: kclient}
+}
+
+func (c *podProxyHealthClient) Get(ctx context.Context, p monitoringv1.PrometheusInterface, pod *operator.Pod, urlPath string) ([]byte, error) {
+	cpf := p.GetCommonPrometheusFields()
+
+	u, err := url.Parse(urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req := c.kclient.CoreV1().RESTClient().
+		Get().
+		Namespace(pod.Namespace).
+		Resource("pods").
+		SubResource("proxy").
+		Name(fmt.Sprintf("%s:%s:%s", cpf.PrometheusURIScheme(), pod.Name, cmp.Or(cpf.PortName, DefaultPortName))).
+		Suffix(path.Join(cpf.WebRoutePrefix(), u.Path))
+	for k, values := range u.Query() {
+		for _, v := range values {
+			req = req.Param(k, v)
+		}
+	}
+
+	return req.DoRaw(ctx)
+}
+
+// remoteWriteFailures remembers the number of failed remote write samples
+// reported by the pods at the previous health check. The counters are indexed
+// by the key of the workload resource and by the pod.
+type remoteWriteFailures struct {
+	mtx    sync.Mutex
+	totals map[string]map[string]int64
+}
+
+func (f *remoteWriteFailures) get(key string) map[string]int64 {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	return f.totals[key]
+}
+
+// set replaces the counters of the workload resource. The pods which haven't
+// been queried are forgotten.
+func (f *remoteWriteFailures) set(key string, totals map[string]int64) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if len(totals) == 0 {
+		delete(f.totals, key)
+		return
+	}
+
+	if f.totals == nil {
+		f.totals = map[string]map[string]int64{}
+	}
+	f.totals[key] = totals
+}
+
+// remoteWriteFailuresKey identifies a pod across the health checks. The UID
+// changes when the pod is recreated with the same name.
+func remoteWriteFailuresKey(pod *operator.Pod) string {
+	return pod.Name + "/" + string(pod.UID)
+}
+
+// podHealth is the runtime information reported by a Prometheus pod.
+type podHealth struct {
+	reloadSuccess            bool
+	lastReloadTime           time.Time
+	corruptionCount          int64
+	activeTargets            int
+	droppedTargets           int
+	unhealthyTargets         int
+	headSeries               *int64
+	remoteWriteFailedSamples int64
+}
+
+// apiResponse is the envelope of the Prometheus HTTP API responses.
+type apiResponse struct {
+	Status string          `json:"status"`
+	Data   json.RawMessage `json:"data"`
+	Error  string          `json:"error"`
+}
+
+func getAPIData(ctx context.Context, hc HealthClient, p monitoringv1.PrometheusInterface, pod *operator.Pod, urlPath string, v any) error {
+	b, err := hc.Get(ctx, p, pod, urlPath)
+	if err != nil {
+		return fmt.Errorf("%s: %w", urlPath, err)
+	}
+
+	var resp apiResponse
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return fmt.Errorf("%s: failed to decode response: %w", urlPath, err)
+	}
+
+	if resp.Status != "success" {
+		return fmt.Errorf("%s: %s", urlPath, cmp.Or(resp.Error, "unexpected status "+resp.Status))
+	}
+
+	if err := json.Unmarshal(resp.Data, v); err != nil {
+		return fmt.Errorf("%s: failed to decode data: %w", urlPath, err)
+	}
+
+	return nil
+}
+
+// getPodHealth queries the HTTP API and the metrics endpoint of a Prometheus
+// pod.
+func getPodHealth(ctx context.Context, hc HealthClient, p monitoringv1.PrometheusInterface, pod *operator.Pod) (*podHealth, error) {
+	var runtimeInfo struct {
+		ReloadConfigSuccess bool      `json:"reloadConfigSuccess"`
+		LastConfigTime      time.Time `json:"lastConfigTime"`
+		CorruptionCount     int64     `json:"corruptionCount"`
+	}
+	if err := getAPIData(ctx, hc, p, pod, "/api/v1/status/runtimeinfo", &runtimeInfo); err != nil {
+		return nil, err
+	}
+
+	// Only the active targets are requested because the dropped targets
+	// (with all their discovered labels) usually make the bulk of the
+	// response. The number of dropped targets is derived from the metrics.
+	var targets struct {
+		ActiveTargets []struct {
+			Health string `json:"health"`
+		} `json:"activeTargets"`
+	}
+	if err := getAPIData(ctx, hc, p, pod, "/api/v1/targets?state=active", &targets); err != nil {
+		return nil, err
+	}
+
+	ph := &podHealth{
+		reloadSuccess:   runtimeInfo.ReloadConfigSuccess,
+		lastReloadTime:  runtimeInfo.LastConfigTime,
+		corruptionCount: runtimeInfo.CorruptionCount,
+		activeTargets:   len(targets.ActiveTargets),
+	}
+
+	for _, t := range targets.ActiveTargets {
+		if t.Health == "down" {
+			ph.unhealthyTargets++
+		}
+	}
+
+	b, err := hc.Get(ctx, p, pod, "/metrics")
+	if err != nil {
+		return nil, fmt.Errorf("/metrics: %w", err)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	mfs, err := parser.TextToMetricFamilies(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("/metrics: failed to parse metrics: %w", err)
+	}
+
+	// The head series aren't exposed in agent mode.
+	if mf, found := mfs["prometheus_tsdb_head_series"]; found && len(mf.GetMetric()) > 0 {
+		ph.headSeries = new(int64(mf.GetMetric()[0].GetGauge().GetValue()))
+	}
+
+	// The discovered targets include the targets of the scrape and notify
+	// (Alertmanager) configurations.
+	if mf, found := mfs["prometheus_sd_discovered_targets"]; found {
+		var discovered int
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "name" && l.GetValue() == "scrape" {
+					discovered += int(m.GetGauge().GetValue())
+				}
+			}
+		}
+		ph.droppedTargets = max(0, discovered-ph.activeTargets)
+	}
+
+	if mf, found := mfs["prometheus_remote_storage_samples_failed_total"]; found {
+		for _, m := range mf.GetMetric() {
+			ph.remoteWriteFailedSamples += int64(m.GetCounter().GetValue())
+		}
+	}
+
+	return ph, nil
+}
+
+// healthReport accumulates the status, reason and message of the Healthy
+// condition.
+type healthReport struct {
+	statuses []monitoringv1.ConditionStatus
+	reasons  []string
+	messages []string
+}
+
+func (r *healthReport) add(status monitoringv1.ConditionStatus, reason, message string) {
+	r.statuses = append(r.statuses, status)
+	r.reasons = append(r.reasons, reason)
+	if message != "" {
+		r.messages = append(r.messages, message)
+	}
+}
+
+func (r *healthReport) merge(o healthReport) {
+	r.statuses = append(r.statuses, o.statuses...)
+	r.reasons = append(r.reasons, o.reasons...)
+	r.messages = append(r.messages, o.messages...)
+}
+
+// shardHealthCheck holds the result of the health check of a shard.
+type shardHealthCheck struct {
+	// index of the shard in the status' shard statuses.
+	index int
+	shard int
+	pods  []operator.Pod
+
+	health *monitoringv1.ShardHealth
+	// remoteWriteFailures holds the counters of failed remote write samples
+	// of the queried pods.
+	remoteWriteFailures map[string]int64
+	// report is the outcome for the Healthy condition.
+	report healthReport
+	// reload is the outcome for the ConfigReloaded condition.
+	reload healthReport
+}
+
+// checkHealth runs the health checks of the shards concurrently. The total
+// duration is bounded by healthCheckTimeout so that a slow or unresponsive pod
+// doesn't delay the status update.
+//
+// The number of failed remote write samples is reported as the increase since
+// the previous health check of the workload resource identified by key.
+func (sr *StatusReporter) checkHealth(ctx context.Context, p monitoringv1.PrometheusInterface, key string, checks []*shardHealthCheck) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	previous := sr.remoteWriteFailures.get(key)
+
+	var wg sync.WaitGroup
+	for _, hc := range checks {
+		wg.Go(func() {
+			hc.health = sr.shardHealth(ctx, p, hc, previous)
+		})
+	}
+	wg.Wait()
+
+	totals := map[string]int64{}
+	for _, hc := range checks {
+		maps.Copy(totals, hc.remoteWriteFailures)
+	}
+	sr.remoteWriteFailures.set(key, totals)
+}
+
+// shardHealth queries the ready pods of a shard concurrently and summarizes
+// their runtime information. The previous argument holds the counters of
+// failed remote write samples from the previous health check. It returns nil
+// if no pod answered.
+func (sr *StatusReporter) shardHealth(ctx context.Context, p monitoringv1.PrometheusInterface, hc *shardHealthCheck, previous map[string]int64) *monitoringv1.ShardHealth {
+	var (
+		shard  = hc.shard
+		pods   = hc.pods
+		report = &hc.report
+		reload = &hc.reload
+	)
+
+	if len(pods) == 0 {
+		report.add(monitoringv1.ConditionUnknown, "NoPodReady", fmt.Sprintf("shard %d: no pod ready", shard))
+		reload.add(monitoringv1.ConditionUnknown, "NoPodReady", fmt.Sprintf("shard %d: no pod ready", shard))
+		return nil
+	}
+
+	var (
+		results = make([]*podHealth, len(pods))
+		errs    = make([]error, len(pods))
+		wg      sync.WaitGroup
+	)
+	for i := range pods {
+		wg.Go(func() {
+			results[i], errs[i] = getPodHealth(ctx, sr.healthClient, p, &pods[i])
+		})
+	}
+	wg.Wait()
+
+	sh := &monitoringv1.ShardHealth{
+		ConfigReloadSuccessful: true,
+	}
+	hc.remoteWriteFailures = map[string]int64{}
+	for i := range pods {
+		pod, ph := &pods[i], results[i]
+		if errs[i] != nil {
+			// Keep the previous counter to compute the increase at the next
+			// health check.
+			if prev, found := previous[remoteWriteFailuresKey(pod)]; found {
+				hc.remoteWriteFailures[remoteWriteFailuresKey(pod)] = prev
+			}
+
+			msg := fmt.Sprintf("shard %d: pod %s: %s", shard, pod.Name, errs[i])
+			report.add(monitoringv1.ConditionUnknown, "HealthCheckFailed", msg)
+			reload.add(monitoringv1.ConditionUnknown, "HealthCheckFailed", msg)
+			continue
+		}
+
+		sh.QueriedReplicas++
+		sh.ActiveTargets = max(sh.ActiveTargets, int32(ph.activeTargets))
+		sh.DroppedTargets = max(sh.DroppedTargets, int32(ph.droppedTargets))
+		sh.UnhealthyTargets = max(sh.UnhealthyTargets, int32(ph.unhealthyTargets))
+		sh.WALCorruptions += ph.corruptionCount
+
+		// The counter restarts from zero when the container restarts.
+		var rwFailed int64
+		rwKey := remoteWriteFailuresKey(pod)
+		hc.remoteWriteFailures[rwKey] = ph.remoteWriteFailedSamples
+		if prev, found := previous[rwKey]; found {
+			rwFailed = ph.remoteWriteFailedSamples
+			if rwFailed >= prev {
+				rwFailed -= prev
+			}
+			sh.RemoteWriteFailedSamples = new(ptr.Deref(sh.RemoteWriteFailedSamples, 0) + rwFailed)
+		}
+
+		if ph.headSeries != nil {
+			sh.HeadSeries = new(max(ptr.Deref(sh.HeadSeries, 0), *ph.headSeries))
+		}
+
+		if !ph.lastReloadTime.IsZero() && (sh.LastConfigReloadTime == nil || ph.lastReloadTime.Before(sh.LastConfigReloadTime.Time)) {
+			sh.LastConfigReloadTime = &metav1.Time{Time: ph.lastReloadTime.UTC()}
+		}
+
+		if ph.reloadSuccess {
+			reload.add(monitoringv1.ConditionTrue, "", "")
+		} else {
+			reload.add(monitoringv1.ConditionFalse, "ConfigReloadFailed", fmt.Sprintf("shard %d: pod %s: the last configuration reload failed", shard, pod.Name))
+		}
+
+		switch {
+		case !ph.reloadSuccess:
+			sh.ConfigReloadSuccessful = false
+			report.add(monitoringv1.ConditionFalse, "ConfigReloadFailed", fmt.Sprintf("shard %d: pod %s: the last configuration reload failed", shard, pod.Name))
+		case ph.corruptionCount > 0:
+			report.add(monitoringv1.ConditionDegraded, "WALCorrupted", fmt.Sprintf("shard %d: pod %s: %d WAL corruption(s) detected", shard, pod.Name, ph.corruptionCount))
+		case rwFailed > 0:
+			report.add(monitoringv1.ConditionDegraded, "RemoteWriteFailed", fmt.Sprintf("shard %d: pod %s: %d sample(s) failed to be sent to the remote write endpoints since the previous health check", shard, pod.Name, rwFailed))
+		default:
+			report.add(monitoringv1.ConditionTrue, "", "")
+		}
+	}
+
+	if sh.QueriedReplicas == 0 {
+		return nil
+	}
+
+	return sh
+}
@@ -0,0 +1,331 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"cmp"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+var (
+	// HTTPRouteGVR is the Gateway API HTTPRoute resource.
+	HTTPRouteGVR = operator.HTTPRouteGVR
+	// GatewayGVR is the Gateway API Gateway resource.
+	GatewayGVR = schema.GroupVersionResource{Group: gatewayAPIGroup, Version: "v1", Resource: "gateways"}
+)
+
+// GetByKeyFn returns the object identified by the <namespace>/<name> key.
+type GetByKeyFn func(key string) (runtime.Object, error)
+
+// HTTPRouteTarget holds the URLs to probe for an HTTPRoute object.
+type HTTPRouteTarget struct {
+	Namespace string
+	Name      string
+	URLs      []string
+}
+
+// The following types are the subset of the Gateway API v1 HTTPRoute and
+// Gateway resources needed to compute the probed URLs. The operator doesn't
+// depend on the Gateway API module and reads the objects from unstructured
+// data.
+type httpRoute struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              httpRouteSpec `json:"spec"`
+}
+
+type httpRouteSpec struct {
+	ParentRefs []parentReference `json:"parentRefs"`
+	Hostnames  []string          `json:"hostnames"`
+	Rules      []httpRouteRule   `json:"rules"`
+}
+
+type parentReference struct {
+	Group       *string `json:"group"`
+	Kind        *string `json:"kind"`
+	Namespace   *string `json:"namespace"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName"`
+	Port        *int32  `json:"port"`
+}
+
+type httpRouteRule struct {
+	Matches []httpRouteMatch `json:"matches"`
+}
+
+type httpRouteMatch struct {
+	Path *httpPathMatch `json:"path"`
+}
+
+type httpPathMatch struct {
+	Type  *string `json:"type"`
+	Value *string `json:"value"`
+}
+
+type gateway struct {
+	Spec gatewaySpec `json:"spec"`
+}
+
+type gatewaySpec struct {
+	Listeners []listener `json:"listeners"`
+}
+
+type listener struct {
+	Name     string  `json:"name"`
+	Hostname *string `json:"hostname"`
+	Port     int32   `json:"port"`
+	Protocol string  `json:"protocol"`
+}
+
+func fromUnstructured(obj runtime.Object, v any) error {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", obj)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), v)
+}
+
+// selectHTTPRouteTargets returns the probed URLs of the HTTPRoute objects
+// selected by the Probe.
+//
+// It also returns the keys of the parent Gateways which couldn't be found,
+// either because they don't exist or because they live outside of the
+// namespaces watched by the operator.
+//
+// When ignoreNamespaceSelectors is true, the HTTPRoute objects are only
+// selected from the namespace of the Probe.
+func selectHTTPRouteTargets(probe *monitoringv1.Probe, ignoreNamespaceSelectors bool, listHTTPRoutes ListAllByNamespaceFn, getGateway GetByKeyFn) ([]HTTPRouteTarget, []string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&probe.Spec.Targets.HTTPRoute.Selector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("selector: %w", err)
+	}
+
+	var namespaces []string
+	switch nsSelector := probe.Spec.Targets.HTTPRoute.NamespaceSelector; {
+	case ignoreNamespaceSelectors:
+		namespaces = []string{probe.Namespace}
+	case nsSelector.Any:
+		namespaces = []string{metav1.NamespaceAll}
+	case len(nsSelector.MatchNames) > 0:
+		namespaces = nsSelector.MatchNames
+	default:
+		namespaces = []string{probe.Namespace}
+	}
+
+	var (
+		routes []httpRoute
+		errs   []error
+	)
+	for _, ns := range namespaces {
+		err := listHTTPRoutes(ns, selector, func(obj any) {
+			var route httpRoute
+			if err := fromUnstructured(obj.(runtime.Object), &route); err != nil {
+				errs = append(errs, err)
+				return
+			}
+
+			routes = append(routes, route)
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to list HTTPRoute objects in namespace %q: %w", ns, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, fmt.Errorf("failed to decode HTTPRoute objects: %w", errs[0])
+	}
+
+	slices.SortFunc(routes, func(a, b httpRoute) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	var (
+		targets  = make([]HTTPRouteTarget, 0, len(routes))
+		notFound = sets.New[string]()
+	)
+	for _, route := range routes {
+		urls, missing, err := httpRouteURLs(route, getGateway)
+		if err != nil {
+			return nil, nil, fmt.Errorf("HTTPRoute %s/%s: %w", route.Namespace, route.Name, err)
+		}
+		notFound.Insert(missing...)
+
+		if len(urls) == 0 {
+			continue
+		}
+
+		targets = append(targets, HTTPRouteTarget{
+			Namespace: route.Namespace,
+			Name:      route.Name,
+			URLs:      urls,
+		})
+	}
+
+	if notFound.Len() == 0 {
+		return targets, nil, nil
+	}
+
+	return targets, sets.List(notFound), nil
+}
+
+// httpRouteURLs returns the sorted list of URLs exposed by the HTTPRoute
+// through the HTTP and HTTPS listeners of its parent Gateways.
+//
+// Wildcard hostnames and regular expression path matches are ignored since
+// they can't be converted to a URL. The keys of the parent Gateways which
+// can't be found are returned as the second value.
+func httpRouteURLs(route httpRoute, getGateway GetByKeyFn) ([]string, []string, error) {
+	var (
+		paths    = httpRoutePaths(route)
+		urls     = sets.New[string]()
+		notFound []string
+	)
+
+	for _, ref := range route.Spec.ParentRefs {
+		if ptr.Deref(ref.Group, gatewayAPIGroup) != gatewayAPIGroup || ptr.Deref(ref.Kind, "Gateway") != "Gateway" {
+			continue
+		}
+
+		key := ptr.Deref(ref.Namespace, route.Namespace) + "/" + ref.Name
+		obj, err := getGateway(key)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				notFound = append(notFound, key)
+				continue
+			}
+
+			return nil, nil, fmt.Errorf("failed to get Gateway %q: %w", ref.Name, err)
+		}
+
+		var gw gateway
+		if err := fromUnstructured(obj, &gw); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode Gateway %q: %w", ref.Name, err)
+		}
+
+		for _, l := range gw.Spec.Listeners {
+			if ref.SectionName != nil && *ref.SectionName != l.Name {
+				continue
+			}
+
+			if ref.Port != nil && *ref.Port != l.Port {
+				continue
+			}
+
+			var scheme string
+			switch l.Protocol {
+			case "HTTP":
+				scheme = "http"
+			case "HTTPS":
+				scheme = "https"
+			default:
+				continue
+			}
+
+			for _, host := range listenerHostnames(route.Spec.Hostnames, ptr.Deref(l.Hostname, "")) {
+				if (scheme == "http" && l.Port != 80) || (scheme == "https" && l.Port != 443) {
+					host = net.JoinHostPort(host, strconv.Itoa(int(l.Port)))
+				}
+
+				for _, path := range paths {
+					urls.Insert(scheme + "://" + host + path)
+				}
+			}
+		}
+	}
+
+	return sets.List(urls), notFound, nil
+}
+
+// httpRoutePaths returns the paths matched by the HTTPRoute rules, defaulting
+// to "/" when a rule has no path match.
+func httpRoutePaths(route httpRoute) []string {
+	paths := sets.New[string]()
+
+	for _, rule := range route.Spec.Rules {
+		if len(rule.Matches) == 0 {
+			paths.Insert("/")
+			continue
+		}
+
+		for _, match := range rule.Matches {
+			if match.Path == nil {
+				paths.Insert("/")
+				continue
+			}
+
+			switch ptr.Deref(match.Path.Type, "PathPrefix") {
+			case "PathPrefix", "Exact":
+				paths.Insert(ptr.Deref(match.Path.Value, "/"))
+			}
+		}
+	}
+
+	if len(route.Spec.Rules) == 0 {
+		paths.Insert("/")
+	}
+
+	return sets.List(paths)
+}
+
+// listenerHostnames returns the hostnames of the route which are accepted by
+// the listener. If the route has no hostname, the listener's hostname is
+// used.
+func listenerHostnames(routeHostnames []string, listenerHostname string) []string {
+	if len(routeHostnames) == 0 {
+		if listenerHostname == "" || strings.HasPrefix(listenerHostname, "*") {
+			return nil
+		}
+
+		return []string{listenerHostname}
+	}
+
+	var hostnames []string
+	for _, host := range routeHostnames {
+		if strings.HasPrefix(host, "*") {
+			continue
+		}
+
+		switch {
+		case listenerHostname == "":
+		case strings.HasPrefix(listenerHostname, "*."):
+			if !strings.HasSuffix(host, listenerHostname[1:]) {
+				continue
+			}
+		case listenerHostname != host:
+			continue
+		}
+
+		hostnames = append(hostnames, host)
+	}
+
+	return hostnames
+}
@@ -29,7 +29,6 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/ptr"
 
@@ -76,17 +75,47 @@ type StatusReporter struct {
 	ssg          StatefulSetGetter
 	dc           DeletionChecker
 	repairPolicy operator.RepairPolicy
+	healthClient HealthClient
+
+	remoteWriteFailures remoteWriteFailures
+}
+
+// StatusReporterOption defines an option of the StatusReporter.
+type StatusReporterOption func(*StatusReporter)
+
+// WithHealthClient tells the StatusReporter to query the ready pods with the
+// given client and to report their runtime health.
+func WithHealthClient(hc HealthClient) StatusReporterOption {
+	return func(sr *StatusReporter) {
+		sr.healthClient = hc
+	}
 }
 
 // NewStatusReporter returns a new StatusReporter.
-func NewStatusReporter(client kubernetes.Interface, rcg ReconciledConditionGetter, ssg StatefulSetGetter, dc DeletionChecker, repairPolicy operator.RepairPolicy) *StatusReporter {
-	return &StatusReporter{
+func NewStatusReporter(client kubernetes.Interface, rcg ReconciledConditionGetter, ssg StatefulSetGetter, dc DeletionChecker, repairPolicy operator.RepairPolicy, opts ...StatusReporterOption) *StatusReporter {
+	sr := &StatusReporter{
 		client:       client,
 		rcg:          rcg,
 		ssg:          ssg,
 		dc:           dc,
 		repairPolicy: repairPolicy,
 	}
+
+	for _, opt := range opts {
+		opt(sr)
+	}
+
+	return sr
+}
+
+// ForgetObject removes the state kept for the workload resource identified by
+// key. It should be called when the resource is deleted.
+func (sr *StatusReporter) ForgetObject(key string) {
+	if sr == nil {
+		return
+	}
+
+	sr.remoteWriteFailures.set(key, nil)
 }
 
 func KeyToStatefulSetKey(p monitoringv1.PrometheusInterface, key string, shard int) string {
@@ -225,39 +254,6 @@ func (cg *ConfigGenerator) checkAzureADManagedIdentity(mid *monitoringv1.Managed
 	return nil
 }
 
-func combinedStatus(statuses []monitoringv1.ConditionStatus) monitoringv1.ConditionStatus {
-	var status monitoringv1.ConditionStatus
-	for _, s := range statuses {
-		switch s {
-		case monitoringv1.ConditionFalse:
-			return monitoringv1.ConditionFalse
-		case monitoringv1.ConditionDegraded:
-			status = monitoringv1.ConditionDegraded
-		case monitoringv1.ConditionUnknown:
-			if status == "" {
-				status = monitoringv1.ConditionUnknown
-			}
-		}
-	}
-
-	if status == "" {
-		return monitoringv1.ConditionTrue
-	}
-
-	return status
-}
-
-func combinedReason(reasons []string) string {
-	uniqReasons := sets.New[string]()
-	for _, r := range reasons {
-		if r != "" {
-			uniqReasons.Insert(r)
-		}
-	}
-
-	return strings.Join(sets.List(uniqReasons), "And")
-}
-
 // Process will determine the Status of a Prometheus or PrometheusAgent
 // resource depending on the current state of the underlying workload resources
 // (including pods).
@@ -272,6 +268,11 @@ func (sr *StatusReporter) Process(ctx context.Context, logger *slog.Logger, p mo
 		reasons  []string
 		messages []string
 		replicas = 1
+
+		health       healthReport
+		reload       healthReport
+		healthChecks []*shardHealthCheck
+		checkHealth  = sr.healthClient != nil && !commonFields.ListenLocal
 	)
 
 	if commonFields.Replicas != nil {
@@ -314,16 +315,21 @@ func (sr *StatusReporter) Process(ctx context.Context, logger *slog.Logger, p mo
 		pStatus.AvailableReplicas += int32(len(stsReporter.ReadyPods()))
 		pStatus.UnavailableReplicas += int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods()))
 
-		pStatus.ShardStatuses = append(
-			pStatus.ShardStatuses,
-			monitoringv1.ShardStatus{
-				ShardID:             strconv.Itoa(shard),
-				Replicas:            int32(len(stsReporter.Pods)),
-				UpdatedReplicas:     int32(len(stsReporter.UpdatedPods())),
-				AvailableReplicas:   int32(len(stsReporter.ReadyPods())),
-				UnavailableReplicas: int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods())),
-			},
-		)
+		shardStatus := monitoringv1.ShardStatus{
+			ShardID:             strconv.Itoa(shard),
+			Replicas:            int32(len(stsReporter.Pods)),
+			UpdatedReplicas:     int32(len(stsReporter.UpdatedPods())),
+			AvailableReplicas:   int32(len(stsReporter.ReadyPods())),
+			UnavailableReplicas: int32(len(stsReporter.Pods) - len(stsReporter.ReadyPods())),
+		}
+		if checkHealth {
+			healthChecks = append(healthChecks, &shardHealthCheck{
+				index: len(pStatus.ShardStatuses),
+				shard: shard,
+				pods:  stsReporter.ReadyPods(),
+			})
+		}
+		pStatus.ShardStatuses = append(pStatus.ShardStatuses, shardStatus)
 
 		status, reason := stsReporter.StatusAndReasonForAvailableCondition(replicas)
 		statuses, reasons = append(statuses, status), append(reasons, reason)
@@ -342,12 +348,20 @@ func (sr *StatusReporter) Process(ctx context.Context, logger *slog.Logger, p mo
 		}
 	}
 
-	pStatus.Conditions = operator.UpdateConditions(
-		p.GetStatus().Conditions,
-		monitoringv1.Condition{
+	if len(healthChecks) > 0 {
+		sr.checkHealth(ctx, p, key, healthChecks)
+		for _, hc := range healthChecks {
+			pStatus.ShardStatuses[hc.index].Health = hc.health
+			health.merge(hc.report)
+			reload.merge(hc.reload)
+		}
+	}
+
+	conditions := []monitoringv1.Condition{
+		{
 			Type:    monitoringv1.Available,
-			Status:  combinedStatus(statuses),
-			Reason:  combinedReason(reasons),
+			Status:  operator.CombinedConditionStatus(statuses),
+			Reason:  operator.CombinedConditionReason(reasons),
 			Message: strings.Join(messages, "\n"),
 			LastTransitionTime: metav1.Time{
 				Time: time.Now().UTC(),
@@ -355,7 +369,40 @@ func (sr *StatusReporter) Process(ctx context.Context, logger *slog.Logger, p mo
 			ObservedGeneration: p.GetObjectMeta().GetGeneration(),
 		},
 		sr.rcg.GetCondition(key, p.GetObjectMeta().GetGeneration()),
-	)
+	}
+
+	if sr.healthClient != nil {
+		for _, r := range []*healthReport{&health, &reload} {
+			if commonFields.ListenLocal {
+				r.add(monitoringv1.ConditionUnknown, "ListenLocal", "the pods can't be queried because they listen on the loopback interface")
+			}
+
+			if len(r.statuses) == 0 {
+				r.add(monitoringv1.ConditionUnknown, "NoPodReady", "")
+			}
+		}
+
+		for _, c := range []struct {
+			typ    monitoringv1.ConditionType
+			report healthReport
+		}{
+			{typ: monitoringv1.Healthy, report: health},
+			{typ: monitoringv1.ConfigReloaded, report: reload},
+		} {
+			conditions = append(conditions, monitoringv1.Condition{
+				Type:    c.typ,
+				Status:  operator.CombinedConditionStatus(c.report.statuses),
+				Reason:  operator.CombinedConditionReason(c.report.reasons),
+				Message: strings.Join(c.report.messages, "\n"),
+				LastTransitionTime: metav1.Time{
+					Time: time.Now().UTC(),
+				},
+				ObservedGeneration: p.GetObjectMeta().GetGeneration(),
+			})
+		}
+	}
+
+	pStatus.Conditions = operator.UpdateConditions(p.GetStatus().Conditions, conditions...)
 
 	return &pStatus, nil
 }
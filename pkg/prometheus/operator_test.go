@@ -17,7 +17,10 @@ package prometheus
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -490,132 +493,6 @@ func fakeReadyPod(sts string, ordinal int, ready bool) corev1.Pod {
 	}
 }
 
-func TestCombinedStatus(t *testing.T) {
-	for _, tc := range []struct {
-		name     string
-		statuses []monitoringv1.ConditionStatus
-		exp      monitoringv1.ConditionStatus
-	}{
-		{
-			name:     "nil slice",
-			statuses: nil,
-			exp:      monitoringv1.ConditionTrue,
-		},
-		{
-			name:     "empty slice",
-			statuses: []monitoringv1.ConditionStatus{},
-			exp:      monitoringv1.ConditionTrue,
-		},
-		{
-			name:     "all True",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionTrue},
-			exp:      monitoringv1.ConditionTrue,
-		},
-		{
-			name:     "single False",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionFalse},
-			exp:      monitoringv1.ConditionFalse,
-		},
-		{
-			name:     "False short-circuits remaining statuses",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionFalse, monitoringv1.ConditionDegraded},
-			exp:      monitoringv1.ConditionFalse,
-		},
-		{
-			name:     "single Degraded",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionDegraded},
-			exp:      monitoringv1.ConditionDegraded,
-		},
-		{
-			name:     "Degraded with True",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionDegraded},
-			exp:      monitoringv1.ConditionDegraded,
-		},
-		{
-			name:     "single Unknown",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionUnknown},
-			exp:      monitoringv1.ConditionUnknown,
-		},
-		{
-			name:     "Unknown with True",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionTrue, monitoringv1.ConditionUnknown},
-			exp:      monitoringv1.ConditionUnknown,
-		},
-		{
-			name:     "Degraded overrides Unknown",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionUnknown, monitoringv1.ConditionDegraded},
-			exp:      monitoringv1.ConditionDegraded,
-		},
-		{
-			name:     "Degraded then Unknown stays Degraded",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionDegraded, monitoringv1.ConditionUnknown},
-			exp:      monitoringv1.ConditionDegraded,
-		},
-		{
-			name:     "False takes priority over Degraded",
-			statuses: []monitoringv1.ConditionStatus{monitoringv1.ConditionDegraded, monitoringv1.ConditionFalse},
-			exp:      monitoringv1.ConditionFalse,
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			require.Equal(t, tc.exp, combinedStatus(tc.statuses))
-		})
-	}
-}
-
-func TestCombinedReason(t *testing.T) {
-	for _, tc := range []struct {
-		name    string
-		reasons []string
-		exp     string
-	}{
-		{
-			name:    "nil slice",
-			reasons: nil,
-			exp:     "",
-		},
-		{
-			name:    "empty slice",
-			reasons: []string{},
-			exp:     "",
-		},
-		{
-			name:    "all empty strings",
-			reasons: []string{"", ""},
-			exp:     "",
-		},
-		{
-			name:    "single reason",
-			reasons: []string{"ReasonA"},
-			exp:     "ReasonA",
-		},
-		{
-			name:    "duplicate reasons",
-			reasons: []string{"ReasonA", "ReasonA"},
-			exp:     "ReasonA",
-		},
-		{
-			name:    "two distinct reasons joined alphabetically",
-			reasons: []string{"ReasonB", "ReasonA"},
-			exp:     "ReasonAAndReasonB",
-		},
-		{
-			name:    "empty strings are ignored",
-			reasons: []string{"", "ReasonA", ""},
-			exp:     "ReasonA",
-		},
-		{
-			name:    "distinct reasons with duplicates and empty strings",
-			reasons: []string{"ReasonB", "", "ReasonA", "ReasonB"},
-			exp:     "ReasonAAndReasonB",
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			require.Equal(t, tc.exp, combinedReason(tc.reasons))
-		})
-	}
-}
-
 func TestStatefulSetReporterProcess(t *testing.T) {
 	for _, tc := range []struct {
 		name  string
@@ -1029,3 +906,353 @@ func TestStatefulSetReporterProcess(t *testing.T) {
 		})
 	}
 }
+
+// stubHealthClient sends the requests to a local HTTP server, the pod name
+// being the first element of the path.
+type stubHealthClient struct {
+	url string
+}
+
+func (c *stubHealthClient) Get(ctx context.Context, _ monitoringv1.PrometheusInterface, pod *operator.Pod, urlPath string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/"+pod.Name+urlPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+type stubPrometheus struct {
+	reloadSuccess   bool
+	lastConfigTime  time.Time
+	corruptionCount int
+	metrics         string
+}
+
+func newStubPrometheusServer(t *testing.T, pods map[string]stubPrometheus) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for name, sp := range pods {
+		mux.HandleFunc("/"+name+"/api/v1/status/runtimeinfo", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprintf(w, `{"status":"success","data":{"reloadConfigSuccess":%t,"lastConfigTime":%q,"corruptionCount":%d}}`,
+				sp.reloadSuccess, sp.lastConfigTime.Format(time.RFC3339), sp.corruptionCount)
+		})
+		mux.HandleFunc("/"+name+"/api/v1/targets", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("state") != "active" {
+				http.Error(w, "expected the active targets only", http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"status":"success","data":{"activeTargets":[{"health":"up"},{"health":"down"},{"health":"unknown"}]}}`)
+		})
+		mux.HandleFunc("/"+name+"/metrics", func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, sp.metrics)
+		})
+	}
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestStatusReporterProcessWithHealth(t *testing.T) {
+	var (
+		reloadTime = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+		metrics    = `# TYPE prometheus_tsdb_head_series gauge
+prometheus_tsdb_head_series 1000
+# TYPE prometheus_remote_storage_samples_failed_total counter
+prometheus_remote_storage_samples_failed_total{remote_name="a",url="http://a"} 3
+prometheus_remote_storage_samples_failed_total{remote_name="b",url="http://b"} 2
+# TYPE prometheus_sd_discovered_targets gauge
+prometheus_sd_discovered_targets{config="pool1",name="scrape"} 5
+prometheus_sd_discovered_targets{config="pool2",name="scrape"} 3
+prometheus_sd_discovered_targets{config="config-0",name="notify"} 2
+`
+	)
+
+	for _, tc := range []struct {
+		name        string
+		listenLocal bool
+		pods        map[string]stubPrometheus
+		expHealth   *monitoringv1.ShardHealth
+		expStatus   monitoringv1.ConditionStatus
+		expReason   string
+
+		expReloadStatus monitoringv1.ConditionStatus
+		expReloadReason string
+	}{
+		{
+			name: "healthy pods",
+			pods: map[string]stubPrometheus{
+				"prometheus-test-0": {reloadSuccess: true, lastConfigTime: reloadTime, metrics: metrics},
+				"prometheus-test-1": {reloadSuccess: true, lastConfigTime: reloadTime.Add(time.Minute), metrics: metrics},
+			},
+			expHealth: &monitoringv1.ShardHealth{
+				QueriedReplicas:        2,
+				ConfigReloadSuccessful: true,
+				LastConfigReloadTime:   &metav1.Time{Time: reloadTime},
+				ActiveTargets:          3,
+				DroppedTargets:         5,
+				UnhealthyTargets:       1,
+				HeadSeries:             new(int64(1000)),
+			},
+			expStatus:       monitoringv1.ConditionTrue,
+			expReloadStatus: monitoringv1.ConditionTrue,
+		},
+		{
+			name: "failed reload and WAL corruption",
+			pods: map[string]stubPrometheus{
+				"prometheus-test-0": {reloadSuccess: false, lastConfigTime: reloadTime},
+				"prometheus-test-1": {reloadSuccess: true, lastConfigTime: reloadTime, corruptionCount: 2},
+			},
+			expHealth: &monitoringv1.ShardHealth{
+				QueriedReplicas:      2,
+				LastConfigReloadTime: &metav1.Time{Time: reloadTime},
+				ActiveTargets:        3,
+				UnhealthyTargets:     1,
+				WALCorruptions:       2,
+			},
+			expStatus:       monitoringv1.ConditionFalse,
+			expReason:       "ConfigReloadFailedAndWALCorrupted",
+			expReloadStatus: monitoringv1.ConditionFalse,
+			expReloadReason: "ConfigReloadFailed",
+		},
+		{
+			name: "one pod not answering",
+			pods: map[string]stubPrometheus{
+				"prometheus-test-0": {reloadSuccess: true, lastConfigTime: reloadTime},
+			},
+			expHealth: &monitoringv1.ShardHealth{
+				QueriedReplicas:        1,
+				ConfigReloadSuccessful: true,
+				LastConfigReloadTime:   &metav1.Time{Time: reloadTime},
+				ActiveTargets:          3,
+				UnhealthyTargets:       1,
+			},
+			expStatus:       monitoringv1.ConditionUnknown,
+			expReason:       "HealthCheckFailed",
+			expReloadStatus: monitoringv1.ConditionUnknown,
+			expReloadReason: "HealthCheckFailed",
+		},
+		{
+			name: "no pod answering",
+			pods: map[string]stubPrometheus{},
+
+			expStatus:       monitoringv1.ConditionUnknown,
+			expReason:       "HealthCheckFailed",
+			expReloadStatus: monitoringv1.ConditionUnknown,
+			expReloadReason: "HealthCheckFailed",
+		},
+		{
+			name:        "listen local",
+			listenLocal: true,
+			pods: map[string]stubPrometheus{
+				"prometheus-test-0": {reloadSuccess: true, lastConfigTime: reloadTime},
+				"prometheus-test-1": {reloadSuccess: true, lastConfigTime: reloadTime},
+			},
+			expStatus:       monitoringv1.ConditionUnknown,
+			expReason:       "ListenLocal",
+			expReloadStatus: monitoringv1.ConditionUnknown,
+			expReloadReason: "ListenLocal",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test",
+					Namespace:  "ns",
+					Generation: 42,
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Replicas:    new(int32(2)),
+						ListenLocal: tc.listenLocal,
+					},
+				},
+			}
+
+			c := fake.NewClientset()
+			for _, pod := range []corev1.Pod{
+				fakeReadyPod("prometheus-test", 0, true),
+				fakeReadyPod("prometheus-test", 1, true),
+			} {
+				c.Tracker().Add(&pod)
+			}
+
+			srv := newStubPrometheusServer(t, tc.pods)
+			sr := NewStatusReporter(
+				c,
+				&fakeReconciledConditionGetter{},
+				fakeStatefulSetGetter([]appsv1.StatefulSet{fakeStatefulSet("prometheus-test")}),
+				&fakeDeletionChecker{},
+				operator.NoneRepairPolicy,
+				WithHealthClient(&stubHealthClient{url: srv.URL}),
+			)
+
+			status, err := sr.Process(context.Background(), slog.New(slog.DiscardHandler), p, "ns/test")
+			require.NoError(t, err)
+
+			require.Len(t, status.ShardStatuses, 1)
+			require.Equal(t, tc.expHealth, status.ShardStatuses[0].Health)
+
+			cond := operator.FindStatusCondition(status.Conditions, monitoringv1.Healthy)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expStatus, cond.Status)
+			require.Equal(t, tc.expReason, cond.Reason)
+			require.Equal(t, int64(42), cond.ObservedGeneration)
+
+			cond = operator.FindStatusCondition(status.Conditions, monitoringv1.ConfigReloaded)
+			require.NotNil(t, cond)
+			require.Equal(t, tc.expReloadStatus, cond.Status)
+			require.Equal(t, tc.expReloadReason, cond.Reason)
+			require.Equal(t, int64(42), cond.ObservedGeneration)
+		})
+	}
+}
+
+func TestStatusReporterProcessRemoteWriteFailures(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Spec: monitoringv1.PrometheusSpec{
+			CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+				Replicas: new(int32(2)),
+			},
+		},
+	}
+
+	c := fake.NewClientset()
+	for _, pod := range []corev1.Pod{
+		fakeReadyPod("prometheus-test", 0, true),
+		fakeReadyPod("prometheus-test", 1, true),
+	} {
+		c.Tracker().Add(&pod)
+	}
+
+	hc := &stubHealthClient{}
+	sr := NewStatusReporter(
+		c,
+		&fakeReconciledConditionGetter{},
+		fakeStatefulSetGetter([]appsv1.StatefulSet{fakeStatefulSet("prometheus-test")}),
+		&fakeDeletionChecker{},
+		operator.NoneRepairPolicy,
+		WithHealthClient(hc),
+	)
+
+	failedSamples := func(n int) stubPrometheus {
+		return stubPrometheus{
+			reloadSuccess: true,
+			metrics: fmt.Sprintf(`# TYPE prometheus_remote_storage_samples_failed_total counter
+prometheus_remote_storage_samples_failed_total{remote_name="a",url="http://a"} %d
+prometheus_remote_storage_samples_failed_total{remote_name="b",url="http://b"} 1
+`, n),
+		}
+	}
+
+	// The health checks run in sequence with the same status reporter.
+	for _, tc := range []struct {
+		name      string
+		pod0      stubPrometheus
+		pod1      stubPrometheus
+		expFailed *int64
+		expStatus monitoringv1.ConditionStatus
+		expReason string
+	}{
+		{
+			name:      "first check",
+			pod0:      failedSamples(4),
+			pod1:      failedSamples(4),
+			expStatus: monitoringv1.ConditionTrue,
+		},
+		{
+			name:      "failures on one pod",
+			pod0:      failedSamples(4),
+			pod1:      failedSamples(8),
+			expFailed: new(int64(4)),
+			expStatus: monitoringv1.ConditionDegraded,
+			expReason: "RemoteWriteFailed",
+		},
+		{
+			name:      "counter reset",
+			pod0:      failedSamples(0),
+			pod1:      failedSamples(8),
+			expFailed: new(int64(1)),
+			expStatus: monitoringv1.ConditionDegraded,
+			expReason: "RemoteWriteFailed",
+		},
+		{
+			name:      "no new failure",
+			pod0:      failedSamples(0),
+			pod1:      failedSamples(8),
+			expFailed: new(int64(0)),
+			expStatus: monitoringv1.ConditionTrue,
+		},
+	} {
+		srv := newStubPrometheusServer(t, map[string]stubPrometheus{
+			"prometheus-test-0": tc.pod0,
+			"prometheus-test-1": tc.pod1,
+		})
+		hc.url = srv.URL
+
+		status, err := sr.Process(context.Background(), slog.New(slog.DiscardHandler), p, "ns/test")
+		require.NoError(t, err, tc.name)
+
+		require.Len(t, status.ShardStatuses, 1, tc.name)
+		require.NotNil(t, status.ShardStatuses[0].Health, tc.name)
+		require.Equal(t, tc.expFailed, status.ShardStatuses[0].Health.RemoteWriteFailedSamples, tc.name)
+
+		cond := operator.FindStatusCondition(status.Conditions, monitoringv1.Healthy)
+		require.NotNil(t, cond, tc.name)
+		require.Equal(t, tc.expStatus, cond.Status, tc.name)
+		require.Equal(t, tc.expReason, cond.Reason, tc.name)
+	}
+}
+
+func TestStatusReporterProcessWithoutHealth(t *testing.T) {
+	p := &monitoringv1.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "ns",
+		},
+		Status: monitoringv1.PrometheusStatus{
+			Conditions: []monitoringv1.Condition{
+				{
+					Type:   monitoringv1.Healthy,
+					Status: monitoringv1.ConditionTrue,
+				},
+			},
+		},
+	}
+
+	c := fake.NewClientset()
+	pod := fakeReadyPod("prometheus-test", 0, true)
+	c.Tracker().Add(&pod)
+
+	sr := NewStatusReporter(
+		c,
+		&fakeReconciledConditionGetter{},
+		fakeStatefulSetGetter([]appsv1.StatefulSet{fakeStatefulSet("prometheus-test")}),
+		&fakeDeletionChecker{},
+		operator.NoneRepairPolicy,
+	)
+
+	status, err := sr.Process(context.Background(), slog.New(slog.DiscardHandler), p, "ns/test")
+	require.NoError(t, err)
+
+	require.Nil(t, status.ShardStatuses[0].Health)
+	require.Nil(t, operator.FindStatusCondition(status.Conditions, monitoringv1.Healthy))
+	require.Nil(t, operator.FindStatusCondition(status.Conditions, monitoringv1.ConfigReloaded))
+}
@@ -92,6 +92,7 @@ type ConfigGenerator struct {
 	prometheusRetentionPolicies bool
 	podTopologyLabelsSupported  bool
 	inlineTLSConfig             bool
+	probeHTTPRouteTargets       map[string][]HTTPRouteTarget
 
 	bypassVersionCheck bool
 }
@@ -250,6 +251,10 @@ func getScrapeClassConfig(p monitoringv1.PrometheusInterface) (map[string]monito
 			return nil, "", fmt.Errorf("invalid authorization for scrapeClass %s: %w", scrapeClass.Name, err)
 		}
 
+		if err := validateScrapeClassDefaults(scrapeClass); err != nil {
+			return nil, "", fmt.Errorf("invalid scrapeClass %s: %w", scrapeClass.Name, err)
+		}
+
 		if ptr.Deref(scrapeClass.Default, false) {
 			if defaultScrapeClass != "" {
 				return nil, "", fmt.Errorf("multiple default scrape classes defined")
@@ -264,6 +269,41 @@ func getScrapeClassConfig(p monitoringv1.PrometheusInterface) (map[string]monito
 	return scrapeClasses, defaultScrapeClass, nil
 }
 
+// validateScrapeClassDefaults validates the scrape class fields which are
+// used as default values for the scrape objects.
+func validateScrapeClassDefaults(scrapeClass monitoringv1.ScrapeClass) error {
+	var authMethods int
+	for _, defined := range []bool{
+		scrapeClass.Authorization != nil,
+		scrapeClass.BasicAuth != nil,
+		scrapeClass.OAuth2 != nil,
+	} {
+		if defined {
+			authMethods++
+		}
+	}
+
+	if authMethods > 1 {
+		return fmt.Errorf("at most one of authorization, basicAuth and oauth2 can be configured")
+	}
+
+	if err := scrapeClass.OAuth2.Validate(); err != nil {
+		return fmt.Errorf("oauth2: %w", err)
+	}
+
+	if err := scrapeClass.ProxyConfig.Validate(); err != nil {
+		return fmt.Errorf("proxy: %w", err)
+	}
+
+	if scrapeClass.ScrapeInterval != nil && scrapeClass.ScrapeTimeout != nil {
+		if err := CompareScrapeTimeoutToScrapeInterval(*scrapeClass.ScrapeTimeout, *scrapeClass.ScrapeInterval); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Version returns the currently configured Prometheus version.
 func (cg *ConfigGenerator) Version() semver.Version {
 	return cg.version
@@ -286,10 +326,21 @@ func (cg *ConfigGenerator) WithKeyVals(keyvals ...any) *ConfigGenerator {
 		prometheusRetentionPolicies: cg.prometheusRetentionPolicies,
 		podTopologyLabelsSupported:  cg.podTopologyLabelsSupported,
 		inlineTLSConfig:             cg.inlineTLSConfig,
+		probeHTTPRouteTargets:       cg.probeHTTPRouteTargets,
 		bypassVersionCheck:          cg.bypassVersionCheck,
 	}
 }
 
+// WithProbeHTTPRouteTargets returns a new ConfigGenerator using the given
+// HTTPRoute targets (indexed by Probe key) for the Probes which discover
+// targets from HTTPRoute objects.
+func (cg *ConfigGenerator) WithProbeHTTPRouteTargets(targets map[string][]HTTPRouteTarget) *ConfigGenerator {
+	ncg := *cg
+	ncg.probeHTTPRouteTargets = targets
+
+	return &ncg
+}
+
 // WithMinimumVersion returns a new ConfigGenerator that does nothing (except
 // logging a warning message) if the Prometheus version is lesser than the
 // given version.
@@ -313,6 +364,7 @@ func (cg *ConfigGenerator) WithMinimumVersion(version string) *ConfigGenerator {
 			prometheusRetentionPolicies: cg.prometheusRetentionPolicies,
 			podTopologyLabelsSupported:  cg.podTopologyLabelsSupported,
 			inlineTLSConfig:             cg.inlineTLSConfig,
+			probeHTTPRouteTargets:       cg.probeHTTPRouteTargets,
 			bypassVersionCheck:          cg.bypassVersionCheck,
 		}
 	}
@@ -343,6 +395,7 @@ func (cg *ConfigGenerator) WithMaximumVersion(version string) *ConfigGenerator {
 			prometheusRetentionPolicies: cg.prometheusRetentionPolicies,
 			podTopologyLabelsSupported:  cg.podTopologyLabelsSupported,
 			inlineTLSConfig:             cg.inlineTLSConfig,
+			probeHTTPRouteTargets:       cg.probeHTTPRouteTargets,
 			bypassVersionCheck:          cg.bypassVersionCheck,
 		}
 	}
@@ -636,6 +689,122 @@ func mergeFallbackScrapeProtocolWithScrapeClass(fallbackScrapeProtocol *monitori
 	return fallbackScrapeProtocol
 }
 
+func mergeScrapeIntervalWithScrapeClass(scrapeInterval monitoringv1.Duration, scrapeClass monitoringv1.ScrapeClass) monitoringv1.Duration {
+	if scrapeInterval == "" && scrapeClass.ScrapeInterval != nil {
+		scrapeInterval = *scrapeClass.ScrapeInterval
+	}
+
+	return scrapeInterval
+}
+
+// mergeScrapeTimeoutWithScrapeClass returns the scrape timeout of the scrape
+// class if the scrape object doesn't define one. The scrape class timeout is
+// ignored when it is greater than the effective scrape interval (which is
+// the given interval or the global interval if empty).
+func (cg *ConfigGenerator) mergeScrapeTimeoutWithScrapeClass(scrapeInterval, scrapeTimeout monitoringv1.Duration, scrapeClass monitoringv1.ScrapeClass) monitoringv1.Duration {
+	if scrapeTimeout != "" || scrapeClass.ScrapeTimeout == nil {
+		return scrapeTimeout
+	}
+
+	if scrapeInterval == "" {
+		scrapeInterval = cg.prom.GetCommonPrometheusFields().ScrapeInterval
+	}
+
+	if scrapeInterval != "" {
+		if err := CompareScrapeTimeoutToScrapeInterval(*scrapeClass.ScrapeTimeout, scrapeInterval); err != nil {
+			cg.logger.Debug("ignoring the scrape class timeout", "scrapeClass", scrapeClass.Name, "err", err)
+			return ""
+		}
+	}
+
+	return *scrapeClass.ScrapeTimeout
+}
+
+func mergeLimitWithScrapeClass(limit *int64, scrapeClassLimit *int64) *int64 {
+	if limit == nil {
+		limit = scrapeClassLimit
+	}
+
+	return limit
+}
+
+func mergeBodySizeLimitWithScrapeClass(bodySizeLimit *monitoringv1.ByteSize, scrapeClass monitoringv1.ScrapeClass) *monitoringv1.ByteSize {
+	if bodySizeLimit == nil {
+		bodySizeLimit = scrapeClass.BodySizeLimit
+	}
+
+	return bodySizeLimit
+}
+
+func mergeScrapeProtocolsWithScrapeClass(scrapeProtocols []monitoringv1.ScrapeProtocol, scrapeClass monitoringv1.ScrapeClass) []monitoringv1.ScrapeProtocol {
+	if len(scrapeProtocols) == 0 {
+		scrapeProtocols = scrapeClass.ScrapeProtocols
+	}
+
+	return scrapeProtocols
+}
+
+func mergeHonorTimestampsWithScrapeClass(honorTimestamps *bool, scrapeClass monitoringv1.ScrapeClass) *bool {
+	if honorTimestamps == nil {
+		honorTimestamps = scrapeClass.HonorTimestamps
+	}
+
+	return honorTimestamps
+}
+
+func mergeNativeHistogramConfigWithScrapeClass(nhc monitoringv1.NativeHistogramConfig, scrapeClass monitoringv1.ScrapeClass) monitoringv1.NativeHistogramConfig {
+	nhc.ScrapeNativeHistograms = cmp.Or(nhc.ScrapeNativeHistograms, scrapeClass.ScrapeNativeHistograms)
+	nhc.ScrapeClassicHistograms = cmp.Or(nhc.ScrapeClassicHistograms, scrapeClass.ScrapeClassicHistograms)
+	nhc.NativeHistogramBucketLimit = cmp.Or(nhc.NativeHistogramBucketLimit, scrapeClass.NativeHistogramBucketLimit)
+	nhc.NativeHistogramMinBucketFactor = cmp.Or(nhc.NativeHistogramMinBucketFactor, scrapeClass.NativeHistogramMinBucketFactor)
+	nhc.ConvertClassicHistogramsToNHCB = cmp.Or(nhc.ConvertClassicHistogramsToNHCB, scrapeClass.ConvertClassicHistogramsToNHCB)
+
+	return nhc
+}
+
+// addProxyConfigWithScrapeClassToYaml adds the proxy configuration of the
+// scrape object or, if it is empty, the proxy configuration of the scrape
+// class. The scrape class secrets are resolved from the Prometheus
+// namespace.
+func (cg *ConfigGenerator) addProxyConfigWithScrapeClassToYaml(
+	cfg yaml.MapSlice,
+	store assets.StoreGetter,
+	proxyConfig monitoringv1.ProxyConfig,
+	scrapeClassStore assets.StoreGetter,
+	scrapeClass monitoringv1.ScrapeClass,
+) yaml.MapSlice {
+	if reflect.ValueOf(proxyConfig).IsZero() {
+		return cg.addProxyConfigtoYaml(cfg, scrapeClassStore, scrapeClass.ProxyConfig)
+	}
+
+	return cg.addProxyConfigtoYaml(cfg, store, proxyConfig)
+}
+
+// hasAuthentication returns true if the HTTP configuration defines an
+// authentication method.
+func hasAuthentication(httpConfig monitoringv1.HTTPConfigWithoutTLS) bool {
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	return httpConfig.Authorization != nil || httpConfig.BasicAuth != nil || httpConfig.OAuth2 != nil || httpConfig.BearerTokenSecret != nil
+}
+
+// addScrapeClassAuthToYaml adds the basic authentication or OAuth2
+// configuration of the scrape class when the scrape object doesn't configure
+// any authentication method. The scrape class secrets are resolved from the
+// Prometheus namespace.
+func (cg *ConfigGenerator) addScrapeClassAuthToYaml(
+	cfg yaml.MapSlice,
+	scrapeClassStore assets.StoreGetter,
+	scrapeClass monitoringv1.ScrapeClass,
+	hasAuth bool,
+) yaml.MapSlice {
+	if hasAuth {
+		return cfg
+	}
+
+	cfg = cg.addBasicAuthToYaml(cfg, scrapeClassStore, scrapeClass.BasicAuth)
+	return cg.addOAuth2ToYaml(cfg, scrapeClassStore, scrapeClass.OAuth2)
+}
+
 func (cg *ConfigGenerator) addBasicAuthToYaml(
 	cfg yaml.MapSlice,
 	store assets.StoreGetter,
@@ -1000,6 +1169,7 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	pMons map[string]*monitoringv1.PodMonitor,
 	probes map[string]*monitoringv1.Probe,
 	sCons map[string]*monitoringv1alpha1.ScrapeConfig,
+	remoteWrites map[string]*monitoringv1alpha1.RemoteWrite,
 	store *assets.StoreBuilder,
 	additionalScrapeConfigs []byte,
 	additionalAlertRelabelConfigs []byte,
@@ -1069,9 +1239,7 @@ func (cg *ConfigGenerator) GenerateServerConfiguration(
 	}
 
 	// Remote write config
-	if len(cpf.RemoteWrite) > 0 {
-		cfg = append(cfg, cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, s))
-	}
+	cfg = cg.appendRemoteWriteConfig(cfg, remoteWrites, store)
 
 	// Remote read config
 	if len(p.Spec.RemoteRead) > 0 {
@@ -1459,14 +1627,17 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 			Value: fmt.Sprintf("podMonitor/%s/%s/%d", m.Namespace, m.Name, i),
 		},
 	}
-	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels)
-	cfg = cg.AddHonorTimestamps(cfg, ep.HonorTimestamps)
+	// The endpoint field isn't a pointer so it can only opt in the scrape
+	// class honorLabels value.
+	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels || ptr.Deref(scrapeClass.HonorLabels, false))
+	cfg = cg.AddHonorTimestamps(cfg, mergeHonorTimestampsWithScrapeClass(ep.HonorTimestamps, scrapeClass))
 	cfg = cg.AddTrackTimestampsStaleness(cfg, ep.TrackTimestampsStaleness)
 
 	attachMetaConfig := mergeAttachMetadataWithScrapeClass(m.Spec.AttachMetadata, scrapeClass, "2.35.0")
 	attachMetaConfig = cg.mergeAttachMetadataForTopology(attachMetaConfig, "2.35.0")
 
 	s := store.ForNamespace(m.Namespace)
+	scs := store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace())
 
 	roleSelectors := []string{kubernetesSDRolePod}
 	cfg = append(cfg,
@@ -1479,11 +1650,12 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 			attachMetaConfig,
 			cg.withK8SRoleSelectorConfig(m.Spec.Selector, m.Spec.SelectorMechanism, roleSelectors)))
 
-	if ep.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	interval := mergeScrapeIntervalWithScrapeClass(ep.Interval, scrapeClass)
+	if interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if ep.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	if timeout := cg.mergeScrapeTimeoutWithScrapeClass(interval, ep.ScrapeTimeout, scrapeClass); timeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: timeout})
 	}
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
@@ -1511,8 +1683,9 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 
 	cfg = cg.addBasicAuthToYaml(cfg, s, ep.BasicAuth)
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
+	cfg = cg.addScrapeClassAuthToYaml(cfg, scs, scrapeClass, hasAuthentication(ep.HTTPConfigWithoutTLS))
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addProxyConfigWithScrapeClassToYaml(cfg, s, ep.ProxyConfig, scs, scrapeClass)
 
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(ep.Authorization, scrapeClass))
 
@@ -1667,17 +1840,17 @@ func (cg *ConfigGenerator) generatePodMonitorConfig(
 
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
-	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
+	cfg = cg.addScrapeProtocols(cfg, mergeScrapeProtocolsWithScrapeClass(m.Spec.ScrapeProtocols, scrapeClass))
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
-	if bodySizeLimit := getLowerByteSize(m.Spec.BodySizeLimit, &cpf); !isByteSizeEmpty(bodySizeLimit) {
+	if bodySizeLimit := getLowerByteSize(mergeBodySizeLimitWithScrapeClass(m.Spec.BodySizeLimit, scrapeClass), &cpf); !isByteSizeEmpty(bodySizeLimit) {
 		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", bodySizeLimit)
 	}
 
@@ -1711,16 +1884,21 @@ func (cg *ConfigGenerator) generateProbeConfig(
 		},
 	}
 
-	hTs := true
+	if scrapeClass.HonorLabels != nil {
+		cfg = cg.AddHonorLabels(cfg, *scrapeClass.HonorLabels)
+	}
+
+	hTs := ptr.Deref(scrapeClass.HonorTimestamps, true)
 	cfg = cg.AddHonorTimestamps(cfg, &hTs)
 
 	cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: m.Spec.ProberSpec.Path})
 
-	if m.Spec.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: m.Spec.Interval})
+	interval := mergeScrapeIntervalWithScrapeClass(m.Spec.Interval, scrapeClass)
+	if interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if m.Spec.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: m.Spec.ScrapeTimeout})
+	if timeout := cg.mergeScrapeTimeoutWithScrapeClass(interval, m.Spec.ScrapeTimeout, scrapeClass); timeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: timeout})
 	}
 	if m.Spec.ProberSpec.Scheme != nil {
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: m.Spec.ProberSpec.Scheme.String()})
@@ -1744,18 +1922,18 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	}
 
 	cpf := cg.prom.GetCommonPrometheusFields()
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
-	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
+	cfg = cg.addScrapeProtocols(cfg, mergeScrapeProtocolsWithScrapeClass(m.Spec.ScrapeProtocols, scrapeClass))
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
-	if cpf.EnforcedBodySizeLimit != "" {
-		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", cpf.EnforcedBodySizeLimit)
+	if bodySizeLimit := getLowerByteSize(scrapeClass.BodySizeLimit, &cpf); !isByteSizeEmpty(bodySizeLimit) {
+		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", bodySizeLimit)
 	}
 
 	relabelings := initRelabelings()
@@ -1771,14 +1949,15 @@ func (cg *ConfigGenerator) generateProbeConfig(
 	labeler := namespacelabeler.New(cpf.EnforcedNamespaceLabel, cpf.ExcludedFromEnforcement, false)
 
 	s := store.ForNamespace(m.Namespace)
+	scs := store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace())
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, m.Spec.ProberSpec.ProxyConfig)
+	cfg = cg.addProxyConfigWithScrapeClassToYaml(cfg, s, m.Spec.ProberSpec.ProxyConfig, scs, scrapeClass)
 
 	cfg = cg.addHTTPConfigToYAML(cfg, s, &m.Spec.HTTPConfig, scrapeClass)
 
-	// As stated in the CRD documentation, if both StaticConfig and Ingress are
-	// defined, the former takes precedence which is why the first case statement
-	// checks for m.Spec.Targets.StaticConfig.
+	// As stated in the CRD documentation, StaticConfig takes precedence over
+	// Ingress which takes precedence over HTTPRoute, which is why the case
+	// statements are checked in this order.
 	switch {
 	case m.Spec.Targets.StaticConfig != nil:
 		// Generate static_config section.
@@ -1916,6 +2095,47 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 		// Add configured relabelings.
 		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.Ingress.RelabelConfigs))...)
+
+	case m.Spec.Targets.HTTPRoute != nil:
+		// The HTTPRoute targets have been resolved by the operator in
+		// SelectProbes(): generate one static_config section per HTTPRoute.
+		staticConfigs := []yaml.MapSlice{}
+		for _, t := range cg.probeHTTPRouteTargets[fmt.Sprintf("%s/%s", m.Namespace, m.Name)] {
+			staticConfigs = append(staticConfigs, yaml.MapSlice{
+				{Key: "targets", Value: t.URLs},
+				{Key: "labels", Value: yaml.MapSlice{
+					{Key: "namespace", Value: t.Namespace},
+					{Key: "httproute", Value: t.Name},
+				}},
+			})
+		}
+
+		cfg = append(cfg, yaml.MapItem{
+			Key:   "static_configs",
+			Value: staticConfigs,
+		})
+
+		// Relabelings for prober.
+		relabelings = append(relabelings, []yaml.MapSlice{
+			{
+				{Key: "source_labels", Value: []string{"__address__"}},
+				{Key: "target_label", Value: "__param_target"},
+			},
+			{
+				{Key: "source_labels", Value: []string{"__param_target"}},
+				{Key: "target_label", Value: "instance"},
+			},
+			{
+				{Key: "target_label", Value: "__address__"},
+				{Key: "replacement", Value: m.Spec.ProberSpec.URL},
+			},
+		}...)
+
+		// Add scrape class relabelings if there is any.
+		relabelings = append(relabelings, generateRelabelConfig(scrapeClass.Relabelings)...)
+
+		// Add configured relabelings.
+		relabelings = append(relabelings, generateRelabelConfig(labeler.GetRelabelingConfigs(m.TypeMeta, m.ObjectMeta, m.Spec.Targets.HTTPRoute.RelabelConfigs))...)
 	}
 
 	relabelings = cg.appendShardingRelabelingForProbes(relabelings, shards)
@@ -1932,6 +2152,7 @@ func (cg *ConfigGenerator) generateProbeConfig(
 
 	cfg = cg.addBasicAuthToYaml(cfg, s, m.Spec.BasicAuth)
 	cfg = cg.addOAuth2ToYaml(cfg, s, m.Spec.OAuth2)
+	cfg = cg.addScrapeClassAuthToYaml(cfg, scs, scrapeClass, hasAuthentication(m.Spec.HTTPConfigWithoutTLS) || m.Spec.Authorization != nil)
 
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(m.Spec.Authorization, scrapeClass))
 
@@ -1962,8 +2183,10 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 			Value: fmt.Sprintf("serviceMonitor/%s/%s/%d", m.Namespace, m.Name, i),
 		},
 	}
-	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels)
-	cfg = cg.AddHonorTimestamps(cfg, ep.HonorTimestamps)
+	// The endpoint field isn't a pointer so it can only opt in the scrape
+	// class honorLabels value.
+	cfg = cg.AddHonorLabels(cfg, ep.HonorLabels || ptr.Deref(scrapeClass.HonorLabels, false))
+	cfg = cg.AddHonorTimestamps(cfg, mergeHonorTimestampsWithScrapeClass(ep.HonorTimestamps, scrapeClass))
 	cfg = cg.AddTrackTimestampsStaleness(cfg, ep.TrackTimestampsStaleness)
 
 	attachMetaConfig := mergeAttachMetadataWithScrapeClass(m.Spec.AttachMetadata, scrapeClass, "2.37.0")
@@ -1972,6 +2195,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	attachMetaConfig = cg.mergeAttachMetadataForTopology(attachMetaConfig, "2.37.0")
 
 	s := store.ForNamespace(m.Namespace)
+	scs := store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace())
 
 	role := cg.defaultEndpointRoleFlavor()
 	if m.Spec.ServiceDiscoveryRole != nil {
@@ -1989,11 +2213,12 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cg.withK8SRoleSelectorConfig(m.Spec.Selector, m.Spec.SelectorMechanism, roleSelectors)),
 	)
 
-	if ep.Interval != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: ep.Interval})
+	interval := mergeScrapeIntervalWithScrapeClass(ep.Interval, scrapeClass)
+	if interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
-	if ep.ScrapeTimeout != "" {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: ep.ScrapeTimeout})
+	if timeout := cg.mergeScrapeTimeoutWithScrapeClass(interval, ep.ScrapeTimeout, scrapeClass); timeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: timeout})
 	}
 	if ep.Path != "" {
 		cfg = append(cfg, yaml.MapItem{Key: "metrics_path", Value: ep.Path})
@@ -2011,7 +2236,7 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *ep.EnableHTTP2)
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, ep.ProxyConfig)
+	cfg = cg.addProxyConfigWithScrapeClassToYaml(cfg, s, ep.ProxyConfig, scs, scrapeClass)
 
 	cfg = cg.addOAuth2ToYaml(cfg, s, ep.OAuth2)
 
@@ -2036,6 +2261,9 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 
 	cfg = cg.addBasicAuthToYaml(cfg, store.ForNamespace(m.Namespace), ep.BasicAuth)
 
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	cfg = cg.addScrapeClassAuthToYaml(cfg, scs, scrapeClass, hasAuthentication(ep.HTTPConfigWithoutTLS) || ep.BearerTokenFile != "")
+
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(ep.Authorization, scrapeClass))
 
 	relabelings := initRelabelings()
@@ -2214,17 +2442,17 @@ func (cg *ConfigGenerator) generateServiceMonitorConfig(
 	relabelings = cg.appendShardingRelabelingWithAddress(relabelings, shards)
 	cfg = append(cfg, yaml.MapItem{Key: "relabel_configs", Value: relabelings})
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, m.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, m.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, m.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, m.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, m.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(m.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(m.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(m.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, m.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, m.Spec.NativeHistogramConfig)
-	cfg = cg.addScrapeProtocols(cfg, m.Spec.ScrapeProtocols)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(m.Spec.NativeHistogramConfig, scrapeClass))
+	cfg = cg.addScrapeProtocols(cfg, mergeScrapeProtocolsWithScrapeClass(m.Spec.ScrapeProtocols, scrapeClass))
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(m.Spec.FallbackScrapeProtocol, scrapeClass))
 
-	if bodySizeLimit := getLowerByteSize(m.Spec.BodySizeLimit, &cpf); !isByteSizeEmpty(bodySizeLimit) {
+	if bodySizeLimit := getLowerByteSize(mergeBodySizeLimitWithScrapeClass(m.Spec.BodySizeLimit, scrapeClass), &cpf); !isByteSizeEmpty(bodySizeLimit) {
 		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", bodySizeLimit)
 	}
 
@@ -2320,6 +2548,10 @@ func (cg *ConfigGenerator) appendShardingRelabelingWithLabel(relabelings []yaml.
 		)
 	}
 
+	if cg.isConsistentHashShardingActive() {
+		return cg.appendConsistentHashShardingRelabelings(relabelings, shards, shardLabel)
+	}
+
 	modulus := shards
 	shardEnvVar := operator.ShardEnvVar
 	if cg.isTopologyShardingActive() {
@@ -2878,233 +3110,277 @@ func (cg *ConfigGenerator) GenerateRemoteWriteConfig(rws []monitoringv1.RemoteWr
 	var cfgs []yaml.MapSlice
 
 	for i, spec := range rws {
-		cfg := yaml.MapSlice{
-			{Key: "url", Value: spec.URL},
-		}
+		cfgs = append(cfgs, cg.generateRemoteWriteEndpoint(spec, fmt.Sprintf("remoteWrite/%d", i), s))
+	}
 
-		if spec.RemoteTimeout != nil {
-			cfg = append(cfg, yaml.MapItem{Key: "remote_timeout", Value: *spec.RemoteTimeout})
-		}
+	return yaml.MapItem{
+		Key:   "remote_write",
+		Value: cfgs,
+	}
+}
 
-		if len(spec.Headers) > 0 {
-			cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "headers", stringMapToMapSlice(spec.Headers))
-		}
+// appendRemoteWriteConfig appends the remote write configuration built from
+// the `spec.remoteWrite` field followed by the endpoints defined by the
+// selected RemoteWrite resources.
+func (cg *ConfigGenerator) appendRemoteWriteConfig(
+	cfg yaml.MapSlice,
+	remoteWrites map[string]*monitoringv1alpha1.RemoteWrite,
+	store *assets.StoreBuilder,
+) yaml.MapSlice {
+	cpf := cg.prom.GetCommonPrometheusFields()
+	if len(cpf.RemoteWrite) == 0 && len(remoteWrites) == 0 {
+		return cfg
+	}
 
-		if ptr.Deref(spec.Name, "") != "" {
-			cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "name", *spec.Name)
-		}
+	item := cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace()))
+	cfgs := item.Value.([]yaml.MapSlice)
 
-		if spec.MessageVersion != nil {
-			cfg = cg.WithMinimumVersion("2.54.0").AppendMapItem(cfg, "protobuf_message", toProtobufMessageVersion(*spec.MessageVersion))
-		}
+	labeler := namespacelabeler.New(cpf.EnforcedNamespaceLabel, cpf.ExcludedFromEnforcement, false)
+	for _, identifier := range sortutil.SortedKeys(remoteWrites) {
+		rw := remoteWrites[identifier]
 
-		if spec.SendExemplars != nil {
-			cfg = cg.WithMinimumVersion("2.27.0").AppendMapItem(cfg, "send_exemplars", spec.SendExemplars)
-		}
+		spec := *rw.Spec.DeepCopy()
+		spec.WriteRelabelConfigs = labeler.GetRemoteWriteRelabelingConfigs(rw.TypeMeta, rw.ObjectMeta, spec.WriteRelabelConfigs)
+		// Prometheus rejects the configuration if the remote write names
+		// aren't unique. The name is derived from the namespace and name of
+		// the RemoteWrite object so that a namespace can't break the
+		// configuration by reusing the name of another endpoint.
+		spec.Name = ptr.To(identifier)
 
-		if spec.SendNativeHistograms != nil {
-			cfg = cg.WithMinimumVersion("2.40.0").AppendMapItem(cfg, "send_native_histograms", spec.SendNativeHistograms)
-		}
+		cfgs = append(cfgs, cg.WithKeyVals("remotewrite", identifier).generateRemoteWriteEndpoint(
+			spec,
+			fmt.Sprintf("remoteWrite/%s", identifier),
+			store.ForNamespace(rw.GetNamespace()),
+		))
+	}
 
-		var relabelings []yaml.MapSlice
-		for _, c := range spec.WriteRelabelConfigs {
-			var relabeling yaml.MapSlice
+	item.Value = cfgs
 
-			if len(c.SourceLabels) > 0 {
-				relabeling = append(relabeling, yaml.MapItem{Key: "source_labels", Value: c.SourceLabels})
-			}
+	return append(cfg, item)
+}
 
-			if c.Separator != nil {
-				relabeling = append(relabeling, yaml.MapItem{Key: "separator", Value: *c.Separator})
-			}
+func (cg *ConfigGenerator) generateRemoteWriteEndpoint(spec monitoringv1.RemoteWriteSpec, assetStoreKey string, s assets.StoreGetter) yaml.MapSlice {
+	cfg := yaml.MapSlice{
+		{Key: "url", Value: spec.URL},
+	}
 
-			if c.TargetLabel != "" {
-				relabeling = append(relabeling, yaml.MapItem{Key: "target_label", Value: c.TargetLabel})
-			}
+	if spec.RemoteTimeout != nil {
+		cfg = append(cfg, yaml.MapItem{Key: "remote_timeout", Value: *spec.RemoteTimeout})
+	}
 
-			if c.Regex != "" {
-				relabeling = append(relabeling, yaml.MapItem{Key: "regex", Value: c.Regex})
-			}
+	if len(spec.Headers) > 0 {
+		cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "headers", stringMapToMapSlice(spec.Headers))
+	}
 
-			if c.Modulus != 0 {
-				relabeling = append(relabeling, yaml.MapItem{Key: "modulus", Value: c.Modulus})
-			}
+	if ptr.Deref(spec.Name, "") != "" {
+		cfg = cg.WithMinimumVersion("2.15.0").AppendMapItem(cfg, "name", *spec.Name)
+	}
 
-			if c.Replacement != nil {
-				relabeling = append(relabeling, yaml.MapItem{Key: "replacement", Value: *c.Replacement})
-			}
+	if spec.MessageVersion != nil {
+		cfg = cg.WithMinimumVersion("2.54.0").AppendMapItem(cfg, "protobuf_message", toProtobufMessageVersion(*spec.MessageVersion))
+	}
 
-			if c.Action != "" {
-				relabeling = append(relabeling, yaml.MapItem{Key: "action", Value: strings.ToLower(c.Action)})
-			}
+	if spec.SendExemplars != nil {
+		cfg = cg.WithMinimumVersion("2.27.0").AppendMapItem(cfg, "send_exemplars", spec.SendExemplars)
+	}
+
+	if spec.SendNativeHistograms != nil {
+		cfg = cg.WithMinimumVersion("2.40.0").AppendMapItem(cfg, "send_native_histograms", spec.SendNativeHistograms)
+	}
+
+	var relabelings []yaml.MapSlice
+	for _, c := range spec.WriteRelabelConfigs {
+		var relabeling yaml.MapSlice
 
-			relabelings = append(relabelings, relabeling)
+		if len(c.SourceLabels) > 0 {
+			relabeling = append(relabeling, yaml.MapItem{Key: "source_labels", Value: c.SourceLabels})
 		}
 
-		if len(relabelings) > 0 {
-			cfg = append(cfg, yaml.MapItem{Key: "write_relabel_configs", Value: relabelings})
+		if c.Separator != nil {
+			relabeling = append(relabeling, yaml.MapItem{Key: "separator", Value: *c.Separator})
 		}
 
-		cfg = cg.addBasicAuthToYaml(cfg, s, spec.BasicAuth)
+		if c.TargetLabel != "" {
+			relabeling = append(relabeling, yaml.MapItem{Key: "target_label", Value: c.TargetLabel})
+		}
 
-		//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
-		if spec.BearerToken != "" {
-			cg.logger.Warn("'bearerToken' is deprecated, use 'authorization' instead.")
-			cfg = append(cfg, yaml.MapItem{Key: "bearer_token", Value: spec.BearerToken})
+		if c.Regex != "" {
+			relabeling = append(relabeling, yaml.MapItem{Key: "regex", Value: c.Regex})
 		}
 
-		//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
-		if spec.BearerTokenFile != "" {
-			cg.logger.Debug("'bearerTokenFile' is deprecated, use 'authorization' instead.")
-			cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: spec.BearerTokenFile})
+		if c.Modulus != 0 {
+			relabeling = append(relabeling, yaml.MapItem{Key: "modulus", Value: c.Modulus})
 		}
 
-		cfg = cg.addOAuth2ToYaml(cfg, s, spec.OAuth2)
+		if c.Replacement != nil {
+			relabeling = append(relabeling, yaml.MapItem{Key: "replacement", Value: *c.Replacement})
+		}
 
-		cfg = cg.addTLStoYaml(cfg, s, spec.TLSConfig)
+		if c.Action != "" {
+			relabeling = append(relabeling, yaml.MapItem{Key: "action", Value: strings.ToLower(c.Action)})
+		}
 
-		cfg = cg.addAuthorizationToYaml(cfg, s, spec.Authorization)
+		relabelings = append(relabelings, relabeling)
+	}
 
-		cfg = cg.addProxyConfigtoYaml(cfg, s, spec.ProxyConfig)
+	if len(relabelings) > 0 {
+		cfg = append(cfg, yaml.MapItem{Key: "write_relabel_configs", Value: relabelings})
+	}
 
-		cfg = cg.WithMinimumVersion("2.26.0").addSigv4ToYaml(cfg, fmt.Sprintf("remoteWrite/%d", i), s, spec.Sigv4)
+	cfg = cg.addBasicAuthToYaml(cfg, s, spec.BasicAuth)
 
-		if spec.AzureAD != nil {
-			azureAd := yaml.MapSlice{}
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if spec.BearerToken != "" {
+		cg.logger.Warn("'bearerToken' is deprecated, use 'authorization' instead.")
+		cfg = append(cfg, yaml.MapItem{Key: "bearer_token", Value: spec.BearerToken})
+	}
 
-			if spec.AzureAD.ManagedIdentity != nil {
-				managedIdentity := yaml.MapSlice{}
-				if clientID := ptr.Deref(spec.AzureAD.ManagedIdentity.ClientID, ""); clientID != "" {
-					managedIdentity = append(managedIdentity, yaml.MapItem{Key: "client_id", Value: clientID})
-				}
-				azureAd = append(azureAd, yaml.MapItem{Key: "managed_identity", Value: managedIdentity})
-			}
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if spec.BearerTokenFile != "" {
+		cg.logger.Debug("'bearerTokenFile' is deprecated, use 'authorization' instead.")
+		cfg = append(cfg, yaml.MapItem{Key: "bearer_token_file", Value: spec.BearerTokenFile})
+	}
 
-			if spec.AzureAD.OAuth != nil {
-				b, err := s.GetSecretKey(spec.AzureAD.OAuth.ClientSecret)
-				if err != nil {
-					cg.logger.Error("invalid Azure OAuth client secret", "err", err)
-				} else {
-					azureAd = cg.WithMinimumVersion("2.48.0").AppendMapItem(azureAd, "oauth", yaml.MapSlice{
-						{Key: "client_id", Value: spec.AzureAD.OAuth.ClientID},
-						{Key: "client_secret", Value: string(b)},
-						{Key: "tenant_id", Value: spec.AzureAD.OAuth.TenantID},
-					})
-				}
-			}
+	cfg = cg.addOAuth2ToYaml(cfg, s, spec.OAuth2)
 
-			if spec.AzureAD.SDK != nil {
-				azureAd = cg.WithMinimumVersion("2.52.0").AppendMapItem(
-					azureAd,
-					"sdk",
-					yaml.MapSlice{
-						{Key: "tenant_id", Value: ptr.Deref(spec.AzureAD.SDK.TenantID, "")},
-					})
-			}
+	cfg = cg.addTLStoYaml(cfg, s, spec.TLSConfig)
 
-			if spec.AzureAD.WorkloadIdentity != nil {
-				workloadIdentityConfig := yaml.MapSlice{
-					{Key: "client_id", Value: spec.AzureAD.WorkloadIdentity.ClientID},
-					{Key: "tenant_id", Value: spec.AzureAD.WorkloadIdentity.TenantID},
-				}
+	cfg = cg.addAuthorizationToYaml(cfg, s, spec.Authorization)
+
+	cfg = cg.addProxyConfigtoYaml(cfg, s, spec.ProxyConfig)
+
+	cfg = cg.WithMinimumVersion("2.26.0").addSigv4ToYaml(cfg, assetStoreKey, s, spec.Sigv4)
 
-				azureAd = cg.WithMinimumVersion("3.7.0").AppendMapItem(azureAd, "workload_identity", workloadIdentityConfig)
+	if spec.AzureAD != nil {
+		azureAd := yaml.MapSlice{}
+
+		if spec.AzureAD.ManagedIdentity != nil {
+			managedIdentity := yaml.MapSlice{}
+			if clientID := ptr.Deref(spec.AzureAD.ManagedIdentity.ClientID, ""); clientID != "" {
+				managedIdentity = append(managedIdentity, yaml.MapItem{Key: "client_id", Value: clientID})
 			}
+			azureAd = append(azureAd, yaml.MapItem{Key: "managed_identity", Value: managedIdentity})
+		}
 
-			if spec.AzureAD.Cloud != nil {
-				azureAd = append(azureAd, yaml.MapItem{Key: "cloud", Value: spec.AzureAD.Cloud})
+		if spec.AzureAD.OAuth != nil {
+			b, err := s.GetSecretKey(spec.AzureAD.OAuth.ClientSecret)
+			if err != nil {
+				cg.logger.Error("invalid Azure OAuth client secret", "err", err)
+			} else {
+				azureAd = cg.WithMinimumVersion("2.48.0").AppendMapItem(azureAd, "oauth", yaml.MapSlice{
+					{Key: "client_id", Value: spec.AzureAD.OAuth.ClientID},
+					{Key: "client_secret", Value: string(b)},
+					{Key: "tenant_id", Value: spec.AzureAD.OAuth.TenantID},
+				})
 			}
+		}
+
+		if spec.AzureAD.SDK != nil {
+			azureAd = cg.WithMinimumVersion("2.52.0").AppendMapItem(
+				azureAd,
+				"sdk",
+				yaml.MapSlice{
+					{Key: "tenant_id", Value: ptr.Deref(spec.AzureAD.SDK.TenantID, "")},
+				})
+		}
 
-			if scope := ptr.Deref(spec.AzureAD.Scope, ""); scope != "" {
-				azureAd = cg.WithMinimumVersion("3.9.0").AppendMapItem(azureAd, "scope", scope)
+		if spec.AzureAD.WorkloadIdentity != nil {
+			workloadIdentityConfig := yaml.MapSlice{
+				{Key: "client_id", Value: spec.AzureAD.WorkloadIdentity.ClientID},
+				{Key: "tenant_id", Value: spec.AzureAD.WorkloadIdentity.TenantID},
 			}
 
-			cfg = cg.WithMinimumVersion("2.45.0").AppendMapItem(cfg, "azuread", azureAd)
+			azureAd = cg.WithMinimumVersion("3.7.0").AppendMapItem(azureAd, "workload_identity", workloadIdentityConfig)
 		}
 
-		if spec.FollowRedirects != nil {
-			cfg = cg.WithMinimumVersion("2.26.0").AppendMapItem(cfg, "follow_redirects", spec.FollowRedirects)
+		if spec.AzureAD.Cloud != nil {
+			azureAd = append(azureAd, yaml.MapItem{Key: "cloud", Value: spec.AzureAD.Cloud})
 		}
 
-		if spec.EnableHttp2 != nil {
-			cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *spec.EnableHttp2)
+		if scope := ptr.Deref(spec.AzureAD.Scope, ""); scope != "" {
+			azureAd = cg.WithMinimumVersion("3.9.0").AppendMapItem(azureAd, "scope", scope)
 		}
 
-		if spec.QueueConfig != nil {
-			queueConfig := yaml.MapSlice{}
+		cfg = cg.WithMinimumVersion("2.45.0").AppendMapItem(cfg, "azuread", azureAd)
+	}
 
-			if spec.QueueConfig.Capacity != int(0) {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "capacity", Value: spec.QueueConfig.Capacity})
-			}
+	if spec.FollowRedirects != nil {
+		cfg = cg.WithMinimumVersion("2.26.0").AppendMapItem(cfg, "follow_redirects", spec.FollowRedirects)
+	}
 
-			if spec.QueueConfig.MinShards != int(0) {
-				queueConfig = cg.WithMinimumVersion("2.6.0").AppendMapItem(queueConfig, "min_shards", spec.QueueConfig.MinShards)
-			}
+	if spec.EnableHttp2 != nil {
+		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *spec.EnableHttp2)
+	}
 
-			if spec.QueueConfig.MaxShards != int(0) {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_shards", Value: spec.QueueConfig.MaxShards})
-			}
+	if spec.QueueConfig != nil {
+		queueConfig := yaml.MapSlice{}
 
-			if spec.QueueConfig.MaxSamplesPerSend != int(0) {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_samples_per_send", Value: spec.QueueConfig.MaxSamplesPerSend})
-			}
+		if spec.QueueConfig.Capacity != int(0) {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "capacity", Value: spec.QueueConfig.Capacity})
+		}
 
-			if spec.QueueConfig.BatchSendDeadline != nil {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "batch_send_deadline", Value: string(*spec.QueueConfig.BatchSendDeadline)})
-			}
+		if spec.QueueConfig.MinShards != int(0) {
+			queueConfig = cg.WithMinimumVersion("2.6.0").AppendMapItem(queueConfig, "min_shards", spec.QueueConfig.MinShards)
+		}
 
-			if spec.QueueConfig.MaxRetries != int(0) {
-				queueConfig = cg.WithMaximumVersion("2.11.0").AppendMapItem(queueConfig, "max_retries", spec.QueueConfig.MaxRetries)
-			}
+		if spec.QueueConfig.MaxShards != int(0) {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "max_shards", Value: spec.QueueConfig.MaxShards})
+		}
 
-			if spec.QueueConfig.MinBackoff != nil {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "min_backoff", Value: string(*spec.QueueConfig.MinBackoff)})
-			}
+		if spec.QueueConfig.MaxSamplesPerSend != int(0) {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "max_samples_per_send", Value: spec.QueueConfig.MaxSamplesPerSend})
+		}
 
-			if spec.QueueConfig.MaxBackoff != nil {
-				queueConfig = append(queueConfig, yaml.MapItem{Key: "max_backoff", Value: string(*spec.QueueConfig.MaxBackoff)})
-			}
+		if spec.QueueConfig.BatchSendDeadline != nil {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "batch_send_deadline", Value: string(*spec.QueueConfig.BatchSendDeadline)})
+		}
 
-			if spec.QueueConfig.RetryOnRateLimit {
-				queueConfig = cg.WithMinimumVersion("2.26.0").AppendMapItem(queueConfig, "retry_on_http_429", spec.QueueConfig.RetryOnRateLimit)
-			}
+		if spec.QueueConfig.MaxRetries != int(0) {
+			queueConfig = cg.WithMaximumVersion("2.11.0").AppendMapItem(queueConfig, "max_retries", spec.QueueConfig.MaxRetries)
+		}
 
-			if spec.QueueConfig.SampleAgeLimit != nil {
-				queueConfig = cg.WithMinimumVersion("2.50.0").AppendMapItem(queueConfig, "sample_age_limit", string(*spec.QueueConfig.SampleAgeLimit))
-			}
+		if spec.QueueConfig.MinBackoff != nil {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "min_backoff", Value: string(*spec.QueueConfig.MinBackoff)})
+		}
 
-			cfg = append(cfg, yaml.MapItem{Key: "queue_config", Value: queueConfig})
+		if spec.QueueConfig.MaxBackoff != nil {
+			queueConfig = append(queueConfig, yaml.MapItem{Key: "max_backoff", Value: string(*spec.QueueConfig.MaxBackoff)})
 		}
 
-		if spec.MetadataConfig != nil {
-			var metadataConfig yaml.MapSlice
-			if ptr.Deref(spec.MessageVersion, "") == monitoringv1.RemoteWriteMessageVersion2_0 {
-				// Prometheus automatically turns off metadata sending when remote-write v2 is used.
-				metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send", Value: false})
-			} else {
-				metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send", Value: spec.MetadataConfig.Send})
-			}
-			if spec.MetadataConfig.SendInterval != "" {
-				metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send_interval", Value: spec.MetadataConfig.SendInterval})
-			}
-			if spec.MetadataConfig.MaxSamplesPerSend != nil {
-				metadataConfig = cg.WithMinimumVersion("2.29.0").AppendMapItem(metadataConfig, "max_samples_per_send", *spec.MetadataConfig.MaxSamplesPerSend)
-			}
+		if spec.QueueConfig.RetryOnRateLimit {
+			queueConfig = cg.WithMinimumVersion("2.26.0").AppendMapItem(queueConfig, "retry_on_http_429", spec.QueueConfig.RetryOnRateLimit)
+		}
 
-			cfg = cg.WithMinimumVersion("2.23.0").AppendMapItem(cfg, "metadata_config", metadataConfig)
+		if spec.QueueConfig.SampleAgeLimit != nil {
+			queueConfig = cg.WithMinimumVersion("2.50.0").AppendMapItem(queueConfig, "sample_age_limit", string(*spec.QueueConfig.SampleAgeLimit))
 		}
 
-		if spec.RoundRobinDNS != nil {
-			cfg = cg.WithMinimumVersion("3.1.0").AppendMapItem(cfg, "round_robin_dns", spec.RoundRobinDNS)
+		cfg = append(cfg, yaml.MapItem{Key: "queue_config", Value: queueConfig})
+	}
+
+	if spec.MetadataConfig != nil {
+		var metadataConfig yaml.MapSlice
+		if ptr.Deref(spec.MessageVersion, "") == monitoringv1.RemoteWriteMessageVersion2_0 {
+			// Prometheus automatically turns off metadata sending when remote-write v2 is used.
+			metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send", Value: false})
+		} else {
+			metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send", Value: spec.MetadataConfig.Send})
+		}
+		if spec.MetadataConfig.SendInterval != "" {
+			metadataConfig = append(metadataConfig, yaml.MapItem{Key: "send_interval", Value: spec.MetadataConfig.SendInterval})
+		}
+		if spec.MetadataConfig.MaxSamplesPerSend != nil {
+			metadataConfig = cg.WithMinimumVersion("2.29.0").AppendMapItem(metadataConfig, "max_samples_per_send", *spec.MetadataConfig.MaxSamplesPerSend)
 		}
 
-		cfgs = append(cfgs, cfg)
+		cfg = cg.WithMinimumVersion("2.23.0").AppendMapItem(cfg, "metadata_config", metadataConfig)
 	}
 
-	return yaml.MapItem{
-		Key:   "remote_write",
-		Value: cfgs,
+	if spec.RoundRobinDNS != nil {
+		cfg = cg.WithMinimumVersion("3.1.0").AppendMapItem(cfg, "round_robin_dns", spec.RoundRobinDNS)
 	}
+
+	return cfg
 }
 
 func (cg *ConfigGenerator) appendScrapeIntervals(slice yaml.MapSlice) yaml.MapSlice {
@@ -3306,6 +3582,7 @@ func (cg *ConfigGenerator) GenerateAgentConfiguration(
 	pMons map[string]*monitoringv1.PodMonitor,
 	probes map[string]*monitoringv1.Probe,
 	sCons map[string]*monitoringv1alpha1.ScrapeConfig,
+	remoteWrites map[string]*monitoringv1alpha1.RemoteWrite,
 	store *assets.StoreBuilder,
 	additionalScrapeConfigs []byte,
 ) ([]byte, error) {
@@ -3404,10 +3681,8 @@ func (cg *ConfigGenerator) GenerateAgentConfiguration(
 	}
 
 	// Remote write config
+	cfg = cg.appendRemoteWriteConfig(cfg, remoteWrites, store)
 	s := store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace())
-	if len(cpf.RemoteWrite) > 0 {
-		cfg = append(cfg, cg.GenerateRemoteWriteConfig(cpf.RemoteWrite, s))
-	}
 
 	// OTLP config
 	cfg, err = cg.appendOTLPConfig(cfg)
@@ -3431,7 +3706,12 @@ func (cg *ConfigGenerator) appendScrapeConfigs(
 
 	for _, identifier := range sortutil.SortedKeys(scrapeConfigs) {
 		cfgGenerator := cg.WithKeyVals("scrapeconfig", identifier)
-		scrapeConfig, err := cfgGenerator.generateScrapeConfig(scrapeConfigs[identifier], store.ForNamespace(scrapeConfigs[identifier].GetNamespace()), shards)
+		scrapeConfig, err := cfgGenerator.generateScrapeConfig(
+			scrapeConfigs[identifier],
+			store.ForNamespace(scrapeConfigs[identifier].GetNamespace()),
+			store.ForNamespace(cg.prom.GetObjectMeta().GetNamespace()),
+			shards,
+		)
 
 		if err != nil {
 			return slices, err
@@ -3446,6 +3726,7 @@ func (cg *ConfigGenerator) appendScrapeConfigs(
 func (cg *ConfigGenerator) generateScrapeConfig(
 	sc *monitoringv1alpha1.ScrapeConfig,
 	s assets.StoreGetter,
+	scs assets.StoreGetter,
 	shards int32,
 ) (yaml.MapSlice, error) {
 	scrapeClass := cg.getScrapeClassOrDefault(sc.Spec.ScrapeClassName)
@@ -3473,16 +3754,16 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		})
 	}
 
-	if sc.Spec.HonorTimestamps != nil {
-		cfg = cg.AddHonorTimestamps(cfg, sc.Spec.HonorTimestamps)
+	if honorTimestamps := mergeHonorTimestampsWithScrapeClass(sc.Spec.HonorTimestamps, scrapeClass); honorTimestamps != nil {
+		cfg = cg.AddHonorTimestamps(cfg, honorTimestamps)
 	}
 
 	if sc.Spec.TrackTimestampsStaleness != nil {
 		cfg = cg.AddTrackTimestampsStaleness(cfg, sc.Spec.TrackTimestampsStaleness)
 	}
 
-	if sc.Spec.HonorLabels != nil {
-		cfg = cg.AddHonorLabels(cfg, *sc.Spec.HonorLabels)
+	if honorLabels := cmp.Or(sc.Spec.HonorLabels, scrapeClass.HonorLabels); honorLabels != nil {
+		cfg = cg.AddHonorLabels(cfg, *honorLabels)
 	}
 
 	if sc.Spec.MetricsPath != nil {
@@ -3501,40 +3782,43 @@ func (cg *ConfigGenerator) generateScrapeConfig(
 		cfg = cg.WithMinimumVersion("2.35.0").AppendMapItem(cfg, "enable_http2", *sc.Spec.EnableHTTP2)
 	}
 
-	if sc.Spec.ScrapeInterval != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: *sc.Spec.ScrapeInterval})
+	interval := mergeScrapeIntervalWithScrapeClass(ptr.Deref(sc.Spec.ScrapeInterval, ""), scrapeClass)
+	if interval != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_interval", Value: interval})
 	}
 
-	if sc.Spec.ScrapeTimeout != nil {
-		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: *sc.Spec.ScrapeTimeout})
+	if timeout := cg.mergeScrapeTimeoutWithScrapeClass(interval, ptr.Deref(sc.Spec.ScrapeTimeout, ""), scrapeClass); timeout != "" {
+		cfg = append(cfg, yaml.MapItem{Key: "scrape_timeout", Value: timeout})
 	}
 
-	cfg = cg.addScrapeProtocols(cfg, sc.Spec.ScrapeProtocols)
+	cfg = cg.addScrapeProtocols(cfg, mergeScrapeProtocolsWithScrapeClass(sc.Spec.ScrapeProtocols, scrapeClass))
 	cfg = cg.addFallbackScrapeProtocol(cfg, mergeFallbackScrapeProtocolWithScrapeClass(sc.Spec.FallbackScrapeProtocol, scrapeClass))
 
 	if sc.Spec.Scheme != nil {
 		cfg = append(cfg, yaml.MapItem{Key: "scheme", Value: sc.Spec.Scheme.String()})
 	}
 
-	cfg = cg.addProxyConfigtoYaml(cfg, s, sc.Spec.ProxyConfig)
+	cfg = cg.addProxyConfigWithScrapeClassToYaml(cfg, s, sc.Spec.ProxyConfig, scs, scrapeClass)
 
 	cfg = cg.addBasicAuthToYaml(cfg, s, sc.Spec.BasicAuth)
 
+	cfg = cg.addScrapeClassAuthToYaml(cfg, scs, scrapeClass, sc.Spec.Authorization != nil || sc.Spec.BasicAuth != nil || sc.Spec.OAuth2 != nil)
+
 	cfg = cg.addAuthorizationToYaml(cfg, s, mergeSafeAuthorizationWithScrapeClass(sc.Spec.Authorization, scrapeClass))
 
 	cfg = cg.addOAuth2ToYaml(cfg, s, sc.Spec.OAuth2)
 
 	cfg = cg.addTLStoYaml(cfg, s, mergeSafeTLSConfigWithScrapeClass(sc.Spec.TLSConfig, scrapeClass))
 
-	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, sc.Spec.SampleLimit, cpf.EnforcedSampleLimit)
-	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, sc.Spec.TargetLimit, cpf.EnforcedTargetLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, sc.Spec.LabelLimit, cpf.EnforcedLabelLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, sc.Spec.LabelNameLengthLimit, cpf.EnforcedLabelNameLengthLimit)
-	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, sc.Spec.LabelValueLengthLimit, cpf.EnforcedLabelValueLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, sampleLimitKey, mergeLimitWithScrapeClass(sc.Spec.SampleLimit, scrapeClass.SampleLimit), cpf.EnforcedSampleLimit)
+	cfg = cg.AddLimitsToYAML(cfg, targetLimitKey, mergeLimitWithScrapeClass(sc.Spec.TargetLimit, scrapeClass.TargetLimit), cpf.EnforcedTargetLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelLimit, scrapeClass.LabelLimit), cpf.EnforcedLabelLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelNameLengthLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelNameLengthLimit, scrapeClass.LabelNameLengthLimit), cpf.EnforcedLabelNameLengthLimit)
+	cfg = cg.AddLimitsToYAML(cfg, labelValueLengthLimitKey, mergeLimitWithScrapeClass(sc.Spec.LabelValueLengthLimit, scrapeClass.LabelValueLengthLimit), cpf.EnforcedLabelValueLengthLimit)
 	cfg = cg.AddLimitsToYAML(cfg, keepDroppedTargetsKey, sc.Spec.KeepDroppedTargets, cpf.EnforcedKeepDroppedTargets)
-	cfg = cg.addNativeHistogramConfig(cfg, sc.Spec.NativeHistogramConfig)
+	cfg = cg.addNativeHistogramConfig(cfg, mergeNativeHistogramConfigWithScrapeClass(sc.Spec.NativeHistogramConfig, scrapeClass))
 
-	if bodySizeLimit := getLowerByteSize(sc.Spec.BodySizeLimit, &cpf); !isByteSizeEmpty(bodySizeLimit) {
+	if bodySizeLimit := getLowerByteSize(mergeBodySizeLimitWithScrapeClass(sc.Spec.BodySizeLimit, scrapeClass), &cpf); !isByteSizeEmpty(bodySizeLimit) {
 		cfg = cg.WithMinimumVersion("2.28.0").AppendMapItem(cfg, "body_size_limit", bodySizeLimit)
 	}
 
@@ -344,6 +344,7 @@ func TestGlobalSettings(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -451,6 +452,7 @@ func TestTopologyZoneExternalLabel(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -690,6 +692,7 @@ func TestProbeStaticTargetsConfigGenerationWithLabelEnforce(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -737,6 +740,7 @@ func TestProbeStaticTargetsConfigGenerationWithJobName(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -783,6 +787,7 @@ func TestProbeStaticTargetsConfigGenerationWithoutModule(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -840,6 +845,7 @@ func TestProbeIngressSDConfigGeneration(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -899,6 +905,7 @@ func TestProbeIngressSDConfigGenerationWithShards(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -909,6 +916,75 @@ func TestProbeIngressSDConfigGenerationWithShards(t *testing.T) {
 	golden.Assert(t, string(cfg), "ProbeIngressSDConfigGenerationWithShards.golden")
 }
 
+func TestProbeHTTPRouteConfigGeneration(t *testing.T) {
+	p := defaultPrometheus()
+
+	cg := mustNewConfigGenerator(t, p)
+	cfg, err := cg.WithProbeHTTPRouteTargets(map[string][]HTTPRouteTarget{
+		"default/testprobe1": {
+			{
+				Namespace: "default",
+				Name:      "frontend",
+				URLs:      []string{"https://example.com/", "https://example.com/login"},
+			},
+			{
+				Namespace: "shop",
+				Name:      "cart",
+				URLs:      []string{"http://shop.example.com:8080/cart"},
+			},
+		},
+	}).GenerateServerConfiguration(
+		p,
+		nil,
+		nil,
+		map[string]*monitoringv1.Probe{
+			"probe1": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "testprobe1",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						Scheme: ptr.To(monitoringv1.SchemeHTTP),
+						URL:    "blackbox.exporter.io",
+						Path:   "/probe",
+					},
+					Module: "http_2xx",
+					Targets: monitoringv1.ProbeTargets{
+						HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"prometheus.io/probe": "true",
+								},
+							},
+							NamespaceSelector: monitoringv1.NamespaceSelector{
+								Any: true,
+							},
+							RelabelConfigs: []monitoringv1.RelabelConfig{
+								{
+									TargetLabel: "foo",
+									Replacement: new("bar"),
+									Action:      "replace",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		nil,
+		nil,
+		&assets.StoreBuilder{},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(t, err)
+
+	golden.Assert(t, string(cfg), "ProbeHTTPRouteConfigGeneration.golden")
+}
+
 func TestProbeIngressSDConfigGenerationWithLabelEnforce(t *testing.T) {
 	p := defaultPrometheus()
 	p.Spec.EnforcedNamespaceLabel = "namespace"
@@ -957,6 +1033,7 @@ func TestProbeIngressSDConfigGenerationWithLabelEnforce(t *testing.T) {
 			},
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -984,6 +1061,7 @@ func TestProbeWithHttp2Disabled(t *testing.T) {
 			"probe1": probe,
 		},
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -1160,6 +1238,7 @@ func TestAlertmanagerBearerToken(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -1233,6 +1312,7 @@ func TestAlertmanagerBasicAuth(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			assets.NewTestStoreBuilder(
 				&corev1.Secret{
 					ObjectMeta: metav1.ObjectMeta{
@@ -1309,6 +1389,7 @@ func TestAlertmanagerSigv4(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			assets.NewTestStoreBuilder(
 				&corev1.Secret{
 					ObjectMeta: metav1.ObjectMeta{
@@ -1444,6 +1525,7 @@ func TestAlertmanagerAPIVersion(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -1477,6 +1559,7 @@ func TestAlertmanagerTimeoutConfig(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -1535,6 +1618,7 @@ func TestAlertmanagerEnableHttp2(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -1586,6 +1670,7 @@ func TestAlertmanagerRelabelConfigs(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			&assets.StoreBuilder{},
 			nil,
 			nil,
@@ -1641,6 +1726,7 @@ func TestAlertmanagerAlertRelabelConfigs(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -1665,6 +1751,7 @@ func TestAdditionalScrapeConfigs(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			&assets.StoreBuilder{},
 			golden.Get(t, "input/TestAdditionalScrapeConfigsAdditionalScrapeConfig.golden"),
 			nil,
@@ -1724,6 +1811,7 @@ func TestAdditionalAlertRelabelConfigs(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		golden.Get(t, "input/AdditionalAlertRelabelConfigs.golden"),
@@ -1786,6 +1874,7 @@ func TestNoEnforcedNamespaceLabelServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -1872,6 +1961,7 @@ func TestServiceMonitorWithServiceDiscoveryRole(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -1931,6 +2021,7 @@ func TestEnforcedNamespaceLabelPodMonitor(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -1999,6 +2090,7 @@ func TestEnforcedNamespaceLabelOnExcludedPodMonitor(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2064,6 +2156,7 @@ func TestEnforcedNamespaceLabelServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2136,6 +2229,7 @@ func TestEnforcedNamespaceLabelOnExcludedServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2166,6 +2260,7 @@ func TestAdditionalAlertmanagers(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2206,6 +2301,7 @@ func TestSettingHonorTimestampsInServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2243,6 +2339,7 @@ func TestSettingHonorTimestampsInPodMonitor(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2280,6 +2377,7 @@ func TestSettingTrackTimestampsStalenessInServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2317,6 +2415,7 @@ func TestSettingTrackTimestampsStalenessInPodMonitor(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2382,6 +2481,7 @@ func TestSettingScrapeProtocolsInServiceMonitor(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -2443,6 +2543,7 @@ func TestSettingScrapeFallbackProtocolInServiceMonitor(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -2510,6 +2611,7 @@ func TestSettingScrapeProtocolsInPodMonitor(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -2571,6 +2673,7 @@ func TestSettingScrapeFallbackProtocolInPodMonitor(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -2611,6 +2714,7 @@ func TestHonorTimestampsOverriding(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2651,6 +2755,7 @@ func TestSettingHonorLabels(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2692,6 +2797,7 @@ func TestHonorLabelsOverriding(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2728,6 +2834,7 @@ func TestTargetLabels(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -2936,6 +3043,7 @@ func TestEndpointOAuth2(t *testing.T) {
 				tt.pMons,
 				tt.probes,
 				nil,
+				nil,
 				s,
 				nil,
 				nil,
@@ -2977,6 +3085,7 @@ func TestPodTargetLabels(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -3016,6 +3125,7 @@ func TestPodTargetLabelsFromPodMonitor(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -3056,6 +3166,7 @@ func TestPodTargetLabelsFromPodMonitorAndGlobal(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -3094,6 +3205,7 @@ func TestEmptyEndpointPorts(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -3165,6 +3277,7 @@ func generateTestConfig(t *testing.T, version string) ([]byte, error) {
 		makePodMonitors(),
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -3735,6 +3848,7 @@ func TestSampleLimits(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -3842,6 +3956,7 @@ func TestTargetLimits(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -4052,6 +4167,7 @@ func TestRemoteReadConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				s,
 				nil,
 				nil,
@@ -4827,6 +4943,7 @@ func TestRemoteWriteConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				store,
 				nil,
 				nil,
@@ -4843,6 +4960,157 @@ func TestRemoteWriteConfig(t *testing.T) {
 	}
 }
 
+func TestRemoteWriteResources(t *testing.T) {
+	remoteWrites := map[string]*monitoringv1alpha1.RemoteWrite{
+		"ns1/rw1": {
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1alpha1.RemoteWritesKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rw1",
+				Namespace: "ns1",
+			},
+			Spec: monitoringv1.RemoteWriteSpec{
+				URL: "http://example.com/ns1",
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "remote-write"},
+						Key:                  "username",
+					},
+					Password: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "remote-write"},
+						Key:                  "password",
+					},
+				},
+				WriteRelabelConfigs: []monitoringv1.RelabelConfig{
+					{
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Regex:        "go_.*",
+						Action:       "drop",
+					},
+				},
+			},
+		},
+		"ns2/rw2": {
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1alpha1.RemoteWritesKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rw2",
+				Namespace: "ns2",
+			},
+			Spec: monitoringv1.RemoteWriteSpec{
+				URL: "http://example.com/ns2",
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name                    string
+		enforcedNamespaceLabel  string
+		excludedFromEnforcement []monitoringv1.ObjectReference
+		agent                   bool
+		remoteWriteName         string
+		golden                  string
+	}{
+		{
+			name:   "server",
+			golden: "RemoteWriteResources.golden",
+		},
+		{
+			name:            "colliding names",
+			remoteWriteName: "shared",
+			golden:          "RemoteWriteResourcesWithCollidingNames.golden",
+		},
+		{
+			name:   "agent",
+			agent:  true,
+			golden: "RemoteWriteResourcesAgent.golden",
+		},
+		{
+			name:                   "enforced namespace label",
+			enforcedNamespaceLabel: "namespace",
+			excludedFromEnforcement: []monitoringv1.ObjectReference{
+				{
+					Group:     monitoringv1.SchemeGroupVersion.Group,
+					Resource:  monitoringv1alpha1.RemoteWriteName,
+					Namespace: "ns2",
+				},
+			},
+			golden: "RemoteWriteResourcesWithEnforcedNamespaceLabel.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := assets.NewTestStoreBuilder(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "remote-write",
+						Namespace: "ns1",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+					},
+				},
+			)
+
+			rws := make(map[string]*monitoringv1alpha1.RemoteWrite, len(remoteWrites))
+			for k, rw := range remoteWrites {
+				rw = rw.DeepCopy()
+				if tc.remoteWriteName != "" {
+					rw.Spec.Name = ptr.To(tc.remoteWriteName)
+				}
+				rws[k] = rw
+			}
+
+			p := defaultPrometheus()
+			p.Spec.CommonPrometheusFields.RemoteWrite = []monitoringv1.RemoteWriteSpec{
+				{URL: "http://example.com/default"},
+			}
+			if tc.remoteWriteName != "" {
+				p.Spec.CommonPrometheusFields.RemoteWrite[0].Name = ptr.To(tc.remoteWriteName)
+			}
+			p.Spec.CommonPrometheusFields.EnforcedNamespaceLabel = tc.enforcedNamespaceLabel
+			p.Spec.CommonPrometheusFields.ExcludedFromEnforcement = tc.excludedFromEnforcement
+
+			var (
+				cg  = mustNewConfigGenerator(t, p)
+				cfg []byte
+				err error
+			)
+			if tc.agent {
+				cfg, err = cg.GenerateAgentConfiguration(
+					nil,
+					nil,
+					nil,
+					nil,
+					rws,
+					store,
+					nil,
+				)
+			} else {
+				cfg, err = cg.GenerateServerConfiguration(
+					p,
+					nil,
+					nil,
+					nil,
+					nil,
+					rws,
+					store,
+					nil,
+					nil,
+					nil,
+					nil,
+				)
+			}
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
 func TestLabelLimits(t *testing.T) {
 	for _, tc := range []struct {
 		version            string
@@ -4941,6 +5209,7 @@ func TestLabelLimits(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5047,6 +5316,7 @@ func TestLabelNameLengthLimits(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5191,6 +5461,7 @@ func TestLabelValueLengthLimits(t *testing.T) {
 					"testprobe1": &probe,
 				},
 				nil,
+				nil,
 				s,
 				nil,
 				nil,
@@ -5264,6 +5535,7 @@ func TestKeepDroppedTargets(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5395,6 +5667,7 @@ func TestNativeHistogramConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5474,6 +5747,7 @@ func TestBodySizeLimits(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5520,6 +5794,7 @@ func TestScrapeConfigBodySizeLimit(t *testing.T) {
 		map[string]*monitoringv1alpha1.ScrapeConfig{
 			"testscrapeconfig1": &scrapeConfig,
 		},
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -5565,6 +5840,7 @@ func TestMatchExpressionsServiceMonitor(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -5642,6 +5918,7 @@ func TestServiceMonitorEndpointFollowRedirects(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5720,6 +5997,7 @@ func TestPodMonitorEndpointFollowRedirects(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5797,6 +6075,7 @@ func TestServiceMonitorEndpointEnableHttp2(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5837,6 +6116,7 @@ func TestPodMonitorPhaseFilter(t *testing.T) {
 		},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -5912,6 +6192,7 @@ func TestPodMonitorEndpointEnableHttp2(t *testing.T) {
 				},
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -5966,6 +6247,7 @@ func TestRuntimeConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -6021,6 +6303,7 @@ func TestStorageSettingMaxExemplars(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -6173,6 +6456,7 @@ func TestTSDBConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -6242,6 +6526,7 @@ func TestRetentionConfigFile(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -6363,6 +6648,7 @@ func TestTSDBConfigPrometheusAgent(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 			)
@@ -6424,6 +6710,7 @@ func TestScrapeFailureLogFilePrometheusAgent(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 			)
@@ -6445,6 +6732,7 @@ func TestPromAgentDaemonSetPodMonitorConfig(t *testing.T) {
 		pmons,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 	)
@@ -6520,6 +6808,7 @@ func TestGenerateRelabelConfig(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -6690,6 +6979,7 @@ func TestProbeSpecConfig(t *testing.T) {
 				nil,
 				pbs,
 				nil,
+				nil,
 				s,
 				nil,
 				nil,
@@ -7570,6 +7860,7 @@ func TestScrapeConfigSpecConfig(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -7780,6 +8071,7 @@ func TestScrapeConfigSpecConfigWithHTTPSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -8090,6 +8382,7 @@ func TestScrapeConfigSpecConfigWithKubernetesSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -8410,6 +8703,7 @@ func TestScrapeConfigSpecConfigWithConsulSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -8686,6 +8980,7 @@ func TestScrapeConfigSpecConfigWithEC2SD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				assets.NewTestStoreBuilder(sec),
 				nil,
 				nil,
@@ -8994,6 +9289,7 @@ func TestScrapeConfigSpecConfigWithAzureSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -9086,6 +9382,7 @@ func TestScrapeConfigSpecConfigWithGCESD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				assets.NewTestStoreBuilder(),
 				nil,
 				nil,
@@ -9187,6 +9484,7 @@ func TestScrapeConfigSpecConfigWithOpenStackSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				assets.NewTestStoreBuilder(sec),
 				nil,
 				nil,
@@ -9374,6 +9672,7 @@ func TestScrapeConfigSpecConfigWithDigitalOceanSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -9751,6 +10050,7 @@ func TestScrapeConfigSpecConfigWithDockerSDConfig(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -9942,6 +10242,7 @@ func TestScrapeConfigSpecConfigWithLinodeSDConfig(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -10219,6 +10520,7 @@ func TestScrapeConfigSpecConfigWithHetznerSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -10281,6 +10583,7 @@ func TestAppendNameValidationScheme(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -10338,6 +10641,7 @@ func TestAppendNameEscapingScheme(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -10395,6 +10699,7 @@ func TestAppendConvertClassicHistogramsToNHCB(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -10651,6 +10956,7 @@ func TestOTLPConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				store,
 				nil,
 				nil,
@@ -10715,6 +11021,7 @@ func TestTracingConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				store,
 				nil,
 				nil,
@@ -10946,6 +11253,7 @@ func TestScrapeConfigSpecConfigWithKumaSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -11142,6 +11450,7 @@ func TestScrapeClass(t *testing.T) {
 				map[string]*monitoringv1.PodMonitor{"monitor": podMonitor},
 				map[string]*monitoringv1.Probe{"monitor": probe},
 				map[string]*monitoringv1alpha1.ScrapeConfig{"monitor": scrapeConfig},
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -11259,6 +11568,7 @@ func TestServiceMonitorScrapeClassWithDefaultTLS(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			&assets.StoreBuilder{},
 			nil,
 			nil,
@@ -11370,6 +11680,7 @@ func TestPodMonitorScrapeClassWithDefaultTLS(t *testing.T) {
 			map[string]*monitoringv1.PodMonitor{"monitor": podMonitor},
 			nil,
 			nil,
+			nil,
 			&assets.StoreBuilder{},
 			nil,
 			nil,
@@ -11431,6 +11742,7 @@ func TestPodMonitorPortNumber(t *testing.T) {
 			map[string]*monitoringv1.PodMonitor{"monitor": podMonitor},
 			nil,
 			nil,
+			nil,
 			&assets.StoreBuilder{},
 			nil,
 			nil,
@@ -11733,6 +12045,7 @@ func TestScrapeConfigSpecConfigWithEurekaSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -11918,6 +12231,7 @@ func TestScrapeConfigSpecConfigWithNomadSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -12153,6 +12467,7 @@ func TestScrapeConfigSpecConfigWithDockerswarmSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -12382,6 +12697,7 @@ func TestScrapeConfigSpecConfigWithPuppetDBSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -12664,6 +12980,7 @@ func TestScrapeConfigSpecConfigWithLightSailSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -12742,6 +13059,7 @@ func TestScrapeConfigSpecConfigWithOVHCloudSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -12895,6 +13213,7 @@ func TestScrapeConfigSpecConfigWithScalewaySD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -13079,6 +13398,7 @@ func TestScrapeConfigSpecConfigWithIonosSD(t *testing.T) {
 				nil,
 				nil,
 				scs,
+				nil,
 				store,
 				nil,
 				nil,
@@ -13127,6 +13447,7 @@ func TestServiceMonitorWithDefaultScrapeClassRelabelings(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -13161,6 +13482,7 @@ func TestServiceMonitorWithNonDefaultScrapeClassRelabelings(t *testing.T) {
 		nil,
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -13207,6 +13529,7 @@ func TestPodMonitorWithDefaultScrapeClassRelabelings(t *testing.T) {
 		map[string]*monitoringv1.PodMonitor{"monitor": podMonitor},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -13241,6 +13564,7 @@ func TestPodMonitorWithNonDefaultScrapeClassRelabelings(t *testing.T) {
 		map[string]*monitoringv1.PodMonitor{"monitor": podMonitor},
 		nil,
 		nil,
+		nil,
 		&assets.StoreBuilder{},
 		nil,
 		nil,
@@ -13379,6 +13703,7 @@ func TestScrapeClassMetricRelabelings(t *testing.T) {
 				tc.podMonitors,
 				tc.probes,
 				tc.scrapeConfigs,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -13652,6 +13977,7 @@ func TestScrapeClassAuthorization(t *testing.T) {
 				tc.podMonitors,
 				tc.probes,
 				tc.scrapeConfigs,
+				nil,
 				tc.storeBuilder,
 				nil,
 				nil,
@@ -13743,6 +14069,7 @@ func TestScrapeClassAttachMetadata(t *testing.T) {
 				tc.podMonitors,
 				tc.probes,
 				tc.scrapeConfigs,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -13880,6 +14207,7 @@ func TestScrapeClassFallbackScrapeProtocol(t *testing.T) {
 				tc.podMonitors,
 				tc.probes,
 				tc.scrapeConfigs,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -13893,6 +14221,309 @@ func TestScrapeClassFallbackScrapeProtocol(t *testing.T) {
 	}
 }
 
+func TestScrapeClassScrapeDefaults(t *testing.T) {
+	scrapeClass := monitoringv1.ScrapeClass{
+		Name:                  "default",
+		Default:               new(true),
+		ScrapeInterval:        ptr.To(monitoringv1.Duration("10s")),
+		ScrapeTimeout:         ptr.To(monitoringv1.Duration("5s")),
+		SampleLimit:           new(int64(1000)),
+		TargetLimit:           new(int64(100)),
+		LabelLimit:            new(int64(50)),
+		LabelNameLengthLimit:  new(int64(64)),
+		LabelValueLengthLimit: new(int64(128)),
+		BodySizeLimit:         ptr.To(monitoringv1.ByteSize("10MB")),
+		ScrapeProtocols:       []monitoringv1.ScrapeProtocol{monitoringv1.OpenMetricsText1_0_0, monitoringv1.PrometheusText0_0_4},
+		HonorLabels:           new(true),
+		HonorTimestamps:       new(false),
+		NativeHistogramConfig: monitoringv1.NativeHistogramConfig{
+			NativeHistogramBucketLimit: new(int64(160)),
+		},
+		ProxyConfig: monitoringv1.ProxyConfig{
+			ProxyURL: new("http://egress-proxy:3128"),
+		},
+	}
+
+	serviceMonitor := defaultServiceMonitor()
+	serviceMonitor.Spec.Endpoints[0].Interval = ""
+	podMonitor := defaultPodMonitor()
+	podMonitor.Spec.PodMetricsEndpoints[0].Interval = ""
+
+	serviceMonitorWithOverrides := defaultServiceMonitor()
+	serviceMonitorWithOverrides.Spec.Endpoints[0].Interval = "20s"
+	serviceMonitorWithOverrides.Spec.Endpoints[0].ProxyConfig = monitoringv1.ProxyConfig{
+		ProxyURL: new("http://other-proxy:3128"),
+	}
+	serviceMonitorWithOverrides.Spec.SampleLimit = new(int64(2000))
+	serviceMonitorWithOverrides.Spec.LabelLimit = new(int64(10))
+
+	serviceMonitorInOtherNamespace := defaultServiceMonitor()
+	serviceMonitorInOtherNamespace.Namespace = "ns1"
+
+	for _, tc := range []struct {
+		name                string
+		scrapeClass         monitoringv1.ScrapeClass
+		enforcedSampleLimit *int64
+		serviceMonitors     map[string]*monitoringv1.ServiceMonitor
+		podMonitors         map[string]*monitoringv1.PodMonitor
+		probes              map[string]*monitoringv1.Probe
+		scrapeConfigs       map[string]*monitoringv1alpha1.ScrapeConfig
+		goldenFile          string
+	}{
+		{
+			name:            "ServiceMonitor with default ScrapeClass",
+			scrapeClass:     scrapeClass,
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitor},
+			goldenFile:      "serviceMonitorObjectWithDefaultScrapeClassScrapeDefaults.golden",
+		},
+		{
+			name:                "ServiceMonitor overriding the ScrapeClass defaults with enforced limits",
+			scrapeClass:         scrapeClass,
+			enforcedSampleLimit: new(int64(1500)),
+			serviceMonitors:     map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitorWithOverrides},
+			goldenFile:          "serviceMonitorObjectOverridingScrapeClassScrapeDefaults.golden",
+		},
+		{
+			name: "ServiceMonitor with ScrapeClass timeout greater than the monitor interval",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:          "default",
+				Default:       new(true),
+				ScrapeTimeout: ptr.To(monitoringv1.Duration("25s")),
+			},
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitorWithOverrides},
+			goldenFile:      "serviceMonitorObjectWithScrapeClassTimeoutGreaterThanInterval.golden",
+		},
+		{
+			name: "ServiceMonitor with ScrapeClass basic auth",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:    "default",
+				Default: new(true),
+				BasicAuth: &monitoringv1.BasicAuth{
+					Username: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-class-auth"},
+						Key:                  "username",
+					},
+					Password: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "scrape-class-auth"},
+						Key:                  "password",
+					},
+				},
+			},
+			serviceMonitors: map[string]*monitoringv1.ServiceMonitor{"monitor": serviceMonitorInOtherNamespace},
+			goldenFile:      "serviceMonitorObjectWithDefaultScrapeClassBasicAuth.golden",
+		},
+		{
+			name:        "PodMonitor with default ScrapeClass",
+			scrapeClass: scrapeClass,
+			podMonitors: map[string]*monitoringv1.PodMonitor{"monitor": podMonitor},
+			goldenFile:  "podMonitorObjectWithDefaultScrapeClassScrapeDefaults.golden",
+		},
+		{
+			name:        "Probe with default ScrapeClass",
+			scrapeClass: scrapeClass,
+			probes:      map[string]*monitoringv1.Probe{"monitor": defaultProbe()},
+			goldenFile:  "probeObjectWithDefaultScrapeClassScrapeDefaults.golden",
+		},
+		{
+			name:          "ScrapeConfig with default ScrapeClass",
+			scrapeClass:   scrapeClass,
+			scrapeConfigs: map[string]*monitoringv1alpha1.ScrapeConfig{"monitor": defaultScrapeConfig()},
+			goldenFile:    "scrapeConfigObjectWithDefaultScrapeClassScrapeDefaults.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{tc.scrapeClass}
+			p.Spec.EnforcedSampleLimit = tc.enforcedSampleLimit
+			cg := mustNewConfigGenerator(t, p)
+
+			store := assets.NewTestStoreBuilder(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "scrape-class-auth",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"username": []byte("scraper"),
+						"password": []byte("secret"),
+					},
+				},
+			)
+			require.NoError(t, AddScrapeClassesToStore(t.Context(), store, p.Namespace, p.Spec.ScrapeClasses))
+
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				tc.serviceMonitors,
+				tc.podMonitors,
+				tc.probes,
+				tc.scrapeConfigs,
+				nil,
+				store,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.goldenFile)
+		})
+	}
+}
+
+func TestScrapeClassHonorLabels(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		scrapeClassHonor  *bool
+		endpointHonor     bool
+		scrapeConfigHonor *bool
+
+		// expectedMonitors applies to the ServiceMonitor and PodMonitor jobs.
+		expectedMonitors     bool
+		expectedScrapeConfig bool
+		expectedProbe        bool
+	}{
+		{
+			name:                 "resources enabled, scrape class disabled",
+			scrapeClassHonor:     new(false),
+			endpointHonor:        true,
+			scrapeConfigHonor:    new(true),
+			expectedMonitors:     true,
+			expectedScrapeConfig: true,
+			expectedProbe:        false,
+		},
+		{
+			// The monitor endpoints can only opt in while the ScrapeConfig
+			// resource can override the scrape class value.
+			name:                 "resources disabled, scrape class enabled",
+			scrapeClassHonor:     new(true),
+			endpointHonor:        false,
+			scrapeConfigHonor:    new(false),
+			expectedMonitors:     true,
+			expectedScrapeConfig: false,
+			expectedProbe:        true,
+		},
+		{
+			name:                 "resources unset, scrape class enabled",
+			scrapeClassHonor:     new(true),
+			expectedMonitors:     true,
+			expectedScrapeConfig: true,
+			expectedProbe:        true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{{
+				Name:        "default",
+				Default:     new(true),
+				HonorLabels: tc.scrapeClassHonor,
+			}}
+			cg := mustNewConfigGenerator(t, p)
+
+			serviceMonitor := defaultServiceMonitor()
+			serviceMonitor.Spec.Endpoints[0].HonorLabels = tc.endpointHonor
+			podMonitor := defaultPodMonitor()
+			podMonitor.Spec.PodMetricsEndpoints[0].HonorLabels = tc.endpointHonor
+			scrapeConfig := defaultScrapeConfig()
+			scrapeConfig.Spec.HonorLabels = tc.scrapeConfigHonor
+
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{"sm": serviceMonitor},
+				map[string]*monitoringv1.PodMonitor{"pm": podMonitor},
+				map[string]*monitoringv1.Probe{"probe": defaultProbe()},
+				map[string]*monitoringv1alpha1.ScrapeConfig{"sc": scrapeConfig},
+				nil,
+				assets.NewTestStoreBuilder(),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+
+			var promCfg struct {
+				ScrapeConfigs []struct {
+					JobName     string `yaml:"job_name"`
+					HonorLabels bool   `yaml:"honor_labels"`
+				} `yaml:"scrape_configs"`
+			}
+			require.NoError(t, yaml.Unmarshal(cfg, &promCfg))
+
+			expected := map[string]bool{
+				"serviceMonitor/default/defaultServiceMonitor/0": tc.expectedMonitors,
+				"podMonitor/default/defaultPodMonitor/0":         tc.expectedMonitors,
+				"scrapeConfig/default/defaultScrapeConfig":       tc.expectedScrapeConfig,
+				"probe/default/defaultProbe":                     tc.expectedProbe,
+			}
+			require.Len(t, promCfg.ScrapeConfigs, len(expected))
+			for _, sc := range promCfg.ScrapeConfigs {
+				require.Equal(t, expected[sc.JobName], sc.HonorLabels, sc.JobName)
+			}
+		})
+	}
+}
+
+func TestScrapeClassScrapeDefaultsValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		scrapeClass monitoringv1.ScrapeClass
+		expectedErr bool
+	}{
+		{
+			name: "valid interval and timeout",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:           "test",
+				ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+				ScrapeTimeout:  ptr.To(monitoringv1.Duration("5s")),
+			},
+		},
+		{
+			name: "timeout greater than interval",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name:           "test",
+				ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+				ScrapeTimeout:  ptr.To(monitoringv1.Duration("15s")),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "basic auth and authorization",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "test",
+				Authorization: &monitoringv1.Authorization{
+					CredentialsFile: "/etc/prometheus/secrets/token",
+				},
+				BasicAuth: &monitoringv1.BasicAuth{},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "invalid proxy",
+			scrapeClass: monitoringv1.ScrapeClass{
+				Name: "test",
+				ProxyConfig: monitoringv1.ProxyConfig{
+					NoProxy: new("0.0.0.0"),
+				},
+			},
+			expectedErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{tc.scrapeClass}
+
+			_, err := NewConfigGenerator(newLogger(), p)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGenerateAlertmanagerConfig(t *testing.T) {
 	for _, tc := range []struct {
 		alerting *monitoringv1.AlertingSpec
@@ -14055,6 +14686,7 @@ func TestGenerateAlertmanagerConfig(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				assets.NewTestStoreBuilder(
 					&corev1.Secret{
 						ObjectMeta: metav1.ObjectMeta{
@@ -14274,6 +14906,7 @@ func TestAlertmanagerTLSConfig(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
 			assets.NewTestStoreBuilder(),
 			nil,
 			nil,
@@ -14428,6 +15061,7 @@ func TestServiceMonitorSelectors(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				assets.NewTestStoreBuilder(),
 				nil,
 				nil,
@@ -14579,6 +15213,7 @@ func TestPodMonitorSelectors(t *testing.T) {
 				map[string]*monitoringv1.PodMonitor{"monitor": tc.podMonitor},
 				nil,
 				nil,
+				nil,
 				assets.NewTestStoreBuilder(),
 				nil,
 				nil,
@@ -14635,6 +15270,7 @@ func TestAppendConvertScrapeClassicHistograms(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -14692,6 +15328,7 @@ func TestAppendScrapeNativeHistograms(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -14859,6 +15496,7 @@ func TestTopologyShardingRelabeling(t *testing.T) {
 				tc.podMonitor,
 				nil,
 				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -14933,6 +15571,90 @@ func TestShardingRelabelConfigsWithRetention(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				&assets.StoreBuilder{},
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			require.NoError(t, err)
+			golden.Assert(t, string(cfg), tc.golden)
+		})
+	}
+}
+
+func TestConsistentHashShardingRelabelConfigs(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		shards           int32
+		virtualBuckets   *int32
+		retentionEnabled bool
+		golden           string
+	}{
+		{
+			name:           "2_shards",
+			shards:         2,
+			virtualBuckets: new(int32(8)),
+			golden:         "ConsistentHashShardingRelabelConfigs_2_shards.golden",
+		},
+		{
+			name:             "with_retention_3_shards",
+			shards:           3,
+			virtualBuckets:   new(int32(8)),
+			retentionEnabled: true,
+			golden:           "ConsistentHashShardingRelabelConfigs_with_retention_3_shards.golden",
+		},
+		{
+			name:           "less_buckets_than_shards",
+			shards:         3,
+			virtualBuckets: new(int32(2)),
+			golden:         "ConsistentHashShardingRelabelConfigs_less_buckets_than_shards.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := defaultPrometheus()
+			p.Spec.Shards = new(tc.shards)
+			p.Spec.ShardingStrategy = &monitoringv1.ShardingStrategy{
+				Mode: ptr.To(monitoringv1.ConsistentHashShardingStrategyMode),
+				ConsistentHash: &monitoringv1.ConsistentHashShardingStrategy{
+					VirtualBuckets: tc.virtualBuckets,
+				},
+			}
+
+			opts := []ConfigGeneratorOption{}
+			if tc.retentionEnabled {
+				opts = append(opts, WithPrometheusRetentionPolicies())
+			}
+
+			cg := mustNewConfigGenerator(t, p, opts...)
+			cfg, err := cg.GenerateServerConfiguration(
+				p,
+				map[string]*monitoringv1.ServiceMonitor{
+					"test": {
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test",
+							Namespace: "default",
+						},
+						Spec: monitoringv1.ServiceMonitorSpec{
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"foo": "bar",
+								},
+							},
+							Endpoints: []monitoringv1.Endpoint{
+								{
+									Port:     "web",
+									Interval: "30s",
+								},
+							},
+						},
+					},
+				},
+				nil,
+				map[string]*monitoringv1.Probe{"probe": defaultProbe()},
+				nil,
+				nil,
 				&assets.StoreBuilder{},
 				nil,
 				nil,
@@ -24,6 +24,7 @@ import (
 	"net/url"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/blang/semver/v4"
@@ -65,6 +66,24 @@ type ResourceSelector struct {
 	accessor           *operator.Accessor
 
 	eventRecorder *operator.EventRecorder
+
+	listHTTPRoutes        ListAllByNamespaceFn
+	getGateway            GetByKeyFn
+	probeHTTPRouteTargets map[string][]HTTPRouteTarget
+
+	// Namespaces considered for the selection indexed by resource kind.
+	selectedNamespaces map[string][]string
+}
+
+type ResourceSelectorOption func(*ResourceSelector)
+
+// WithHTTPRouteDiscovery enables the discovery of probe targets from
+// Gateway API HTTPRoute objects.
+func WithHTTPRouteDiscovery(listHTTPRoutes ListAllByNamespaceFn, getGateway GetByKeyFn) ResourceSelectorOption {
+	return func(rs *ResourceSelector) {
+		rs.listHTTPRoutes = listHTTPRoutes
+		rs.getGateway = getGateway
+	}
 }
 
 type ListAllByNamespaceFn func(namespace string, selector labels.Selector, appendFn cache.AppendFunc) error
@@ -76,6 +95,7 @@ func NewResourceSelector(
 	namespaceInformers cache.SharedIndexInformer,
 	metrics *operator.Metrics,
 	eventRecorder *operator.EventRecorder,
+	opts ...ResourceSelectorOption,
 ) (*ResourceSelector, error) {
 	promVersion := operator.StringValOrDefault(p.GetCommonPrometheusFields().Version, operator.DefaultPrometheusVersion)
 	version, err := semver.ParseTolerant(promVersion)
@@ -83,16 +103,24 @@ func NewResourceSelector(
 		return nil, fmt.Errorf("failed to parse Prometheus version: %w", err)
 	}
 
-	return &ResourceSelector{
-		l:                  l,
-		p:                  p,
-		version:            version,
-		store:              store,
-		namespaceInformers: namespaceInformers,
-		metrics:            metrics,
-		eventRecorder:      eventRecorder,
-		accessor:           operator.NewAccessor(l),
-	}, nil
+	rs := &ResourceSelector{
+		l:                     l,
+		p:                     p,
+		version:               version,
+		store:                 store,
+		namespaceInformers:    namespaceInformers,
+		metrics:               metrics,
+		eventRecorder:         eventRecorder,
+		accessor:              operator.NewAccessor(l),
+		probeHTTPRouteTargets: map[string][]HTTPRouteTarget{},
+		selectedNamespaces:    map[string][]string{},
+	}
+
+	for _, opt := range opts {
+		opt(rs)
+	}
+
+	return rs, nil
 }
 
 func selectObjects[T operator.ConfigurationResource](
@@ -113,6 +141,7 @@ func selectObjects[T operator.ConfigurationResource](
 		return nil, err
 	}
 	logger.Debug("selecting objects", "namespaces", strings.Join(namespaces, ","))
+	rs.selectedNamespaces[kind] = namespaces
 
 	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
 	if err != nil {
@@ -238,7 +267,7 @@ func (rs *ResourceSelector) checkServiceMonitor(ctx context.Context, sm *monitor
 			return fmt.Errorf("%w: authorization: %w", epErr, err)
 		}
 
-		if err := validateScrapeIntervalAndTimeout(rs.p, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
+		if err := validateScrapeIntervalAndTimeout(rs.p, sm.Spec.ScrapeClassName, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
 			return fmt.Errorf("%w: %w", epErr, err)
 		}
 
@@ -288,16 +317,42 @@ func testForArbitraryFSAccess(e monitoringv1.Endpoint) error {
 	return nil
 }
 
-func validateScrapeIntervalAndTimeout(p monitoringv1.PrometheusInterface, scrapeInterval, scrapeTimeout monitoringv1.Duration) error {
+// validateScrapeIntervalAndTimeout checks that the scrape timeout isn't
+// greater than the effective scrape interval which is (in order of
+// precedence) the given interval, the interval of the scrape class or the
+// global interval.
+func validateScrapeIntervalAndTimeout(p monitoringv1.PrometheusInterface, scrapeClassName *string, scrapeInterval, scrapeTimeout monitoringv1.Duration) error {
 	if scrapeTimeout == "" {
 		return nil
 	}
+	if scrapeInterval == "" {
+		scrapeInterval = ptr.Deref(scrapeClassOrDefault(p, scrapeClassName).ScrapeInterval, "")
+	}
 	if scrapeInterval == "" {
 		scrapeInterval = p.GetCommonPrometheusFields().ScrapeInterval
 	}
 	return CompareScrapeTimeoutToScrapeInterval(scrapeTimeout, scrapeInterval)
 }
 
+// scrapeClassOrDefault returns the scrape class matching the given name or
+// the default scrape class if the name is empty.
+func scrapeClassOrDefault(p monitoringv1.PrometheusInterface, scrapeClassName *string) monitoringv1.ScrapeClass {
+	for _, c := range p.GetCommonPrometheusFields().ScrapeClasses {
+		if ptr.Deref(scrapeClassName, "") != "" {
+			if c.Name == *scrapeClassName {
+				return c
+			}
+			continue
+		}
+
+		if ptr.Deref(c.Default, false) {
+			return c
+		}
+	}
+
+	return monitoringv1.ScrapeClass{}
+}
+
 func validateScrapeClass(p monitoringv1.PrometheusInterface, sc *string) error {
 	if ptr.Deref(sc, "") == "" {
 		return nil
@@ -350,7 +405,7 @@ func (rs *ResourceSelector) checkPodMonitor(ctx context.Context, pm *monitoringv
 
 	for i, endpoint := range pm.Spec.PodMetricsEndpoints {
 		epErr := fmt.Errorf("endpoint[%d]", i)
-		if err := validateScrapeIntervalAndTimeout(rs.p, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
+		if err := validateScrapeIntervalAndTimeout(rs.p, pm.Spec.ScrapeClassName, endpoint.Interval, endpoint.ScrapeTimeout); err != nil {
 			return fmt.Errorf("%w: %w", epErr, err)
 		}
 
@@ -462,7 +517,7 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 		return fmt.Errorf("oauth2: %w", err)
 	}
 
-	if err := validateScrapeIntervalAndTimeout(rs.p, probe.Spec.Interval, probe.Spec.ScrapeTimeout); err != nil {
+	if err := validateScrapeIntervalAndTimeout(rs.p, probe.Spec.ScrapeClassName, probe.Spec.Interval, probe.Spec.ScrapeTimeout); err != nil {
 		return err
 	}
 
@@ -485,6 +540,34 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 		}
 	}
 
+	if probe.Spec.Targets.HTTPRoute != nil {
+		if err := rs.ValidateRelabelConfigs(probe.Spec.Targets.HTTPRoute.RelabelConfigs); err != nil {
+			return fmt.Errorf("targets.httpRoute.relabelConfigs: %w", err)
+		}
+
+		// The HTTPRoute targets are only resolved when they aren't
+		// superseded by the static or ingress targets.
+		if probe.Spec.Targets.StaticConfig == nil && probe.Spec.Targets.Ingress == nil {
+			if rs.listHTTPRoutes == nil {
+				return errors.New("targets.httpRoute: the Gateway API HTTPRoute and Gateway resources aren't installed or the operator isn't allowed to watch them")
+			}
+
+			targets, notFound, err := selectHTTPRouteTargets(probe, rs.p.GetCommonPrometheusFields().IgnoreNamespaceSelectors, rs.listHTTPRoutes, rs.getGateway)
+			if err != nil {
+				return fmt.Errorf("targets.httpRoute: %w", err)
+			}
+
+			if len(notFound) > 0 {
+				msg := fmt.Sprintf("targets.httpRoute: ignoring the parent Gateways which don't exist or aren't in the namespaces watched by the operator: %s", strings.Join(notFound, ", "))
+				rs.l.Warn(msg, "probe", probe.Name, "namespace", probe.Namespace)
+				rs.eventRecorder.Eventf(probe, corev1.EventTypeWarning, operator.InvalidConfigurationEvent, selectingConfigurationResourcesAction, "%s", msg)
+			}
+
+			k, _ := rs.accessor.MetaNamespaceKey(probe)
+			rs.probeHTTPRouteTargets[k] = targets
+		}
+	}
+
 	if err := addProxyConfigToStore(ctx, probe.Spec.ProberSpec.ProxyConfig, rs.store, probe.GetNamespace()); err != nil {
 		return fmt.Errorf("proxy configuration: %w", err)
 	}
@@ -496,6 +579,19 @@ func (rs *ResourceSelector) checkProbe(ctx context.Context, probe *monitoringv1.
 	return nil
 }
 
+// ProbeHTTPRouteTargets returns the probed URLs of the HTTPRoute objects
+// discovered by the selected Probes, indexed by the Probe's key.
+// It should be called after SelectProbes().
+func (rs *ResourceSelector) ProbeHTTPRouteTargets() map[string][]HTTPRouteTarget {
+	return rs.probeHTTPRouteTargets
+}
+
+// SelectedNamespaces returns the namespaces considered for the selection of
+// configuration resources, indexed by resource kind.
+func (rs *ResourceSelector) SelectedNamespaces() map[string][]string {
+	return rs.selectedNamespaces
+}
+
 func (rs *ResourceSelector) validateStaticConfigLabels(labels map[string]string) error {
 	keys := slices.Collect(maps.Keys(labels))
 	slices.Sort(keys)
@@ -548,6 +644,86 @@ func validateServer(server string) error {
 	return nil
 }
 
+// SelectRemoteWrites returns the RemoteWrites which match the selectors in the
+// Prometheus CR and filters them returning all the configuration.
+func (rs *ResourceSelector) SelectRemoteWrites(ctx context.Context, listFn ListAllByNamespaceFn) (operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite], error) {
+	cpf := rs.p.GetCommonPrometheusFields()
+
+	cg, err := NewConfigGenerator(rs.l, rs.p)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectObjects(
+		ctx,
+		rs.l.With("kind", monitoringv1alpha1.RemoteWritesKind),
+		rs,
+		monitoringv1alpha1.RemoteWritesKind,
+		cpf.RemoteWriteSelector,
+		cpf.RemoteWriteNamespaceSelector,
+		listFn,
+		func(ctx context.Context, rw *monitoringv1alpha1.RemoteWrite) error {
+			return rs.checkRemoteWrite(ctx, cg, rw)
+		},
+	)
+}
+
+// checkRemoteWrite verifies that the RemoteWrite object is valid.
+func (rs *ResourceSelector) checkRemoteWrite(ctx context.Context, cg *ConfigGenerator, rw *monitoringv1alpha1.RemoteWrite) error {
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if rw.Spec.BearerToken != "" {
+		return errors.New("bearerToken: not supported, use authorization instead")
+	}
+
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if rw.Spec.BearerTokenFile != "" {
+		return errors.New("bearerTokenFile: it accesses file system which is not allowed for RemoteWrite resources")
+	}
+
+	if tlsConf := rw.Spec.TLSConfig; tlsConf != nil {
+		if tlsConf.CAFile != "" || tlsConf.CertFile != "" || tlsConf.KeyFile != "" {
+			return errors.New("tlsConfig: it accesses file system which is not allowed for RemoteWrite resources")
+		}
+	}
+
+	if authz := rw.Spec.Authorization; authz != nil && authz.CredentialsFile != "" {
+		return errors.New("authorization: credentialsFile accesses file system which is not allowed for RemoteWrite resources")
+	}
+
+	// The authentication methods relying on the identity of the Prometheus
+	// pod would let any namespace write with the Prometheus credentials.
+	if azureAD := rw.Spec.AzureAD; azureAD != nil {
+		switch {
+		case azureAD.ManagedIdentity != nil:
+			return errors.New("azureAd: managedIdentity uses the Prometheus identity which is not allowed for RemoteWrite resources")
+		case azureAD.SDK != nil:
+			return errors.New("azureAd: sdk uses the Prometheus identity which is not allowed for RemoteWrite resources")
+		case azureAD.WorkloadIdentity != nil:
+			return errors.New("azureAd: workloadIdentity uses the Prometheus identity which is not allowed for RemoteWrite resources")
+		}
+	}
+
+	if sigv4 := rw.Spec.Sigv4; sigv4 != nil {
+		if sigv4.AccessKey == nil || sigv4.SecretKey == nil {
+			return errors.New("sigv4: accessKey and secretKey are required for RemoteWrite resources")
+		}
+
+		if sigv4.Profile != "" {
+			return errors.New("sigv4: profile accesses file system which is not allowed for RemoteWrite resources")
+		}
+	}
+
+	if err := rs.ValidateRelabelConfigs(rw.Spec.WriteRelabelConfigs); err != nil {
+		return fmt.Errorf("writeRelabelConfigs: %w", err)
+	}
+
+	if err := cg.validateRemoteWriteSpec(rw.Spec); err != nil {
+		return err
+	}
+
+	return addRemoteWritesToStore(ctx, rs.store, rw.GetNamespace(), rw.Spec)
+}
+
 // SelectScrapeConfigs returns the ScrapeConfigs which match the selectors in the
 // Prometheus CR and filters them returning all the configuration.
 func (rs *ResourceSelector) SelectScrapeConfigs(ctx context.Context, listFn ListAllByNamespaceFn) (operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig], error) {
@@ -600,7 +776,7 @@ func (rs *ResourceSelector) checkScrapeConfig(ctx context.Context, sc *monitorin
 		scrapeTimeout = *sc.Spec.ScrapeTimeout
 	}
 
-	if err := validateScrapeIntervalAndTimeout(rs.p, scrapeInterval, scrapeTimeout); err != nil {
+	if err := validateScrapeIntervalAndTimeout(rs.p, sc.Spec.ScrapeClassName, scrapeInterval, scrapeTimeout); err != nil {
 		return err
 	}
 
@@ -1372,3 +1548,36 @@ func (rs *ResourceSelector) validateIonosSDConfigs(ctx context.Context, sc *moni
 	}
 	return nil
 }
+
+// NewWorkloadDebugInfo returns the debug information of the configuration
+// resources selected by a Prometheus or PrometheusAgent object. Nil
+// selections (e.g. when the operator doesn't manage the resource) are
+// omitted.
+func NewWorkloadDebugInfo(
+	rs *ResourceSelector,
+	smons operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor],
+	pmons operator.TypedResourcesSelection[*monitoringv1.PodMonitor],
+	probes operator.TypedResourcesSelection[*monitoringv1.Probe],
+	scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig],
+	remoteWrites operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite],
+) operator.WorkloadDebugInfo {
+	info := operator.WorkloadDebugInfo{
+		LastReconciliation: time.Now(),
+		Namespaces:         maps.Clone(rs.SelectedNamespaces()),
+		Resources: map[string][]operator.ResourceDebugInfo{
+			monitoringv1.ServiceMonitorsKind: operator.NewResourcesDebugInfo(smons),
+			monitoringv1.PodMonitorsKind:     operator.NewResourcesDebugInfo(pmons),
+			monitoringv1.ProbesKind:          operator.NewResourcesDebugInfo(probes),
+		},
+	}
+
+	if scrapeConfigs != nil {
+		info.Resources[monitoringv1alpha1.ScrapeConfigsKind] = operator.NewResourcesDebugInfo(scrapeConfigs)
+	}
+
+	if remoteWrites != nil {
+		info.Resources[monitoringv1alpha1.RemoteWritesKind] = operator.NewResourcesDebugInfo(remoteWrites)
+	}
+
+	return info
+}
@@ -23,8 +23,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -597,10 +600,60 @@ func TestValidateScrapeIntervalAndTimeout(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			scenario: "only scrape timeout specified at service monitor spec but invalid compared to the default scrape class interval",
+			prometheus: monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeInterval: "30s",
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:           "high-frequency",
+								Default:        ptr.To(true),
+								ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+							},
+						},
+					},
+				},
+			},
+			smSpec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						ScrapeTimeout: "20s",
+					},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			scenario: "only scrape timeout specified at service monitor spec and valid compared to the scrape class interval",
+			prometheus: monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						ScrapeInterval: "30s",
+						ScrapeClasses: []monitoringv1.ScrapeClass{
+							{
+								Name:           "low-frequency",
+								ScrapeInterval: ptr.To(monitoringv1.Duration("60s")),
+							},
+						},
+					},
+				},
+			},
+			smSpec: monitoringv1.ServiceMonitorSpec{
+				ScrapeClassName: ptr.To("low-frequency"),
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						ScrapeTimeout: "45s",
+					},
+				},
+			},
+			expectedErr: false,
+		},
 	} {
 		t.Run(tc.scenario, func(t *testing.T) {
 			for _, endpoint := range tc.smSpec.Endpoints {
-				err := validateScrapeIntervalAndTimeout(&tc.prometheus, endpoint.Interval, endpoint.ScrapeTimeout)
+				err := validateScrapeIntervalAndTimeout(&tc.prometheus, tc.smSpec.ScrapeClassName, endpoint.Interval, endpoint.ScrapeTimeout)
 				t.Logf("err %v", err)
 				if tc.expectedErr {
 					require.Error(t, err)
@@ -612,6 +665,252 @@ func TestValidateScrapeIntervalAndTimeout(t *testing.T) {
 	}
 }
 
+func newGateway(namespace, name string, listeners ...any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "Gateway",
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]any{
+				"listeners": listeners,
+			},
+		},
+	}
+}
+
+func newHTTPRoute(namespace, name string, spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata": map[string]any{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestSelectProbesWithHTTPRouteTargets(t *testing.T) {
+	gateways := map[string]*unstructured.Unstructured{
+		"infra/public": newGateway("infra", "public",
+			map[string]any{"name": "https", "protocol": "HTTPS", "port": int64(443), "hostname": "*.example.com"},
+			map[string]any{"name": "http", "protocol": "HTTP", "port": int64(8080)},
+			map[string]any{"name": "tls", "protocol": "TLS", "port": int64(8443)},
+		),
+	}
+
+	for _, tc := range []struct {
+		scenario                 string
+		routes                   []*unstructured.Unstructured
+		nsSelector               monitoringv1.NamespaceSelector
+		ignoreNamespaceSelectors bool
+		noDiscovery              bool
+		valid                    bool
+		expectedURLs             map[string][]string
+		notFound                 []string
+	}{
+		{
+			scenario: "hostnames and path matches",
+			routes: []*unstructured.Unstructured{
+				newHTTPRoute("test", "frontend", map[string]any{
+					"parentRefs": []any{
+						map[string]any{"name": "public", "namespace": "infra", "sectionName": "https"},
+					},
+					"hostnames": []any{"www.example.com", "www.other.org", "*.example.com"},
+					"rules": []any{
+						map[string]any{
+							"matches": []any{
+								map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/"}},
+								map[string]any{"path": map[string]any{"type": "Exact", "value": "/login"}},
+								map[string]any{"path": map[string]any{"type": "RegularExpression", "value": "/api/.*"}},
+							},
+						},
+					},
+				}),
+			},
+			valid: true,
+			expectedURLs: map[string][]string{
+				"test/frontend": {"https://www.example.com/", "https://www.example.com/login"},
+			},
+		},
+		{
+			scenario: "listener port and no path match",
+			routes: []*unstructured.Unstructured{
+				newHTTPRoute("test", "backend", map[string]any{
+					"parentRefs": []any{
+						map[string]any{"name": "public", "namespace": "infra", "port": int64(8080)},
+					},
+					"hostnames": []any{"api.example.org"},
+				}),
+			},
+			valid: true,
+			expectedURLs: map[string][]string{
+				"test/backend": {"http://api.example.org:8080/"},
+			},
+		},
+		{
+			scenario: "all listeners",
+			routes: []*unstructured.Unstructured{
+				newHTTPRoute("test", "backend", map[string]any{
+					"parentRefs": []any{
+						map[string]any{"name": "public", "namespace": "infra"},
+					},
+					"hostnames": []any{"api.example.com"},
+					"rules": []any{
+						map[string]any{
+							"matches": []any{
+								map[string]any{"path": map[string]any{"value": "/healthz"}},
+							},
+						},
+					},
+				}),
+			},
+			valid: true,
+			expectedURLs: map[string][]string{
+				"test/backend": {"http://api.example.com:8080/healthz", "https://api.example.com/healthz"},
+			},
+		},
+		{
+			scenario: "missing gateway and non-gateway parent",
+			routes: []*unstructured.Unstructured{
+				newHTTPRoute("test", "backend", map[string]any{
+					"parentRefs": []any{
+						map[string]any{"name": "public"},
+						map[string]any{"name": "svc", "group": "", "kind": "Service"},
+					},
+					"hostnames": []any{"api.example.com"},
+				}),
+			},
+			valid:        true,
+			expectedURLs: map[string][]string{},
+			notFound:     []string{"test/public"},
+		},
+		{
+			scenario: "any namespace",
+			routes: []*unstructured.Unstructured{
+				newHTTPRoute("other", "backend", map[string]any{
+					"parentRefs": []any{
+						map[string]any{"name": "public", "namespace": "infra", "port": int64(8080)},
+					},
+					"hostnames": []any{"api.example.org"},
+				}),
+			},
+			nsSelector: monitoringv1.NamespaceSelector{Any: true},
+			valid:      true,
+			expectedURLs: map[string][]string{
+				"other/backend": {"http://api.example.org:8080/"},
+			},
+		},
+		{
+			scenario: "any namespace with ignoreNamespaceSelectors",
+			routes: []*unstructured.Unstructured{
+				newHTTPRoute("other", "backend", map[string]any{
+					"parentRefs": []any{
+						map[string]any{"name": "public", "namespace": "infra", "port": int64(8080)},
+					},
+					"hostnames": []any{"api.example.org"},
+				}),
+			},
+			nsSelector:               monitoringv1.NamespaceSelector{Any: true},
+			ignoreNamespaceSelectors: true,
+			valid:                    true,
+			expectedURLs:             map[string][]string{},
+		},
+		{
+			scenario:    "HTTPRoute discovery not enabled",
+			noDiscovery: true,
+			valid:       false,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						IgnoreNamespaceSelectors: tc.ignoreNamespaceSelectors,
+					},
+				},
+			}
+
+			listHTTPRoutes := func(ns string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				for _, r := range tc.routes {
+					if ns == metav1.NamespaceAll || r.GetNamespace() == ns {
+						appendFn(r)
+					}
+				}
+				return nil
+			}
+			getGateway := func(key string) (runtime.Object, error) {
+				gw, found := gateways[key]
+				if !found {
+					return nil, apierrors.NewNotFound(GatewayGVR.GroupResource(), key)
+				}
+				return gw, nil
+			}
+
+			var opts []ResourceSelectorOption
+			if !tc.noDiscovery {
+				opts = append(opts, WithHTTPRouteDiscovery(listHTTPRoutes, getGateway))
+			}
+
+			rs, err := NewResourceSelector(
+				newLogger(),
+				p,
+				assets.NewTestStoreBuilder(),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				operator.NewFakeRecorder(1, p),
+				opts...,
+			)
+			require.NoError(t, err)
+
+			probe := &monitoringv1.Probe{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.ProbeSpec{
+					ProberSpec: monitoringv1.ProberSpec{
+						URL: "example.com:80",
+					},
+					Targets: monitoringv1.ProbeTargets{
+						HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{
+							NamespaceSelector: tc.nsSelector,
+						},
+					},
+				},
+			}
+
+			probes, err := rs.SelectProbes(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				appendFn(probe)
+				return nil
+			})
+			require.NoError(t, err)
+
+			require.Len(t, probes, 1)
+			if !tc.valid {
+				require.Len(t, probes.ValidResources(), 0)
+				return
+			}
+			require.Len(t, probes.ValidResources(), 1)
+
+			urls := map[string][]string{}
+			for _, target := range rs.ProbeHTTPRouteTargets()["test/test"] {
+				urls[target.Namespace+"/"+target.Name] = target.URLs
+			}
+			require.Equal(t, tc.expectedURLs, urls)
+
+			_, notFound, err := selectHTTPRouteTargets(probe, tc.ignoreNamespaceSelectors, listHTTPRoutes, getGateway)
+			require.NoError(t, err)
+			require.Equal(t, tc.notFound, notFound)
+		})
+	}
+}
+
 func TestSelectServiceMonitors(t *testing.T) {
 	ca, err := os.ReadFile(certsDir + "ca.crt")
 	require.NoError(t, err)
@@ -4824,6 +5123,316 @@ func TestSelectScrapeConfigs(t *testing.T) {
 	}
 }
 
+func TestSelectRemoteWrites(t *testing.T) {
+	for _, tc := range []struct {
+		scenario    string
+		updateSpec  func(*monitoringv1.RemoteWriteSpec)
+		valid       bool
+		promVersion string
+	}{
+		{
+			scenario:   "minimal config",
+			updateSpec: func(*monitoringv1.RemoteWriteSpec) {},
+			valid:      true,
+		},
+		{
+			scenario: "valid write relabeling config",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
+					{
+						Action:       "Drop",
+						SourceLabels: []monitoringv1.LabelName{"__name__"},
+						Regex:        "go_.*",
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "invalid write relabeling config",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
+					{
+						Action:      "Replace",
+						TargetLabel: " invalid label name",
+					},
+				}
+			},
+			promVersion: "2.55.0",
+			valid:       false,
+		},
+		{
+			scenario: "valid basic auth",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.BasicAuth = &monitoringv1.BasicAuth{
+					Username: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key1",
+					},
+					Password: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key2",
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "basic auth with missing secret key",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.BasicAuth = &monitoringv1.BasicAuth{
+					Username: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "invalid",
+					},
+					Password: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key2",
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "basic auth and authorization",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.BasicAuth = &monitoringv1.BasicAuth{
+					Username: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key1",
+					},
+					Password: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key2",
+					},
+				}
+				rw.Authorization = &monitoringv1.Authorization{
+					SafeAuthorization: monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+							Key:                  "key1",
+						},
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "bearer token",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.BearerToken = "secret"
+			},
+			valid: false,
+		},
+		{
+			scenario: "bearer token file",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.BearerTokenFile = "/etc/token"
+			},
+			valid: false,
+		},
+		{
+			scenario: "TLS config with file",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.TLSConfig = &monitoringv1.TLSConfig{
+					TLSFilesConfig: monitoringv1.TLSFilesConfig{
+						CAFile: "/etc/ca.crt",
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "azureAD workload identity with unsupported version",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.AzureAD = &monitoringv1.AzureAD{
+					WorkloadIdentity: &monitoringv1.AzureWorkloadIdentity{
+						ClientID: "00000000-0000-0000-0000-000000000000",
+						TenantID: "00000000-0000-0000-0000-000000000000",
+					},
+				}
+			},
+			promVersion: "3.6.0",
+			valid:       false,
+		},
+		{
+			scenario: "authorization with credentials file",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.Authorization = &monitoringv1.Authorization{
+					CredentialsFile: "/etc/token",
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "azureAD oauth",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.AzureAD = &monitoringv1.AzureAD{
+					OAuth: &monitoringv1.AzureOAuth{
+						ClientID: "00000000-0000-0000-0000-000000000000",
+						TenantID: "00000000-0000-0000-0000-000000000000",
+						ClientSecret: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+							Key:                  "key1",
+						},
+					},
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "azureAD managed identity",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.AzureAD = &monitoringv1.AzureAD{
+					ManagedIdentity: &monitoringv1.ManagedIdentity{
+						ClientID: new("00000000-0000-0000-0000-000000000000"),
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "azureAD sdk",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.AzureAD = &monitoringv1.AzureAD{
+					SDK: &monitoringv1.AzureSDK{},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "azureAD workload identity",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.AzureAD = &monitoringv1.AzureAD{
+					WorkloadIdentity: &monitoringv1.AzureWorkloadIdentity{
+						ClientID: "00000000-0000-0000-0000-000000000000",
+						TenantID: "00000000-0000-0000-0000-000000000000",
+					},
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "sigv4 with access and secret keys",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.Sigv4 = &monitoringv1.Sigv4{
+					Region: "us-east-1",
+					AccessKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key1",
+					},
+					SecretKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key2",
+					},
+					RoleArn: "arn:aws:iam::123456789012:role/remote-write",
+				}
+			},
+			valid: true,
+		},
+		{
+			scenario: "sigv4 without access and secret keys",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.Sigv4 = &monitoringv1.Sigv4{
+					Region: "us-east-1",
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "sigv4 with role ARN only",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.Sigv4 = &monitoringv1.Sigv4{
+					Region:  "us-east-1",
+					RoleArn: "arn:aws:iam::123456789012:role/remote-write",
+				}
+			},
+			valid: false,
+		},
+		{
+			scenario: "sigv4 with profile",
+			updateSpec: func(rw *monitoringv1.RemoteWriteSpec) {
+				rw.Sigv4 = &monitoringv1.Sigv4{
+					Region: "us-east-1",
+					AccessKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key1",
+					},
+					SecretKey: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "secret"},
+						Key:                  "key2",
+					},
+					Profile: "default",
+				}
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.scenario, func(t *testing.T) {
+			cs := fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "secret",
+						Namespace: "test",
+					},
+					Data: map[string][]byte{
+						"key1": []byte("val1"),
+						"key2": []byte("val2"),
+					},
+				},
+			)
+
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Version: tc.promVersion,
+					},
+				},
+			}
+			rs, err := NewResourceSelector(
+				newLogger(),
+				p,
+				assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1()),
+				nil,
+				operator.NewMetrics(prometheus.NewPedanticRegistry()),
+				operator.NewFakeRecorder(1, p),
+			)
+			require.NoError(t, err)
+
+			rw := &monitoringv1alpha1.RemoteWrite{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "test",
+				},
+				Spec: monitoringv1.RemoteWriteSpec{
+					URL: "http://example.com/api/v1/write",
+				},
+			}
+
+			tc.updateSpec(&rw.Spec)
+
+			rws, err := rs.SelectRemoteWrites(context.Background(), func(_ string, _ labels.Selector, appendFn cache.AppendFunc) error {
+				appendFn(rw)
+				return nil
+			})
+
+			require.NoError(t, err)
+			require.Len(t, rws, 1)
+
+			valid := rws.ValidResources()
+			if tc.valid {
+				require.Len(t, valid, 1)
+			} else {
+				require.Empty(t, valid)
+			}
+		})
+	}
+}
+
 func TestSelectPodMonitorsWithInvalidAuthentication(t *testing.T) {
 	storeBuilder := assets.NewTestStoreBuilder(
 		&corev1.Secret{
@@ -0,0 +1,222 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// ResourceValidator verifies ServiceMonitor, PodMonitor, Probe and
+// ScrapeConfig objects independently of the Prometheus objects selecting
+// them (for instance in the admission webhook).
+//
+// It runs the same checks as the ResourceSelector against the default
+// Prometheus version. The checks which depend on the selecting Prometheus
+// object (e.g. the scrape class lookup) or on the referenced Secrets and
+// ConfigMaps can't be performed and they are returned as warnings instead.
+type ResourceValidator struct {
+	logger *slog.Logger
+}
+
+// NewResourceValidator returns a new ResourceValidator.
+func NewResourceValidator(logger *slog.Logger) *ResourceValidator {
+	return &ResourceValidator{logger: logger}
+}
+
+// ValidateServiceMonitor returns an error if the ServiceMonitor object is
+// invalid.
+func (rv *ResourceValidator) ValidateServiceMonitor(ctx context.Context, sm *monitoringv1.ServiceMonitor) ([]string, error) {
+	var (
+		w        = newValidationWarnings(sm.Spec.ScrapeClassName)
+		timeouts []monitoringv1.Duration
+	)
+
+	for i, endpoint := range sm.Spec.Endpoints {
+		if err := testForArbitraryFSAccess(endpoint); err != nil {
+			w.add("endpoints[%d]: %v: the resource will be rejected by Prometheus objects with arbitraryFSAccessThroughSMs.deny enabled", i, err)
+		}
+
+		if endpoint.Interval == "" && endpoint.ScrapeTimeout != "" {
+			w.add("endpoints[%d]: scrapeTimeout %q must not be greater than the scrape interval of the selecting Prometheus objects", i, endpoint.ScrapeTimeout)
+			timeouts = append(timeouts, endpoint.ScrapeTimeout)
+		}
+	}
+
+	rs, store, err := rv.newResourceSelector(sm.Spec.ScrapeClassName, timeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.checkServiceMonitor(ctx, sm)
+
+	return w.finalize(store), err
+}
+
+// ValidatePodMonitor returns an error if the PodMonitor object is invalid.
+func (rv *ResourceValidator) ValidatePodMonitor(ctx context.Context, pm *monitoringv1.PodMonitor) ([]string, error) {
+	var (
+		w        = newValidationWarnings(pm.Spec.ScrapeClassName)
+		timeouts []monitoringv1.Duration
+	)
+
+	for i, endpoint := range pm.Spec.PodMetricsEndpoints {
+		if endpoint.Interval == "" && endpoint.ScrapeTimeout != "" {
+			w.add("podMetricsEndpoints[%d]: scrapeTimeout %q must not be greater than the scrape interval of the selecting Prometheus objects", i, endpoint.ScrapeTimeout)
+			timeouts = append(timeouts, endpoint.ScrapeTimeout)
+		}
+	}
+
+	rs, store, err := rv.newResourceSelector(pm.Spec.ScrapeClassName, timeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.checkPodMonitor(ctx, pm)
+
+	return w.finalize(store), err
+}
+
+// ValidateProbe returns an error if the Probe object is invalid.
+func (rv *ResourceValidator) ValidateProbe(ctx context.Context, probe *monitoringv1.Probe) ([]string, error) {
+	var (
+		w        = newValidationWarnings(probe.Spec.ScrapeClassName)
+		timeouts []monitoringv1.Duration
+	)
+
+	if probe.Spec.Interval == "" && probe.Spec.ScrapeTimeout != "" {
+		w.add("scrapeTimeout %q must not be greater than the scrape interval of the selecting Prometheus objects", probe.Spec.ScrapeTimeout)
+		timeouts = append(timeouts, probe.Spec.ScrapeTimeout)
+	}
+
+	rs, store, err := rv.newResourceSelector(probe.Spec.ScrapeClassName, timeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.checkProbe(ctx, probe)
+
+	return w.finalize(store), err
+}
+
+// ValidateScrapeConfig returns an error if the ScrapeConfig object is
+// invalid.
+func (rv *ResourceValidator) ValidateScrapeConfig(ctx context.Context, sc *monitoringv1alpha1.ScrapeConfig) ([]string, error) {
+	var (
+		w        = newValidationWarnings(sc.Spec.ScrapeClassName)
+		timeouts []monitoringv1.Duration
+	)
+
+	if sc.Spec.ScrapeInterval == nil && sc.Spec.ScrapeTimeout != nil {
+		w.add("scrapeTimeout %q must not be greater than the scrape interval of the selecting Prometheus objects", *sc.Spec.ScrapeTimeout)
+		timeouts = append(timeouts, *sc.Spec.ScrapeTimeout)
+	}
+
+	rs, store, err := rv.newResourceSelector(sc.Spec.ScrapeClassName, timeouts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rs.checkScrapeConfig(ctx, sc)
+
+	return w.finalize(store), err
+}
+
+// newResourceSelector returns a ResourceSelector backed by a Prometheus
+// object which defines the given scrape class and whose scrape interval is
+// greater than or equal to the given timeouts.
+// The selector's store doesn't fetch the referenced Secrets and ConfigMaps.
+func (rv *ResourceValidator) newResourceSelector(scrapeClass *string, timeouts []monitoringv1.Duration) (*ResourceSelector, *assets.StoreBuilder, error) {
+	p := &monitoringv1.Prometheus{}
+
+	if name := ptr.Deref(scrapeClass, ""); name != "" {
+		p.Spec.ScrapeClasses = []monitoringv1.ScrapeClass{{Name: name}}
+	}
+
+	// The scrape timeouts can't be compared to the unknown scrape interval
+	// of the Prometheus object: choose the largest timeout to pass the check.
+	var maxTimeout model.Duration
+	for _, t := range timeouts {
+		d, err := model.ParseDuration(string(t))
+		if err != nil {
+			// The error is reported by the check function.
+			continue
+		}
+
+		if d > maxTimeout {
+			maxTimeout = d
+			p.Spec.ScrapeInterval = t
+		}
+	}
+
+	store := assets.NewOfflineStoreBuilder()
+	rs, err := NewResourceSelector(
+		rv.logger,
+		p,
+		store,
+		nil,
+		nil,
+		nil,
+		// The HTTPRoute objects can only be resolved by the operator.
+		WithHTTPRouteDiscovery(
+			func(string, labels.Selector, cache.AppendFunc) error { return nil },
+			func(string) (runtime.Object, error) { return nil, nil },
+		),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rs, store, nil
+}
+
+type validationWarnings struct {
+	warnings []string
+}
+
+func newValidationWarnings(scrapeClass *string) *validationWarnings {
+	w := &validationWarnings{}
+
+	if name := ptr.Deref(scrapeClass, ""); name != "" {
+		w.add("scrapeClassName: the scrape class %q must be defined by the selecting Prometheus objects", name)
+	}
+
+	return w
+}
+
+func (w *validationWarnings) add(format string, a ...any) {
+	w.warnings = append(w.warnings, fmt.Sprintf(format, a...))
+}
+
+// finalize returns the warnings including the references which haven't been
+// resolved by the store.
+func (w *validationWarnings) finalize(store *assets.StoreBuilder) []string {
+	for _, ref := range store.UnresolvedReferences() {
+		w.add("%s must exist", ref)
+	}
+
+	return w.warnings
+}
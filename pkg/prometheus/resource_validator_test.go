@@ -0,0 +1,267 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+)
+
+func TestValidateServiceMonitor(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.ServiceMonitorSpec
+		err      bool
+		warnings []string
+	}{
+		{
+			name: "valid",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{Port: "web"}},
+			},
+		},
+		{
+			name: "invalid relabel config",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Port: "web",
+						RelabelConfigs: []monitoringv1.RelabelConfig{
+							{
+								Action: "replace",
+								Regex:  "(",
+							},
+						},
+					},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "scrape timeout greater than interval",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Port:          "web",
+						Interval:      "10s",
+						ScrapeTimeout: "20s",
+					},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "scrape timeout without interval",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Port:          "web",
+						ScrapeTimeout: "2m",
+					},
+				},
+			},
+			warnings: []string{
+				`endpoints[0]: scrapeTimeout "2m" must not be greater than the scrape interval of the selecting Prometheus objects`,
+			},
+		},
+		{
+			name: "scrape class and secret references",
+			spec: monitoringv1.ServiceMonitorSpec{
+				ScrapeClassName: ptr.To("default"),
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Port: "web",
+						HTTPConfigWithProxyAndTLSFiles: monitoringv1.HTTPConfigWithProxyAndTLSFiles{
+							HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
+								HTTPConfigWithoutTLS: monitoringv1.HTTPConfigWithoutTLS{
+									BasicAuth: &monitoringv1.BasicAuth{
+										Username: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "auth"},
+											Key:                  "user",
+										},
+										Password: corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: "auth"},
+											Key:                  "password",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			warnings: []string{
+				`scrapeClassName: the scrape class "default" must be defined by the selecting Prometheus objects`,
+				`secret ns/auth (key "password") must exist`,
+				`secret ns/auth (key "user") must exist`,
+			},
+		},
+		{
+			name: "file system access",
+			spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{
+					{
+						Port: "web",
+						HTTPConfigWithProxyAndTLSFiles: monitoringv1.HTTPConfigWithProxyAndTLSFiles{
+							HTTPConfigWithTLSFiles: monitoringv1.HTTPConfigWithTLSFiles{
+								TLSConfig: &monitoringv1.TLSConfig{
+									TLSFilesConfig: monitoringv1.TLSFilesConfig{
+										CAFile: "/etc/ca.crt",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			warnings: []string{
+				"endpoints[0]: it accesses file system via tls config which Prometheus specification prohibits: the resource will be rejected by Prometheus objects with arbitraryFSAccessThroughSMs.deny enabled",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := NewResourceValidator(newLogger()).ValidateServiceMonitor(
+				context.Background(),
+				&monitoringv1.ServiceMonitor{
+					ObjectMeta: metav1.ObjectMeta{Name: "sm", Namespace: "ns"},
+					Spec:       tc.spec,
+				},
+			)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.warnings, warnings)
+		})
+	}
+}
+
+func TestValidatePodMonitor(t *testing.T) {
+	rv := NewResourceValidator(newLogger())
+
+	_, err := rv.ValidatePodMonitor(context.Background(), &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "ns"},
+		Spec: monitoringv1.PodMonitorSpec{
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port: ptr.To("web"),
+					MetricRelabelConfigs: []monitoringv1.RelabelConfig{
+						{
+							Action: "labelmap",
+							Regex:  "(",
+						},
+					},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	warnings, err := rv.ValidatePodMonitor(context.Background(), &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{Name: "pm", Namespace: "ns"},
+		Spec: monitoringv1.PodMonitorSpec{
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port:          ptr.To("web"),
+					ScrapeTimeout: "10s",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+}
+
+func TestValidateProbe(t *testing.T) {
+	rv := NewResourceValidator(newLogger())
+
+	_, err := rv.ValidateProbe(context.Background(), &monitoringv1.Probe{
+		ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "ns"},
+		Spec: monitoringv1.ProbeSpec{
+			ProberSpec: monitoringv1.ProberSpec{
+				URL: "http://blackbox-exporter:9115",
+			},
+			Targets: monitoringv1.ProbeTargets{
+				StaticConfig: &monitoringv1.ProbeTargetStaticConfig{
+					Targets: []string{"example.com"},
+				},
+			},
+		},
+	})
+	require.Error(t, err)
+
+	warnings, err := rv.ValidateProbe(context.Background(), &monitoringv1.Probe{
+		ObjectMeta: metav1.ObjectMeta{Name: "probe", Namespace: "ns"},
+		Spec: monitoringv1.ProbeSpec{
+			ProberSpec: monitoringv1.ProberSpec{
+				URL: "blackbox-exporter:9115",
+			},
+			Targets: monitoringv1.ProbeTargets{
+				HTTPRoute: &monitoringv1.ProbeTargetHTTPRoute{},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, warnings)
+}
+
+func TestValidateScrapeConfig(t *testing.T) {
+	rv := NewResourceValidator(newLogger())
+
+	_, err := rv.ValidateScrapeConfig(context.Background(), &monitoringv1alpha1.ScrapeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "sc", Namespace: "ns"},
+		Spec: monitoringv1alpha1.ScrapeConfigSpec{
+			ScrapeInterval: ptr.To(monitoringv1.Duration("10s")),
+			ScrapeTimeout:  ptr.To(monitoringv1.Duration("20s")),
+		},
+	})
+	require.Error(t, err)
+
+	warnings, err := rv.ValidateScrapeConfig(context.Background(), &monitoringv1alpha1.ScrapeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "sc", Namespace: "ns"},
+		Spec: monitoringv1alpha1.ScrapeConfigSpec{
+			ScrapeClassName: ptr.To("default"),
+			HTTPSDConfigs: []monitoringv1alpha1.HTTPSDConfig{
+				{
+					URL: "http://example.com/targets",
+					Authorization: &monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "token"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t,
+		[]string{
+			`scrapeClassName: the scrape class "default" must be defined by the selecting Prometheus objects`,
+			`secret ns/token (key "token") must exist`,
+		},
+		warnings,
+	)
+}
@@ -15,12 +15,14 @@
 package prometheus
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -29,11 +31,13 @@ import (
 	"github.com/prometheus/common/model"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -81,7 +85,8 @@ type Operator struct {
 	accessor *operator.Accessor
 	config   prompkg.Config
 
-	controllerID string
+	controllerID  string
+	leaderElected <-chan struct{}
 
 	nsPromInf cache.SharedIndexInformer
 	nsMonInf  cache.SharedIndexInformer
@@ -91,25 +96,40 @@ type Operator struct {
 	pmonInfs  *informers.ForResource
 	probeInfs *informers.ForResource
 	sconInfs  *informers.ForResource
+	rwInfs    *informers.ForResource
 	ruleInfs  *informers.ForResource
 	cmapInfs  *informers.ForResource
 	secrInfs  *informers.ForResource
 	ssetInfs  *informers.ForResource
+	pdbInfs   *informers.ForResource
+
+	exposureSyncer *operator.ExposureSyncer
+
+	httpRouteInfs *informers.ForResource
+	gatewayInfs   *informers.ForResource
 
 	rr *operator.ResourceReconciler
 
 	metrics         *operator.Metrics
 	reconciliations *operator.ReconciliationTracker
 	statusReporter  *prompkg.StatusReporter
+	debugStore      *operator.DebugStore
 
 	endpointSliceSupported        bool
 	scrapeConfigSupported         bool
+	remoteWriteSupported          bool
+	httpRouteSupported            bool
 	canReadStorageClass           bool
+	volumeExpansionEnabled        bool
+	podDisruptionBudgetSupported  bool
+	ingressExposureSupported      bool
+	httpRouteExposureSupported    bool
 	disableUnmanagedConfiguration bool
 	retentionPoliciesEnabled      bool
 	configResourcesStatusEnabled  bool
 	topologyShardingEnabled       bool
 	podTopologyLabelsSupported    bool
+	runtimeHealthEnabled          bool
 
 	newEventRecorder operator.NewEventRecorderFunc
 	finalizerSyncer  *operator.FinalizerSyncer
@@ -117,14 +137,19 @@ type Operator struct {
 
 type ControllerOption func(*Operator)
 
-// selectedConfigResources return the configuration resources (serviceMonitors, podMonitors, probes, prometheusRules and scrapeConfigs)
+// selectedConfigResources return the configuration resources (serviceMonitors, podMonitors, probes, prometheusRules, scrapeConfigs and remoteWrites)
 // selected by Prometheus.
 type selectedConfigResources struct {
 	sMons         operator.TypedResourcesSelection[*monitoringv1.ServiceMonitor]
 	pMons         operator.TypedResourcesSelection[*monitoringv1.PodMonitor]
 	bMons         operator.TypedResourcesSelection[*monitoringv1.Probe]
 	scrapeConfigs operator.TypedResourcesSelection[*monitoringv1alpha1.ScrapeConfig]
+	remoteWrites  operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]
 	rules         operator.PrometheusRuleSelection
+
+	probeHTTPRouteTargets map[string][]prompkg.HTTPRouteTarget
+
+	debugInfo operator.WorkloadDebugInfo
 }
 
 func (s *selectedConfigResources) Len() int {
@@ -132,6 +157,7 @@ func (s *selectedConfigResources) Len() int {
 		len(s.pMons) +
 		len(s.bMons) +
 		len(s.scrapeConfigs) +
+		len(s.remoteWrites) +
 		s.rules.SelectedLen()
 }
 
@@ -149,6 +175,21 @@ func WithScrapeConfig() ControllerOption {
 	}
 }
 
+// WithRemoteWrite tells that the controller manages RemoteWrite objects.
+func WithRemoteWrite() ControllerOption {
+	return func(o *Operator) {
+		o.remoteWriteSupported = true
+	}
+}
+
+// WithHTTPRoute tells that the controller can discover probe targets from
+// Gateway API HTTPRoute objects.
+func WithHTTPRoute() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteSupported = true
+	}
+}
+
 // WithStorageClassValidation tells that the controller should verify that the
 // Prometheus spec references a valid StorageClass name.
 func WithStorageClassValidation() ControllerOption {
@@ -157,6 +198,46 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithVolumeExpansion tells that the controller should expand the persistent
+// volume claims when the storage size increases.
+func WithVolumeExpansion() ControllerOption {
+	return func(o *Operator) {
+		o.volumeExpansionEnabled = true
+	}
+}
+
+// WithPodDisruptionBudget tells that the controller can manage
+// PodDisruptionBudget objects.
+func WithPodDisruptionBudget() ControllerOption {
+	return func(o *Operator) {
+		o.podDisruptionBudgetSupported = true
+	}
+}
+
+// WithIngressExposure tells that the controller can manage Ingress objects
+// exposing the Prometheus pods.
+func WithIngressExposure() ControllerOption {
+	return func(o *Operator) {
+		o.ingressExposureSupported = true
+	}
+}
+
+// WithHTTPRouteExposure tells that the controller can manage Gateway API
+// HTTPRoute objects exposing the Prometheus pods.
+func WithHTTPRouteExposure() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteExposureSupported = true
+	}
+}
+
+// WithRuntimeHealth tells that the controller can query the HTTP API of the
+// pods to report their runtime health.
+func WithRuntimeHealth() ControllerOption {
+	return func(o *Operator) {
+		o.runtimeHealthEnabled = true
+	}
+}
+
 // WithoutUnmanagedConfiguration tells that the controller should not support
 // unmanaged configurations.
 func WithoutUnmanagedConfiguration() ControllerOption {
@@ -183,6 +264,15 @@ func WithPodTopologyLabels() ControllerOption {
 	}
 }
 
+// WithDebugStore tells that the controller should record the debug
+// information of the last reconciliation of the Prometheus objects in the
+// store. Without a store, the controller doesn't compute it.
+func WithDebugStore(ds *operator.DebugStore) ControllerOption {
+	return func(o *Operator) {
+		o.debugStore = ds
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, opts ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -218,19 +308,12 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		logger:   logger,
 		accessor: operator.NewAccessor(logger),
 
-		config: prompkg.Config{
-			LocalHost:                      c.LocalHost,
-			ReloaderConfig:                 c.ReloaderConfig,
-			PrometheusDefaultBaseImage:     c.PrometheusDefaultBaseImage,
-			ThanosDefaultBaseImage:         c.ThanosDefaultBaseImage,
-			Annotations:                    c.Annotations,
-			Labels:                         c.Labels,
-			WatchObjectRefsInAllNamespaces: c.WatchObjectRefsInAllNamespaces,
-		},
+		config:          newConfig(c),
 		metrics:         operator.NewMetrics(r),
 		reconciliations: &operator.ReconciliationTracker{},
 
 		controllerID:             c.ControllerID,
+		leaderElected:            c.LeaderElected,
 		newEventRecorder:         c.EventRecorderFactory(client, controllerName),
 		retentionPoliciesEnabled: c.Gates.Enabled(operator.PrometheusShardRetentionPolicyFeature),
 		topologyShardingEnabled:  c.Gates.Enabled(operator.PrometheusTopologyShardingFeature),
@@ -335,6 +418,53 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 			return nil, fmt.Errorf("error creating scrapeconfigs informers: %w", err)
 		}
 	}
+
+	if o.remoteWriteSupported {
+		o.rwInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating remotewrites informers: %w", err)
+		}
+	}
+
+	if o.httpRouteSupported {
+		o.httpRouteInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dclient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.HTTPRouteGVR,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating httproute informers: %w", err)
+		}
+
+		o.gatewayInfs, err = informers.NewInformersForResource(
+			informers.NewDynamicInformerFactories(
+				c.Namespaces.AllowList,
+				c.Namespaces.DenyList,
+				dclient,
+				resyncPeriod,
+				nil,
+			),
+			prompkg.GatewayGVR,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating gateway informers: %w", err)
+		}
+	}
+
 	o.ruleInfs, err = informers.NewInformersForResource(
 		informers.NewMonitoringInformerFactories(
 			c.Namespaces.AllowList,
@@ -405,6 +535,39 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
+	if o.podDisruptionBudgetSupported {
+		o.pdbInfs, err = informers.NewInformersForResourceWithTransform(
+			informers.NewMetadataInformerFactory(
+				c.Namespaces.PrometheusAllowList,
+				c.Namespaces.DenyList,
+				o.mdClient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = prompkg.LabelSelectorForStatefulSets(prometheusMode)
+				},
+			),
+			policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"),
+			informers.OwnedObjectMetadataStrip(operator.PodDisruptionBudgetGVK()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating poddisruptionbudget informers: %w", err)
+		}
+	}
+
+	o.exposureSyncer, err = operator.NewExposureSyncer(
+		o.kclient,
+		o.dclient,
+		o.mdClient,
+		c.Namespaces.PrometheusAllowList,
+		c.Namespaces.DenyList,
+		resyncPeriod,
+		o.ingressExposureSupported,
+		o.httpRouteExposureSupported,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating exposure syncer: %w", err)
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -440,35 +603,64 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		}
 	}
 
+	var statusReporterOpts []prompkg.StatusReporterOption
+	if o.runtimeHealthEnabled {
+		statusReporterOpts = append(statusReporterOpts, prompkg.WithHealthClient(prompkg.NewPodProxyHealthClient(o.kclient)))
+	}
+
 	o.statusReporter = prompkg.NewStatusReporter(
 		o.kclient,
 		o.reconciliations,
 		o.ssetInfs,
 		o.rr,
 		c.RepairPolicy,
+		statusReporterOpts...,
 	)
 
 	return o, nil
 }
 
+func newConfig(c operator.Config) prompkg.Config {
+	return prompkg.Config{
+		LocalHost:                      c.LocalHost,
+		ReloaderConfig:                 c.ReloaderConfig,
+		PrometheusDefaultBaseImage:     c.PrometheusDefaultBaseImage,
+		ThanosDefaultBaseImage:         c.ThanosDefaultBaseImage,
+		Annotations:                    c.Annotations,
+		Labels:                         c.Labels,
+		WatchObjectRefsInAllNamespaces: c.WatchObjectRefsInAllNamespaces,
+	}
+}
+
 // waitForCacheSync waits for the informers' caches to be synced.
 func (c *Operator) waitForCacheSync(ctx context.Context) error {
-	for _, infs := range []struct {
+	type namedInformers struct {
 		name                 string
 		informersForResource *informers.ForResource
-	}{
+	}
+
+	infsList := []namedInformers{
 		{"Prometheus", c.promInfs},
 		{"ServiceMonitor", c.smonInfs},
 		{"PodMonitor", c.pmonInfs},
 		{"PrometheusRule", c.ruleInfs},
 		{"Probe", c.probeInfs},
 		{"ScrapeConfig", c.sconInfs},
+		{"RemoteWrite", c.rwInfs},
+		{"HTTPRoute", c.httpRouteInfs},
+		{"Gateway", c.gatewayInfs},
 		{"ConfigMap", c.cmapInfs},
 		{"Secret", c.secrInfs},
 		{"StatefulSet", c.ssetInfs},
-	} {
+		{"PodDisruptionBudget", c.pdbInfs},
+	}
+	for name, infs := range c.exposureSyncer.Informers() {
+		infsList = append(infsList, namedInformers{name, infs})
+	}
+
+	for _, infs := range infsList {
 		// Skipping informers that were not started. If prerequisites for a CRD were not met, their informer will be
-		// nil. ScrapeConfig is one example.
+		// nil. ScrapeConfig, RemoteWrite and HTTPRoute are examples.
 		if infs.informersForResource == nil {
 			continue
 		}
@@ -560,6 +752,47 @@ func (c *Operator) addHandlers() {
 		))
 	}
 
+	if c.rwInfs != nil {
+		c.rwInfs.AddEventHandler(operator.NewEventHandler(
+			c.logger,
+			c.accessor,
+			c.metrics,
+			monitoringv1alpha1.RemoteWritesKind,
+			c.enqueueForNamespaceFunc(c.nsMonInf.GetStore()),
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
+	if c.httpRouteInfs != nil {
+		for _, infs := range []struct {
+			kind                 string
+			informersForResource *informers.ForResource
+			enqueueFunc          func(string)
+		}{
+			{"HTTPRoute", c.httpRouteInfs, c.enqueueForHTTPRouteNamespace},
+			{"Gateway", c.gatewayInfs, c.enqueueForGateway},
+		} {
+			infs.informersForResource.AddEventHandler(operator.NewEventHandler(
+				c.logger,
+				c.accessor,
+				c.metrics,
+				infs.kind,
+				infs.enqueueFunc,
+				operator.WithFilter(
+					operator.AnyFilter(
+						operator.GenerationChanged,
+						operator.LabelsChanged,
+					),
+				),
+			))
+		}
+	}
+
 	c.ruleInfs.AddEventHandler(operator.NewEventHandler(
 		c.logger,
 		c.accessor,
@@ -627,10 +860,21 @@ func (c *Operator) Run(ctx context.Context) error {
 	if c.scrapeConfigSupported {
 		go c.sconInfs.Start(ctx.Done())
 	}
+	if c.remoteWriteSupported {
+		go c.rwInfs.Start(ctx.Done())
+	}
+	if c.httpRouteSupported {
+		go c.httpRouteInfs.Start(ctx.Done())
+		go c.gatewayInfs.Start(ctx.Done())
+	}
 	go c.ruleInfs.Start(ctx.Done())
 	go c.cmapInfs.Start(ctx.Done())
 	go c.secrInfs.Start(ctx.Done())
 	go c.ssetInfs.Start(ctx.Done())
+	if c.podDisruptionBudgetSupported {
+		go c.pdbInfs.Start(ctx.Done())
+	}
+	c.exposureSyncer.Start(ctx.Done())
 	go c.nsMonInf.Run(ctx.Done())
 	if c.nsPromInf != c.nsMonInf {
 		go c.nsPromInf.Run(ctx.Done())
@@ -638,6 +882,13 @@ func (c *Operator) Run(ctx context.Context) error {
 	if err := c.waitForCacheSync(ctx); err != nil {
 		return err
 	}
+	c.metrics.Ready().Set(1)
+
+	// Wait for the leadership before reconciling the objects. The informers
+	// keep running in the meantime so that the caches are warm on failover.
+	if !operator.WaitForLeadership(ctx, c.leaderElected) {
+		return nil
+	}
 
 	// Refresh the status of the existing Prometheus objects.
 	_ = c.promInfs.ListAll(labels.Everything(), func(obj any) {
@@ -688,7 +939,6 @@ func (c *Operator) Run(ctx context.Context) error {
 		}()
 	}
 
-	c.metrics.Ready().Set(1)
 	<-ctx.Done()
 	return nil
 }
@@ -707,6 +957,104 @@ func (c *Operator) RefreshStatusFor(o metav1.Object) {
 	c.rr.EnqueueForStatus(o)
 }
 
+// enqueueForHTTPRouteNamespace enqueues the Prometheus objects which select
+// Probe objects discovering HTTPRoute targets in the given namespace.
+func (c *Operator) enqueueForHTTPRouteNamespace(nsName string) {
+	c.enqueueForHTTPRouteProbes(func(probe *monitoringv1.Probe) bool {
+		nsSelector := probe.Spec.Targets.HTTPRoute.NamespaceSelector
+		switch {
+		case nsSelector.Any:
+			return true
+		case len(nsSelector.MatchNames) > 0:
+			return slices.Contains(nsSelector.MatchNames, nsName)
+		default:
+			return probe.Namespace == nsName
+		}
+	})
+}
+
+// enqueueForGateway enqueues the Prometheus objects which select Probe
+// objects discovering HTTPRoute targets. HTTPRoutes can reference Gateways
+// from other namespaces hence the namespace of the Gateway isn't considered.
+func (c *Operator) enqueueForGateway(_ string) {
+	c.enqueueForHTTPRouteProbes(func(*monitoringv1.Probe) bool { return true })
+}
+
+// enqueueForHTTPRouteProbes enqueues the Prometheus objects which select at
+// least one Probe object with HTTPRoute targets for which filter returns true.
+func (c *Operator) enqueueForHTTPRouteProbes(filter func(*monitoringv1.Probe) bool) {
+	var probes []*monitoringv1.Probe
+	err := c.probeInfs.ListAll(labels.Everything(), func(obj any) {
+		probe := obj.(*monitoringv1.Probe)
+		if probe.Spec.Targets.HTTPRoute != nil && filter(probe) {
+			probes = append(probes, probe)
+		}
+	})
+	if err != nil {
+		c.logger.Error("failed to list Probe objects", "err", err)
+		return
+	}
+
+	if len(probes) == 0 {
+		return
+	}
+
+	err = c.promInfs.ListAll(labels.Everything(), func(obj any) {
+		p := obj.(*monitoringv1.Prometheus)
+		for _, probe := range probes {
+			if c.prometheusSelectsProbe(p, probe) {
+				c.rr.EnqueueForReconciliation(p)
+				return
+			}
+		}
+	})
+	if err != nil {
+		c.logger.Error("failed to list Prometheus objects", "err", err)
+	}
+}
+
+// prometheusSelectsProbe returns true if the Prometheus object selects the
+// given Probe object.
+func (c *Operator) prometheusSelectsProbe(p *monitoringv1.Prometheus, probe *monitoringv1.Probe) bool {
+	if p.Spec.ProbeSelector == nil {
+		return false
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.ProbeSelector)
+	if err != nil {
+		c.logger.Error(
+			fmt.Sprintf("failed to convert ProbeSelector of %q to selector", p.Name),
+			"err", err,
+		)
+		return false
+	}
+
+	if !selector.Matches(labels.Set(probe.Labels)) {
+		return false
+	}
+
+	// A nil namespace selector selects only the Prometheus namespace.
+	if p.Spec.ProbeNamespaceSelector == nil {
+		return probe.Namespace == p.Namespace
+	}
+
+	nsSelector, err := metav1.LabelSelectorAsSelector(p.Spec.ProbeNamespaceSelector)
+	if err != nil {
+		c.logger.Error(
+			fmt.Sprintf("failed to convert ProbeNamespaceSelector of %q to selector", p.Name),
+			"err", err,
+		)
+		return false
+	}
+
+	nsObject, found, err := c.nsMonInf.GetStore().GetByKey(probe.Namespace)
+	if err != nil || !found {
+		return false
+	}
+
+	return nsSelector.Matches(labels.Set(nsObject.(*corev1.Namespace).Labels))
+}
+
 func (c *Operator) enqueueForNamespaceFunc(gbk operator.GetByKeyer) func(string) {
 	return func(ns string) {
 		c.enqueueForNamespace(gbk, ns)
@@ -816,6 +1164,22 @@ func (c *Operator) enqueueForNamespace(gbk operator.GetByKeyer, nsName string) {
 			c.rr.EnqueueForReconciliation(p)
 			return
 		}
+
+		// Check for Prometheus instances selecting RemoteWrites in
+		// the NS.
+		remoteWriteNSSelector, err := metav1.LabelSelectorAsSelector(p.Spec.RemoteWriteNamespaceSelector)
+		if err != nil {
+			c.logger.Error(
+				fmt.Sprintf("failed to convert RemoteWriteNamespaceSelector of %q to selector", p.Name),
+				"err", err,
+			)
+			return
+		}
+
+		if remoteWriteNSSelector.Matches(labels.Set(ns.Labels)) {
+			c.rr.EnqueueForReconciliation(p)
+			return
+		}
 	})
 	if err != nil {
 		c.logger.Error(
@@ -842,7 +1206,7 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo any) {
 	c.metrics.TriggerByCounter("Namespace", operator.UpdateEvent).Inc()
 
 	// Check for Prometheus instances selecting ServiceMonitors, PodMonitors,
-	// Probes, PrometheusRules and ScrapeConfigs in the namespace.
+	// Probes, PrometheusRules, ScrapeConfigs and RemoteWrites in the namespace.
 	err := c.promInfs.ListAll(labels.Everything(), func(obj any) {
 		p := obj.(*monitoringv1.Prometheus)
 
@@ -850,6 +1214,7 @@ func (c *Operator) handleMonitorNamespaceUpdate(oldo, curo any) {
 			"PodMonitors":     p.Spec.PodMonitorNamespaceSelector,
 			"Probes":          p.Spec.ProbeNamespaceSelector,
 			"PrometheusRules": p.Spec.RuleNamespaceSelector,
+			"RemoteWrites":    p.Spec.RemoteWriteNamespaceSelector,
 			"ScrapeConfigs":   p.Spec.ScrapeConfigNamespaceSelector,
 			"ServiceMonitors": p.Spec.ServiceMonitorNamespaceSelector,
 		} {
@@ -906,6 +1271,8 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 
 	if p == nil {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(key)
+		c.statusReporter.ForgetObject(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return closure, nil
 	}
@@ -928,6 +1295,8 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 
 	if c.rr.DeletionInProgress(p) {
 		c.reconciliations.ForgetObject(key)
+		c.debugStore.ForgetObject(key)
+		c.statusReporter.ForgetObject(key)
 		return closure, nil
 	}
 
@@ -942,6 +1311,14 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, err
 	}
 
+	if p.Spec.PodDisruptionBudget != nil && !c.podDisruptionBudgetSupported {
+		return closure, errors.New("podDisruptionBudget: the operator isn't allowed to manage PodDisruptionBudget objects")
+	}
+
+	if err := c.exposureSyncer.Check(p.Spec.Exposure); err != nil {
+		return closure, err
+	}
+
 	assetStore := assets.NewStoreBuilder(c.kclient.CoreV1(), c.kclient.CoreV1())
 
 	// Select configuration resources.
@@ -956,6 +1333,11 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return c.updateConfigResourcesStatus(ctx, p, *resources)
 	}
 
+	// Record the debug information even if the reconciliation fails.
+	defer func() {
+		c.debugStore.Set(key, resources.debugInfo)
+	}()
+
 	if resources.Len() == 0 {
 		c.reconciliations.SetReasonAndMessage(key, operator.NoSelectedResourcesReason, noSelectedResourcesMessage)
 	}
@@ -964,6 +1346,7 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 	if err != nil {
 		return closure, err
 	}
+	resources.debugInfo.RuleConfigMaps = ruleConfigMapNames
 
 	opts := []prompkg.ConfigGeneratorOption{}
 	if c.endpointSliceSupported {
@@ -983,9 +1366,13 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, err
 	}
 
-	if err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, assetStore, resources); err != nil {
+	conf, err := c.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, assetStore, resources)
+	if err != nil {
 		return closure, fmt.Errorf("creating config failed: %w", err)
 	}
+	if c.debugStore != nil {
+		resources.debugInfo.Configuration = prompkg.RedactedConfig(logger, conf)
+	}
 	c.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
 
 	tlsAssets, err := operator.ReconcileShardedSecret(ctx, assetStore.TLSAssets(), c.kclient, prompkg.NewTLSAssetSecret(p, c.config))
@@ -1033,6 +1420,7 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 	}
 
 	ssetClient := c.kclient.AppsV1().StatefulSets(p.Namespace)
+	pdbClient := c.kclient.PolicyV1().PodDisruptionBudgets(p.Namespace)
 
 	// Reconcile all active statefulset shards.
 	expected := prompkg.ExpectedStatefulSetShardNames(p)
@@ -1083,6 +1471,13 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		}
 		operator.SanitizeSTS(sset)
 
+		if p.Spec.PodDisruptionBudget != nil {
+			pdb := operator.MakePodDisruptionBudget(sset, *p.Spec.PodDisruptionBudget, operator.WithManagingOwner(p))
+			if err := k8s.CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, pdb); err != nil {
+				return closure, fmt.Errorf("failed to synchronize PodDisruptionBudget: %w", err)
+			}
+		}
+
 		if notFound {
 			logger.Debug("creating statefulset")
 			if _, err := k8s.CreateStatefulSetOrPatchLabels(ctx, ssetClient, sset); err != nil {
@@ -1102,6 +1497,19 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 			"existing_hash", existingStatefulSet.Annotations[operator.InputHashAnnotationKey],
 		)
 
+		if c.volumeExpansionEnabled {
+			expanded, err := operator.ExpandStatefulSetVolumes(ctx, c.kclient, existingStatefulSet, sset)
+			if err != nil {
+				return closure, err
+			}
+
+			if expanded {
+				c.metrics.StsDeleteCreateCounter().Inc()
+				logger.Info("recreating StatefulSet because the persistent volume claims have been expanded")
+				continue
+			}
+		}
+
 		if err = k8s.ForceUpdateStatefulSet(ctx, ssetClient, sset, func(reason string) {
 			c.metrics.StsDeleteCreateCounter().Inc()
 			logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", reason)
@@ -1110,11 +1518,40 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		}
 	}
 
+	if err := c.exposureSyncer.Sync(ctx, makeExposedWorkload(p), p.Spec.Exposure,
+		operator.WithAnnotations(c.config.Annotations),
+		operator.WithLabels(c.config.Labels),
+		operator.WithOwner(p),
+	); err != nil {
+		return closure, err
+	}
+
 	ssets := map[string]struct{}{}
 	for _, ssetName := range expected {
 		ssets[ssetName] = struct{}{}
 	}
 
+	if c.podDisruptionBudgetSupported {
+		// Delete the PodDisruptionBudgets of the removed shards (or all of
+		// them if the PodDisruptionBudget isn't configured anymore).
+		keep := sets.New[string]()
+		if p.Spec.PodDisruptionBudget != nil {
+			keep.Insert(expected...)
+		}
+
+		var pdbs []metav1.Object
+		err := c.pdbInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prompkg.PrometheusNameLabelName: p.Name, prompkg.PrometheusModeLabelName: prometheusMode}), func(obj any) {
+			pdbs = append(pdbs, obj.(metav1.Object))
+		})
+		if err != nil {
+			return closure, fmt.Errorf("listing PodDisruptionBudget resources failed: %w", err)
+		}
+
+		if err := k8s.DeletePodDisruptionBudgets(ctx, pdbClient, p, pdbs, keep); err != nil {
+			return closure, err
+		}
+	}
+
 	var deleteErrs []error
 	err = c.ssetInfs.ListAllByNamespace(p.Namespace, labels.SelectorFromSet(labels.Set{prompkg.PrometheusNameLabelName: p.Name, prompkg.PrometheusModeLabelName: prometheusMode}), func(obj any) {
 		s := obj.(*appsv1.StatefulSet)
@@ -1156,7 +1593,7 @@ func (c *Operator) sync(ctx context.Context, key string) (func(context.Context)
 }
 
 // updateConfigResourcesStatus updates the status of the selected configuration
-// resources (ServiceMonitor, PodMonitor, ScrapeConfig and Probe).
+// resources (ServiceMonitor, PodMonitor, ScrapeConfig, RemoteWrite and Probe).
 func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitoringv1.Prometheus, resources selectedConfigResources) error {
 	if !c.configResourcesStatusEnabled {
 		return nil
@@ -1192,6 +1629,13 @@ func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitorin
 		}
 	}
 
+	// Update the status of selected remoteWrites.
+	for key, configResource := range resources.remoteWrites {
+		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
+			return fmt.Errorf("failed to update RemoteWrite %s status: %w", key, err)
+		}
+	}
+
 	// Update the status of selected prometheusRules.
 	for key, configResource := range resources.rules.Selected() {
 		if err := configResourceSyncer.UpdateBinding(ctx, configResource.Resource(), configResource.Conditions()); err != nil {
@@ -1220,6 +1664,15 @@ func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitorin
 		}
 	}
 
+	// Remove bindings from remoteWrites which reference the
+	// workload but aren't selected anymore.
+	// Only cleanup if RemoteWrite support is enabled (rwInfs is initialized).
+	if c.rwInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.rwInfs.ListAll, resources.remoteWrites, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for remoteWrites: %w", err)
+		}
+	}
+
 	// Remove bindings from probes which reference the
 	// workload but aren't selected anymore.
 	if err := operator.CleanupBindings(ctx, c.probeInfs.ListAll, resources.bMons, configResourceSyncer); err != nil {
@@ -1234,7 +1687,7 @@ func (c *Operator) updateConfigResourcesStatus(ctx context.Context, p *monitorin
 	return nil
 }
 
-// configResStatusCleanup removes prometheus bindings from the configuration resources (ServiceMonitor, PodMonitor, ScrapeConfig, PrometheusRule and PodMonitor).
+// configResStatusCleanup removes prometheus bindings from the configuration resources (ServiceMonitor, PodMonitor, ScrapeConfig, RemoteWrite, PrometheusRule and PodMonitor).
 func (c *Operator) configResStatusCleanup(ctx context.Context, p *monitoringv1.Prometheus) error {
 	if !c.configResourcesStatusEnabled {
 		return nil
@@ -1260,6 +1713,14 @@ func (c *Operator) configResStatusCleanup(ctx context.Context, p *monitoringv1.P
 		}
 	}
 
+	// Remove bindings from all remoteWrites which reference the workload.
+	// Only cleanup if RemoteWrite support is enabled (rwInfs is initialized).
+	if c.rwInfs != nil {
+		if err := operator.CleanupBindings(ctx, c.rwInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]{}, configResourceSyncer); err != nil {
+			return fmt.Errorf("failed to remove bindings for remoteWrites: %w", err)
+		}
+	}
+
 	// Remove bindings from all probes which reference the workload.
 	if err := operator.CleanupBindings(ctx, c.probeInfs.ListAll, operator.TypedResourcesSelection[*monitoringv1.Probe]{}, configResourceSyncer); err != nil {
 		return fmt.Errorf("failed to remove bindings for probes: %w", err)
@@ -1369,6 +1830,28 @@ func gracePeriodForPrometheusStorage(p *monitoringv1.Prometheus) (time.Duration,
 	return time.Duration(d), nil
 }
 
+// storageResizedCondition returns the StorageResized condition of the
+// Prometheus or nil if it shouldn't be reported.
+func (c *Operator) storageResizedCondition(ctx context.Context, p *monitoringv1.Prometheus, key string) (*monitoringv1.Condition, error) {
+	if !c.volumeExpansionEnabled {
+		return nil, nil
+	}
+
+	var ssets []*appsv1.StatefulSet
+	for shard := range prompkg.ExpectedStatefulSetShardNames(p) {
+		obj, err := c.ssetInfs.Get(prompkg.KeyToStatefulSetKey(p, key, shard))
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to retrieve statefulset: %w", err)
+		}
+		ssets = append(ssets, obj.(*appsv1.StatefulSet))
+	}
+
+	return operator.VolumeExpansionCondition(ctx, c.kclient, p.Spec.Storage, ssets, p.Status.Conditions, p.Generation), nil
+}
+
 // UpdateStatus updates the status subresource of the object identified by the given
 // key.
 // UpdateStatus implements the operator.Syncer interface.
@@ -1390,6 +1873,14 @@ func (c *Operator) UpdateStatus(ctx context.Context, key string) error {
 		return fmt.Errorf("failed to get prometheus status: %w", err)
 	}
 
+	cond, err := c.storageResizedCondition(ctx, p, key)
+	if err != nil {
+		return err
+	}
+	if cond != nil {
+		pStatus.Conditions = append(pStatus.Conditions, operator.UpdateConditions(p.Status.Conditions, *cond)...)
+	}
+
 	p.Status = *pStatus
 	selectorLabels := makeSelectorLabels(p.Name)
 	selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: selectorLabels})
@@ -1507,9 +1998,14 @@ func createSSetInputHash(p monitoringv1.Prometheus, c prompkg.Config, ruleConfig
 	return fmt.Sprintf("%d", hash), nil
 }
 
-// getSeletedConfigResources returns all the configuration resources (PodMonitor, ServiceMonitor, Probes and ScrapeConfigs) selected by the Prometheus.
+// getSeletedConfigResources returns all the configuration resources (PodMonitor, ServiceMonitor, Probes, ScrapeConfigs and RemoteWrites) selected by the Prometheus.
 func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, store *assets.StoreBuilder) (*selectedConfigResources, error) {
-	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p))
+	var rsOpts []prompkg.ResourceSelectorOption
+	if c.httpRouteInfs != nil {
+		rsOpts = append(rsOpts, prompkg.WithHTTPRouteDiscovery(c.httpRouteInfs.ListAllByNamespace, c.gatewayInfs.Get))
+	}
+
+	resourceSelector, err := prompkg.NewResourceSelector(logger, p, store, c.nsMonInf, c.metrics, c.newEventRecorder(p), rsOpts...)
 
 	if err != nil {
 		return nil, err
@@ -1537,21 +2033,39 @@ func (c *Operator) getSelectedConfigResources(ctx context.Context, logger *slog.
 		}
 	}
 
+	var remoteWrites operator.TypedResourcesSelection[*monitoringv1alpha1.RemoteWrite]
+	if c.rwInfs != nil {
+		remoteWrites, err = resourceSelector.SelectRemoteWrites(ctx, c.rwInfs.ListAllByNamespace)
+		if err != nil {
+			return nil, fmt.Errorf("selecting RemoteWrites failed: %w", err)
+		}
+	}
+
 	rules, err := c.selectPrometheusRules(p, logger)
 	if err != nil {
 		return nil, fmt.Errorf("selecting PrometheusRule failed: %w", err)
 	}
 
+	var debugInfo operator.WorkloadDebugInfo
+	if c.debugStore != nil {
+		debugInfo = prompkg.NewWorkloadDebugInfo(resourceSelector, smons, pmons, bmons, scrapeConfigs, remoteWrites)
+		debugInfo.Namespaces[monitoringv1.PrometheusRuleKind] = rules.Namespaces()
+		debugInfo.Resources[monitoringv1.PrometheusRuleKind] = operator.NewResourcesDebugInfo(rules.Selected())
+	}
+
 	return &selectedConfigResources{
-		sMons:         smons,
-		bMons:         bmons,
-		pMons:         pmons,
-		scrapeConfigs: scrapeConfigs,
-		rules:         rules,
+		sMons:                 smons,
+		bMons:                 bmons,
+		pMons:                 pmons,
+		scrapeConfigs:         scrapeConfigs,
+		remoteWrites:          remoteWrites,
+		rules:                 rules,
+		probeHTTPRouteTargets: resourceSelector.ProbeHTTPRouteTargets(),
+		debugInfo:             debugInfo,
 	}, nil
 }
 
-func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder, resources *selectedConfigResources) error {
+func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger *slog.Logger, p *monitoringv1.Prometheus, cg *prompkg.ConfigGenerator, ruleConfigMapNames []string, store *assets.StoreBuilder, resources *selectedConfigResources) ([]byte, error) {
 	// If no service/pod monitor and probe selectors are configured, the user
 	// wants to manage configuration themselves. Let's create an empty Secret
 	// if it doesn't exist.
@@ -1559,7 +2073,7 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		s, err := prompkg.MakeConfigurationSecret(p, c.config, nil)
 		if err != nil {
-			return fmt.Errorf("failed to generate empty configuration secret: %w", err)
+			return nil, fmt.Errorf("failed to generate empty configuration secret: %w", err)
 		}
 
 		sClient := c.kclient.CoreV1().Secrets(p.Namespace)
@@ -1567,25 +2081,25 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		if apierrors.IsNotFound(err) {
 			logger.Debug("creating an empty configuration secret")
 			if _, err := c.kclient.CoreV1().Secrets(p.Namespace).Create(ctx, s, metav1.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
-				return fmt.Errorf("failed to create an empty configuration secret: %w", err)
+				return nil, fmt.Errorf("failed to create an empty configuration secret: %w", err)
 			}
 
-			return nil
+			return nil, nil
 		}
 
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddRemoteReadsToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteRead); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := cg.AddRemoteWriteToStore(ctx, store, p.GetNamespace(), p.Spec.RemoteWrite); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := prompkg.AddAPIServerConfigToStore(ctx, store, p.GetNamespace(), p.Spec.APIServerConfig); err != nil {
-		return err
+		return nil, err
 	}
 
 	if p.Spec.Alerting != nil {
@@ -1593,40 +2107,41 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 
 		for i, am := range ams {
 			if err := validateAlertmanagerEndpoints(p, am); err != nil {
-				return fmt.Errorf("alertmanager %d: %w", i, err)
+				return nil, fmt.Errorf("alertmanager %d: %w", i, err)
 			}
 		}
 
 		if err := addAlertmanagerEndpointsToStore(ctx, store, p.GetNamespace(), ams); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if err := prompkg.AddScrapeClassesToStore(ctx, store, p.GetNamespace(), p.Spec.ScrapeClasses); err != nil {
-		return fmt.Errorf("failed to process scrape classes: %w", err)
+		return nil, fmt.Errorf("failed to process scrape classes: %w", err)
 	}
 
 	sClient := c.kclient.CoreV1().Secrets(p.Namespace)
 	additionalScrapeConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalScrapeConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional scrape configs from Secret failed: %w", err)
 	}
 	additionalAlertRelabelConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertRelabelConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert relabel configs from Secret failed: %w", err)
 	}
 	additionalAlertManagerConfigs, err := k8s.LoadSecretRef(ctx, logger, sClient, p.Spec.AdditionalAlertManagerConfigs)
 	if err != nil {
-		return fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
+		return nil, fmt.Errorf("loading additional alert manager configs from Secret failed: %w", err)
 	}
 
 	// Update secret based on the most recent configuration.
-	conf, err := cg.GenerateServerConfiguration(
+	conf, err := cg.WithProbeHTTPRouteTargets(resources.probeHTTPRouteTargets).GenerateServerConfiguration(
 		p,
 		resources.sMons.ValidResources(),
 		resources.pMons.ValidResources(),
 		resources.bMons.ValidResources(),
 		resources.scrapeConfigs.ValidResources(),
+		resources.remoteWrites.ValidResources(),
 		store,
 		additionalScrapeConfigs,
 		additionalAlertRelabelConfigs,
@@ -1634,17 +2149,17 @@ func (c *Operator) createOrUpdateConfigurationSecret(ctx context.Context, logger
 		ruleConfigMapNames,
 	)
 	if err != nil {
-		return fmt.Errorf("generating config failed: %w", err)
+		return nil, fmt.Errorf("generating config failed: %w", err)
 	}
 
 	// Compress config to avoid 1mb secret limit for a while
 	s, err := prompkg.MakeConfigurationSecret(p, c.config, conf)
 	if err != nil {
-		return fmt.Errorf("creating compressed secret failed: %w", err)
+		return nil, fmt.Errorf("creating compressed secret failed: %w", err)
 	}
 
 	logger.Debug("updating Prometheus configuration secret")
-	return k8s.CreateOrUpdateSecret(ctx, sClient, s)
+	return conf, k8s.CreateOrUpdateSecret(ctx, sClient, s)
 }
 
 func (c *Operator) createOrUpdateWebConfigSecret(ctx context.Context, p *monitoringv1.Prometheus) error {
@@ -1703,6 +2218,37 @@ func makeSelectorLabels(name string) map[string]string {
 	}
 }
 
+// makeExposedWorkload returns the description of the Prometheus pods exposed
+// by the operator.
+func makeExposedWorkload(p *monitoringv1.Prometheus) operator.ExposedWorkload {
+	cpf := p.GetCommonPrometheusFields()
+
+	w := operator.ExposedWorkload{
+		Name:            prompkg.PrefixedName(p),
+		Namespace:       p.Namespace,
+		ApplicationName: applicationNameLabelValue,
+		InstanceName:    p.Name,
+		ExternalURL:     cpf.ExternalURL,
+		RoutePrefix:     cpf.WebRoutePrefix(),
+		PortName:        cmp.Or(cpf.PortName, prompkg.DefaultPortName),
+		Port:            9090,
+		Selector:        makeSelectorLabels(p.Name),
+	}
+
+	for shard, ssetName := range prompkg.ExpectedStatefulSetShardNames(p) {
+		selector := makeSelectorLabels(p.Name)
+		selector[prompkg.ShardLabelName] = fmt.Sprintf("%d", shard)
+
+		w.Shards = append(w.Shards, operator.ExposedShard{
+			StatefulSetName: ssetName,
+			Replicas:        *prompkg.ReplicasNumberPtr(p),
+			Selector:        selector,
+		})
+	}
+
+	return w
+}
+
 func validateAlertmanagerEndpoints(p *monitoringv1.Prometheus, am monitoringv1.AlertmanagerEndpoints) error {
 	var nonNilFields []string
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
@@ -344,6 +346,24 @@ func TestProcessShardRetention(t *testing.T) {
 			expectedPatch:          true,
 			expectedDeadlineIsZero: true,
 		},
+		{
+			name:                     "WhenScaled set to Retain with consistent-hash sharding",
+			retentionPoliciesEnabled: true,
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					Shards: new(int32(2)),
+					ShardingStrategy: &monitoringv1.ShardingStrategy{
+						Mode: new(monitoringv1.ConsistentHashShardingStrategyMode),
+					},
+				},
+				ShardRetentionPolicy: &monitoringv1.ShardRetentionPolicy{
+					WhenScaled: new(monitoringv1.RetainWhenScaledRetentionType),
+				},
+			},
+			expectedDelete:           false,
+			expectedPatch:            true,
+			expectedDeadlineDuration: 24 * time.Hour,
+		},
 		{
 			name:                     "patch failure returns error",
 			retentionPoliciesEnabled: true,
@@ -508,3 +528,85 @@ func TestGracePeriodForPrometheusStorage(t *testing.T) {
 		})
 	}
 }
+
+func TestPrometheusSelectsProbe(t *testing.T) {
+	nsInf := cache.NewSharedIndexInformer(&cache.ListWatch{}, &corev1.Namespace{}, 0, cache.Indexers{})
+	require.NoError(t, nsInf.GetStore().Add(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "team-a",
+			Labels: map[string]string{"team": "a"},
+		},
+	}))
+
+	c := &Operator{
+		logger:   slog.New(slog.DiscardHandler),
+		nsMonInf: nsInf,
+	}
+
+	probe := &monitoringv1.Probe{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "probe",
+			Namespace: "team-a",
+			Labels:    map[string]string{"group": "web"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		spec     monitoringv1.PrometheusSpec
+		expected bool
+	}{
+		{
+			name:     "no probe selector",
+			expected: false,
+		},
+		{
+			name: "probe labels not matching",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ProbeSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"group": "db"}},
+					ProbeNamespaceSelector: &metav1.LabelSelector{},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "nil namespace selector and different namespace",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ProbeSelector: &metav1.LabelSelector{},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "namespace labels matching",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ProbeSelector:          &metav1.LabelSelector{MatchLabels: map[string]string{"group": "web"}},
+					ProbeNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "namespace labels not matching",
+			spec: monitoringv1.PrometheusSpec{
+				CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+					ProbeSelector:          &metav1.LabelSelector{},
+					ProbeNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+				},
+			},
+			expected: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &monitoringv1.Prometheus{
+				ObjectMeta: metav1.ObjectMeta{Name: "prom", Namespace: "monitoring"},
+				Spec:       tc.spec,
+			}
+
+			require.Equal(t, tc.expected, c.prometheusSelectsProbe(p, probe))
+		})
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prompkg "github.com/prometheus-operator/prometheus-operator/pkg/prometheus"
+)
+
+// Render generates the configuration, the rule files and the statefulsets of
+// the Prometheus resource from the given objects, without interacting with
+// a Kubernetes API server.
+func Render(ctx context.Context, logger *slog.Logger, c operator.Config, p *monitoringv1.Prometheus, objects operator.RenderObjects) (files, ruleFiles map[string]string, ssets []*appsv1.StatefulSet, err error) {
+	o := &Operator{
+		kclient:  objects.KubeClient(),
+		mclient:  objects.MonitoringClient(),
+		logger:   logger,
+		accessor: operator.NewAccessor(logger),
+		config:   newConfig(c),
+
+		nsMonInf:  objects.NamespaceInformer(),
+		nsPromInf: objects.NamespaceInformer(),
+
+		metrics:          operator.NewMetrics(prometheus.NewRegistry()),
+		reconciliations:  &operator.ReconciliationTracker{},
+		newEventRecorder: c.EventRecorderFactory(objects.KubeClient(), controllerName),
+
+		endpointSliceSupported:   true,
+		scrapeConfigSupported:    true,
+		remoteWriteSupported:     c.Gates.Enabled(operator.RemoteWriteCustomResourceDefinitionFeature),
+		retentionPoliciesEnabled: c.Gates.Enabled(operator.PrometheusShardRetentionPolicyFeature),
+		topologyShardingEnabled:  c.Gates.Enabled(operator.PrometheusTopologyShardingFeature),
+	}
+
+	type resourceInformers struct {
+		infs     **informers.ForResource
+		resource schema.GroupVersionResource
+	}
+	resourceInfs := []resourceInformers{
+		{&o.smonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName)},
+		{&o.pmonInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PodMonitorName)},
+		{&o.probeInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ProbeName)},
+		{&o.sconInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.ScrapeConfigName)},
+		{&o.ruleInfs, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName)},
+	}
+	// The RemoteWrite resources are only selected when the informers exist.
+	if o.remoteWriteSupported {
+		resourceInfs = append(resourceInfs, resourceInformers{&o.rwInfs, monitoringv1alpha1.SchemeGroupVersion.WithResource(monitoringv1alpha1.RemoteWriteName)})
+	}
+
+	for _, inf := range resourceInfs {
+		*inf.infs, err = objects.NewInformersForResource(ctx, inf.resource)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create %s informers: %w", inf.resource.Resource, err)
+		}
+	}
+
+	store := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
+
+	resources, err := o.getSelectedConfigResources(ctx, logger, p, store)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(ctx, p, resources.rules, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	opts := []prompkg.ConfigGeneratorOption{prompkg.WithEndpointSliceSupport()}
+	if o.retentionPoliciesEnabled {
+		opts = append(opts, prompkg.WithPrometheusRetentionPolicies())
+	}
+	if o.topologyShardingEnabled {
+		opts = append(opts, prompkg.WithPrometheusTopologySharding())
+	}
+	cg, err := prompkg.NewConfigGenerator(logger, p, opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if _, err := o.createOrUpdateConfigurationSecret(ctx, logger, p, cg, ruleConfigMapNames, store, resources); err != nil {
+		return nil, nil, nil, fmt.Errorf("creating config failed: %w", err)
+	}
+
+	s, err := o.kclient.CoreV1().Secrets(p.Namespace).Get(ctx, prompkg.ConfigSecretName(p), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	conf, err := operator.GunzipConfig(s.Data[prompkg.ConfigFilename])
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decompress the configuration: %w", err)
+	}
+
+	tlsAssets, err := operator.ReconcileShardedSecret(ctx, store.TLSAssets(), o.kclient, prompkg.NewTLSAssetSecret(p, o.config))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+	}
+
+	for shard, ssetName := range prompkg.ExpectedStatefulSetShardNames(p) {
+		inputHash, err := createSSetInputHash(*p, o.config, ruleConfigMapNames, tlsAssets, appsv1.StatefulSetSpec{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		sset, err := makeStatefulSet(ssetName, p, o.config, cg, ruleConfigMapNames, inputHash, int32(shard), tlsAssets)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("making statefulset failed: %w", err)
+		}
+		operator.SanitizeSTS(sset)
+
+		ssets = append(ssets, sset)
+	}
+
+	files = map[string]string{strings.TrimSuffix(prompkg.ConfigFilename, ".gz"): conf}
+	return files, resources.rules.RuleFiles(), ssets, nil
+}
@@ -0,0 +1,161 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	// DefaultConsistentHashVirtualBuckets is the default number of virtual
+	// buckets for the ConsistentHash sharding mode.
+	DefaultConsistentHashVirtualBuckets = 1024
+
+	shardLabelNameForSharding = "__tmp_shard"
+)
+
+// isConsistentHashShardingActive returns true when the Prometheus resource
+// is configured with mode=ConsistentHash.
+func (cg *ConfigGenerator) isConsistentHashShardingActive() bool {
+	ss := cg.prom.GetCommonPrometheusFields().ShardingStrategy
+	return ss != nil &&
+		ss.Mode != nil &&
+		*ss.Mode == monitoringv1.ConsistentHashShardingStrategyMode
+}
+
+// consistentHashVirtualBuckets returns the number of virtual buckets for the
+// given number of shards. It is never lower than the number of shards.
+func (cg *ConfigGenerator) consistentHashVirtualBuckets(shards int32) int32 {
+	buckets := int32(DefaultConsistentHashVirtualBuckets)
+	if ch := cg.prom.GetCommonPrometheusFields().ShardingStrategy.ConsistentHash; ch != nil {
+		buckets = ptr.Deref(ch.VirtualBuckets, buckets)
+	}
+
+	return max(buckets, shards)
+}
+
+// assignBucketsToShards distributes the virtual buckets across the shards
+// using rendezvous (highest random weight) hashing: each bucket goes to the
+// shard with the highest score for this bucket. Because the score of a
+// (bucket, shard) pair doesn't depend on the number of shards, adding a
+// shard only moves the buckets for which the new shard has the highest
+// score (about 1/N of the buckets) and removing a shard only moves the
+// buckets which were assigned to it.
+//
+// The returned slice is indexed by shard and contains the sorted bucket
+// numbers assigned to each shard.
+//
+// The hashing function must not change, otherwise the targets would be
+// reassigned on upgrade of the operator.
+func assignBucketsToShards(shards, buckets int32) [][]int32 {
+	assignments := make([][]int32, shards)
+	for bucket := range buckets {
+		var (
+			owner     int32
+			bestScore uint64
+		)
+		for shard := range shards {
+			if score := rendezvousScore(bucket, shard); shard == 0 || score > bestScore {
+				owner, bestScore = shard, score
+			}
+		}
+
+		assignments[owner] = append(assignments[owner], bucket)
+	}
+
+	return assignments
+}
+
+func rendezvousScore(bucket, shard int32) uint64 {
+	var b [8]byte
+	binary.BigEndian.PutUint32(b[:4], uint32(bucket))
+	binary.BigEndian.PutUint32(b[4:], uint32(shard))
+
+	h := fnv.New64a()
+	_, _ = h.Write(b[:])
+
+	// FNV doesn't mix the last bytes very well, finalize the hash with the
+	// splitmix64 mixer.
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
+
+// appendConsistentHashShardingRelabelings appends the relabeling rules which
+// compute the bucket of the target, translate it to a shard number and keep
+// only the targets assigned to the current shard.
+//
+// Because the same configuration is used by all shards, the
+// bucket-to-shard mapping is expressed as one "replace" rule per shard.
+func (cg *ConfigGenerator) appendConsistentHashShardingRelabelings(relabelings []yaml.MapSlice, shards int32, shardLabel string) []yaml.MapSlice {
+	buckets := cg.consistentHashVirtualBuckets(shards)
+
+	relabelings = append(relabelings,
+		// Store the "shardLabel" value into the __tmp_hash label unless the
+		// latter is already set.
+		yaml.MapSlice{
+			{Key: "source_labels", Value: []string{shardLabel, hashLabelNameForSharding}},
+			{Key: "target_label", Value: hashLabelNameForSharding},
+			{Key: "regex", Value: "(.+);"},
+			{Key: "replacement", Value: "$1"},
+			{Key: "action", Value: "replace"},
+		}, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
+			{Key: "target_label", Value: hashLabelNameForSharding},
+			{Key: "modulus", Value: buckets},
+			{Key: "action", Value: "hashmod"},
+		},
+	)
+
+	for shard, shardBuckets := range assignBucketsToShards(shards, buckets) {
+		if len(shardBuckets) == 0 {
+			continue
+		}
+
+		values := make([]string, 0, len(shardBuckets))
+		for _, b := range shardBuckets {
+			values = append(values, strconv.Itoa(int(b)))
+		}
+
+		relabelings = append(relabelings, yaml.MapSlice{
+			{Key: "source_labels", Value: []string{hashLabelNameForSharding}},
+			{Key: "target_label", Value: shardLabelNameForSharding},
+			{Key: "regex", Value: strings.Join(values, "|")},
+			{Key: "replacement", Value: strconv.Itoa(shard)},
+			{Key: "action", Value: "replace"},
+		})
+	}
+
+	return append(relabelings, yaml.MapSlice{
+		{Key: "source_labels", Value: []string{shardLabelNameForSharding, hashLabelNameForDisablingSharding}},
+		{Key: "regex", Value: fmt.Sprintf("$(%s);|.+;.+", operator.ShardEnvVar)},
+		{Key: "action", Value: "keep"},
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// bucketOwners returns the shard owning each bucket.
+func bucketOwners(t *testing.T, shards, buckets int32) []int32 {
+	t.Helper()
+
+	owners := make([]int32, buckets)
+	for i := range owners {
+		owners[i] = -1
+	}
+
+	for shard, shardBuckets := range assignBucketsToShards(shards, buckets) {
+		for _, b := range shardBuckets {
+			require.Equal(t, int32(-1), owners[b], "bucket %d assigned to several shards", b)
+			owners[b] = int32(shard)
+		}
+	}
+
+	for b, owner := range owners {
+		require.NotEqual(t, int32(-1), owner, "bucket %d not assigned", b)
+	}
+
+	return owners
+}
+
+func TestAssignBucketsToShards(t *testing.T) {
+	const buckets = DefaultConsistentHashVirtualBuckets
+
+	for _, shards := range []int32{1, 2, 4, 5, 10} {
+		t.Run(fmt.Sprintf("shards=%d", shards), func(t *testing.T) {
+			owners := bucketOwners(t, shards, buckets)
+
+			// Every shard should get a fair share of the buckets.
+			counts := make([]int, shards)
+			for _, owner := range owners {
+				counts[owner]++
+			}
+
+			expected := float64(buckets) / float64(shards)
+			for shard, n := range counts {
+				require.InDelta(t, expected, n, expected*0.25, "unbalanced shard %d", shard)
+			}
+
+			// The assignment must be stable.
+			require.Equal(t, assignBucketsToShards(shards, buckets), assignBucketsToShards(shards, buckets))
+		})
+	}
+}
+
+func TestAssignBucketsToShardsMinimalChurn(t *testing.T) {
+	const buckets = DefaultConsistentHashVirtualBuckets
+
+	for _, tc := range []struct {
+		from, to int32
+	}{
+		{from: 4, to: 5},
+		{from: 5, to: 4},
+		{from: 1, to: 2},
+		{from: 9, to: 10},
+	} {
+		t.Run(fmt.Sprintf("%d->%d", tc.from, tc.to), func(t *testing.T) {
+			before := bucketOwners(t, tc.from, buckets)
+			after := bucketOwners(t, tc.to, buckets)
+
+			var moved int
+			for b := range before {
+				if before[b] == after[b] {
+					continue
+				}
+
+				moved++
+				if tc.to > tc.from {
+					// Scaling up: buckets only move to the new shards.
+					require.GreaterOrEqual(t, after[b], tc.from)
+				} else {
+					// Scaling down: only the buckets of the removed shards move.
+					require.GreaterOrEqual(t, before[b], tc.to)
+				}
+			}
+
+			// About 1/N of the buckets should move.
+			expected := float64(buckets) / float64(max(tc.from, tc.to))
+			require.InDelta(t, expected, moved, expected*0.25)
+		})
+	}
+}
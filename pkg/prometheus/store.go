@@ -113,6 +113,18 @@ func AddScrapeClassesToStore(ctx context.Context, store *assets.StoreBuilder, na
 		if err := store.AddTLSConfig(ctx, namespace, scrapeClass.TLSConfig); err != nil {
 			return fmt.Errorf("scrape class %q: %w", scrapeClass.Name, err)
 		}
+
+		if err := store.AddBasicAuth(ctx, namespace, scrapeClass.BasicAuth); err != nil {
+			return fmt.Errorf("scrape class %q: basicAuth: %w", scrapeClass.Name, err)
+		}
+
+		if err := store.AddOAuth2(ctx, namespace, scrapeClass.OAuth2); err != nil {
+			return fmt.Errorf("scrape class %q: oauth2: %w", scrapeClass.Name, err)
+		}
+
+		if err := addProxyConfigToStore(ctx, scrapeClass.ProxyConfig, store, namespace); err != nil {
+			return fmt.Errorf("scrape class %q: proxy: %w", scrapeClass.Name, err)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,176 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	structuraldefaulting "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1beta1"
+)
+
+var decodeScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(kscheme.AddToScheme(decodeScheme))
+	utilruntime.Must(monitoringv1.SchemeBuilder.AddToScheme(decodeScheme))
+	utilruntime.Must(monitoringv1alpha1.SchemeBuilder.AddToScheme(decodeScheme))
+	utilruntime.Must(monitoringv1beta1.SchemeBuilder.AddToScheme(decodeScheme))
+}
+
+// Defaulter applies the default values declared in the OpenAPI schemas of
+// CustomResourceDefinitions. It emulates the defaulting done by the API
+// server when custom resources are read from files.
+type Defaulter struct {
+	schemas map[schema.GroupVersionKind]*structuralschema.Structural
+}
+
+// NewDefaulter returns a Defaulter for the served versions of the given
+// CustomResourceDefinitions.
+func NewDefaulter(crds []apiextensionsv1.CustomResourceDefinition) (*Defaulter, error) {
+	d := &Defaulter{schemas: map[schema.GroupVersionKind]*structuralschema.Structural{}}
+
+	for _, crd := range crds {
+		for _, v := range crd.Spec.Versions {
+			if !v.Served || v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+				continue
+			}
+
+			var props apiextensions.JSONSchemaProps
+			if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(v.Schema.OpenAPIV3Schema, &props, nil); err != nil {
+				return nil, fmt.Errorf("failed to convert schema of %s/%s: %w", crd.Name, v.Name, err)
+			}
+
+			s, err := structuralschema.NewStructural(&props)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build structural schema of %s/%s: %w", crd.Name, v.Name, err)
+			}
+
+			d.schemas[schema.GroupVersionKind{Group: crd.Spec.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}] = s
+		}
+	}
+
+	return d, nil
+}
+
+// Default applies the schema defaults to the JSON-encoded object. Objects
+// without a matching schema are returned unchanged.
+func (d *Defaulter) Default(js []byte) ([]byte, error) {
+	if d == nil {
+		return js, nil
+	}
+
+	var obj map[string]any
+	if err := json.Unmarshal(js, &obj); err != nil {
+		return nil, err
+	}
+
+	u := unstructured.Unstructured{Object: obj}
+	s, found := d.schemas[u.GroupVersionKind()]
+	if !found {
+		return js, nil
+	}
+
+	structuraldefaulting.Default(obj, s)
+
+	return json.Marshal(obj)
+}
+
+// DecodeObjects decodes the Kubernetes and monitoring objects from a stream
+// of YAML (or JSON) documents. Empty documents are skipped and List objects
+// are expanded into their items.
+//
+// If the defaulter isn't nil, it is applied to the objects before decoding.
+func DecodeObjects(r io.Reader, d *Defaulter) ([]runtime.Object, error) {
+	var (
+		reader       = utilyaml.NewYAMLReader(bufio.NewReader(r))
+		deserializer = serializer.NewCodecFactory(decodeScheme).UniversalDeserializer()
+		objects      []runtime.Object
+	)
+
+	decode := func(js []byte) (runtime.Object, error) {
+		js, err := d.Default(js)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, _, err := deserializer.Decode(js, nil, nil)
+		return obj, err
+	}
+
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+
+			return nil, fmt.Errorf("failed to read document %d: %w", i, err)
+		}
+
+		// Skip documents without content (e.g. only comments).
+		js, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert document %d to JSON: %w", i, err)
+		}
+		if len(js) == 0 || string(js) == "null" {
+			continue
+		}
+
+		obj, err := decode(js)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", i, err)
+		}
+
+		if !meta.IsListType(obj) {
+			objects = append(objects, obj)
+			continue
+		}
+
+		items, err := meta.ExtractList(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract items from document %d: %w", i, err)
+		}
+
+		for j, item := range items {
+			if u, ok := item.(*runtime.Unknown); ok {
+				item, err = decode(u.Raw)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode item %d of document %d: %w", j, i, err)
+				}
+			}
+			objects = append(objects, item)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+const manifests = `
+# Only comments.
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: creds
+  namespace: default
+stringData:
+  password: secret
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: monitoring.coreos.com/v1
+  kind: ServiceMonitor
+  metadata:
+    name: foo
+    namespace: default
+  spec:
+    endpoints:
+    - port: web
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: bar
+    namespace: default
+`
+
+func TestDecodeObjects(t *testing.T) {
+	objects, err := DecodeObjects(strings.NewReader(manifests), nil)
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	require.IsType(t, &corev1.Secret{}, objects[0])
+	require.IsType(t, &monitoringv1.ServiceMonitor{}, objects[1])
+	require.IsType(t, &corev1.ConfigMap{}, objects[2])
+
+	require.Nil(t, objects[1].(*monitoringv1.ServiceMonitor).Spec.Endpoints[0].Scheme)
+}
+
+func TestDecodeObjectsWithDefaulter(t *testing.T) {
+	d, err := NewDefaulter([]apiextensionsv1.CustomResourceDefinition{
+		{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: "monitoring.coreos.com",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Kind: "ServiceMonitor"},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:   "v1",
+						Served: true,
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type: "object",
+								Properties: map[string]apiextensionsv1.JSONSchemaProps{
+									"spec": {
+										Type: "object",
+										Properties: map[string]apiextensionsv1.JSONSchemaProps{
+											"endpoints": {
+												Type: "array",
+												Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+													Schema: &apiextensionsv1.JSONSchemaProps{
+														Type: "object",
+														Properties: map[string]apiextensionsv1.JSONSchemaProps{
+															"port":   {Type: "string"},
+															"scheme": {Type: "string", Default: &apiextensionsv1.JSON{Raw: []byte(`"HTTP"`)}},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	objects, err := DecodeObjects(strings.NewReader(manifests), d)
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+
+	smon := objects[1].(*monitoringv1.ServiceMonitor)
+	require.NotNil(t, smon.Spec.Endpoints[0].Scheme)
+	require.Equal(t, "HTTP", string(*smon.Spec.Endpoints[0].Scheme))
+	require.Equal(t, "web", smon.Spec.Endpoints[0].Port)
+}
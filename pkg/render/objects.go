@@ -0,0 +1,164 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	kfake "k8s.io/client-go/kubernetes/fake"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
+	monitoringclient "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
+)
+
+// InMemoryObjects exposes objects read from manifests through fake
+// Kubernetes clients. It allows the controllers to generate the
+// configuration of a workload resource without a Kubernetes API server.
+//
+// It implements the operator.RenderObjects interface.
+type InMemoryObjects struct {
+	kclient    kubernetes.Interface
+	mclient    monitoringclient.Interface
+	namespaces cache.SharedIndexInformer
+}
+
+// NewInMemoryObjects returns an InMemoryObjects holding the given objects.
+//
+// The namespaces of the objects which don't have a corresponding Namespace
+// object are created automatically with the "kubernetes.io/metadata.name"
+// label. The stringData field of Secrets is merged into the data field like
+// the API server does.
+func NewInMemoryObjects(objects []runtime.Object) (*InMemoryObjects, error) {
+	var (
+		kubeObjects       []runtime.Object
+		monitoringObjects []runtime.Object
+		namespaces        = map[string]*corev1.Namespace{}
+		implicit          = sets.New[string]()
+	)
+
+	for _, obj := range objects {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		switch {
+		case gvk.Group == monitoring.GroupName:
+			monitoringObjects = append(monitoringObjects, obj)
+		case kscheme.Scheme.Recognizes(gvk):
+			kubeObjects = append(kubeObjects, obj)
+		default:
+			// Objects of other API groups aren't used by the controllers.
+			continue
+		}
+
+		switch o := obj.(type) {
+		case *corev1.Namespace:
+			namespaces[o.Name] = o
+			continue
+		case *corev1.Secret:
+			// The API server merges stringData into data on write.
+			for k, v := range o.StringData {
+				if o.Data == nil {
+					o.Data = map[string][]byte{}
+				}
+				o.Data[k] = []byte(v)
+			}
+			o.StringData = nil
+		}
+
+		if o, ok := obj.(metav1.Object); ok && o.GetNamespace() != "" {
+			implicit.Insert(o.GetNamespace())
+		}
+	}
+
+	for _, name := range sets.List(implicit) {
+		if _, found := namespaces[name]; found {
+			continue
+		}
+
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{corev1.LabelMetadataName: name},
+			},
+		}
+		namespaces[name] = ns
+		kubeObjects = append(kubeObjects, ns)
+	}
+
+	nsInf := cache.NewSharedIndexInformer(&cache.ListWatch{}, &corev1.Namespace{}, 0, cache.Indexers{})
+	for _, name := range slices.Sorted(maps.Keys(namespaces)) {
+		if err := nsInf.GetStore().Add(namespaces[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	return &InMemoryObjects{
+		kclient:    kfake.NewClientset(kubeObjects...),
+		mclient:    monitoringfake.NewClientset(monitoringObjects...),
+		namespaces: nsInf,
+	}, nil
+}
+
+// KubeClient returns the client holding the Kubernetes objects.
+func (imo *InMemoryObjects) KubeClient() kubernetes.Interface {
+	return imo.kclient
+}
+
+// MonitoringClient returns the client holding the monitoring objects.
+func (imo *InMemoryObjects) MonitoringClient() monitoringclient.Interface {
+	return imo.mclient
+}
+
+// NamespaceInformer returns an informer holding the namespaces.
+// The informer doesn't need to be started.
+func (imo *InMemoryObjects) NamespaceInformer() cache.SharedIndexInformer {
+	return imo.namespaces
+}
+
+// NewInformersForResource returns synced informers for the given monitoring
+// resource in all namespaces.
+func (imo *InMemoryObjects) NewInformersForResource(ctx context.Context, resource schema.GroupVersionResource) (*informers.ForResource, error) {
+	infs, err := informers.NewInformersForResource(
+		informers.NewMonitoringInformerFactories(
+			map[string]struct{}{corev1.NamespaceAll: {}},
+			nil,
+			imo.mclient,
+			0,
+			nil,
+		),
+		resource,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	infs.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), infs.HasSynced) {
+		return nil, errors.New("failed to sync the informers")
+	}
+
+	return infs, nil
+}
@@ -0,0 +1,71 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+)
+
+func TestNewInMemoryObjects(t *testing.T) {
+	imo, err := NewInMemoryObjects([]runtime.Object{
+		&corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"team": "a"}},
+		},
+		&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "default"},
+			Data:       map[string][]byte{"user": []byte("admin")},
+			StringData: map[string]string{"password": "secret"},
+		},
+		&monitoringv1.ServiceMonitor{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "monitoring.coreos.com/v1", Kind: "ServiceMonitor"},
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "monitoring"},
+		},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	s, err := imo.KubeClient().CoreV1().Secrets("default").Get(ctx, "creds", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"user": []byte("admin"), "password": []byte("secret")}, s.Data)
+	require.Empty(t, s.StringData)
+
+	ns, err := imo.KubeClient().CoreV1().Namespaces().Get(ctx, "monitoring", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{corev1.LabelMetadataName: "monitoring"}, ns.Labels)
+
+	require.ElementsMatch(t, []string{"default", "monitoring"}, imo.NamespaceInformer().GetStore().ListKeys())
+
+	infs, err := imo.NewInformersForResource(ctx, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.ServiceMonitorName))
+	require.NoError(t, err)
+
+	var names []string
+	err = infs.ListAll(labels.Everything(), func(obj any) {
+		names = append(names, obj.(*monitoringv1.ServiceMonitor).Name)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"app"}, names)
+}
@@ -0,0 +1,111 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package render generates the configuration files, the rule files and the
+// statefulsets of the workload resources from manifests, without a
+// Kubernetes API server. It is only used by the operator's render command.
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/yaml"
+
+	alertmanagercontroller "github.com/prometheus-operator/prometheus-operator/pkg/alertmanager"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+	prometheuscontroller "github.com/prometheus-operator/prometheus-operator/pkg/prometheus/server"
+	thanoscontroller "github.com/prometheus-operator/prometheus-operator/pkg/thanos"
+)
+
+// RenderedResources holds the configuration files and the statefulsets
+// generated by the operator for a workload resource.
+type RenderedResources struct {
+	// Files maps the name of the configuration files to their content.
+	Files map[string]string
+	// RuleFiles maps the name of the rule files to their content.
+	RuleFiles map[string]string
+	// StatefulSets are the statefulsets deployed for the workload resource.
+	StatefulSets []*appsv1.StatefulSet
+}
+
+func newRenderedResources(files, ruleFiles map[string]string, ssets []*appsv1.StatefulSet, err error) (*RenderedResources, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderedResources{
+		Files:        files,
+		RuleFiles:    ruleFiles,
+		StatefulSets: ssets,
+	}, nil
+}
+
+// Prometheus renders the resources of the Prometheus object.
+func Prometheus(ctx context.Context, logger *slog.Logger, c operator.Config, p *monitoringv1.Prometheus, objects *InMemoryObjects) (*RenderedResources, error) {
+	return newRenderedResources(prometheuscontroller.Render(ctx, logger, c, p, objects))
+}
+
+// Alertmanager renders the resources of the Alertmanager object.
+func Alertmanager(ctx context.Context, logger *slog.Logger, c operator.Config, am *monitoringv1.Alertmanager, objects *InMemoryObjects) (*RenderedResources, error) {
+	return newRenderedResources(alertmanagercontroller.Render(ctx, logger, c, am, objects))
+}
+
+// ThanosRuler renders the resources of the ThanosRuler object.
+func ThanosRuler(ctx context.Context, logger *slog.Logger, c operator.Config, tr *monitoringv1.ThanosRuler, objects *InMemoryObjects) (*RenderedResources, error) {
+	return newRenderedResources(thanoscontroller.Render(ctx, logger, c, tr, objects))
+}
+
+// Write writes the rendered resources as a stream of YAML documents. Each
+// document is preceded by a comment identifying the source of the resource.
+func (r *RenderedResources) Write(w io.Writer, source string) error {
+	for _, name := range slices.Sorted(maps.Keys(r.Files)) {
+		if _, err := fmt.Fprintf(w, "---\n# Source: %s: %s\n%s", source, name, withTrailingNewline(r.Files[name])); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(r.RuleFiles)) {
+		if _, err := fmt.Fprintf(w, "---\n# Source: %s: rules/%s\n%s", source, name, withTrailingNewline(r.RuleFiles[name])); err != nil {
+			return err
+		}
+	}
+
+	for _, sset := range r.StatefulSets {
+		b, err := yaml.Marshal(sset)
+		if err != nil {
+			return fmt.Errorf("failed to marshal statefulset %s/%s: %w", sset.Namespace, sset.Name, err)
+		}
+
+		if _, err := fmt.Fprintf(w, "---\n# Source: %s: statefulset %s\n%s", source, sset.Name, b); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func withTrailingNewline(s string) string {
+	if s == "" || strings.HasSuffix(s, "\n") {
+		return s
+	}
+	return s + "\n"
+}
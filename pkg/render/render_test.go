@@ -0,0 +1,256 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/v3/golden"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+func TestPrometheus(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		gates      map[string]bool
+		goldenFile string
+	}{
+		{
+			name: "RemoteWrite CRD enabled",
+			gates: map[string]bool{
+				string(operator.RemoteWriteCustomResourceDefinitionFeature): true,
+			},
+			goldenFile: "prometheus_remote_write_enabled.golden",
+		},
+		{
+			name: "RemoteWrite CRD disabled",
+			gates: map[string]bool{
+				string(operator.RemoteWriteCustomResourceDefinitionFeature): false,
+			},
+			goldenFile: "prometheus_remote_write_disabled.golden",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := operator.DefaultConfig("100m", "50Mi")
+			c.ReloaderConfig = operator.DefaultReloaderTestConfig.ReloaderConfig
+			c.PrometheusDefaultBaseImage = operator.DefaultPrometheusBaseImage
+			c.ThanosDefaultBaseImage = operator.DefaultThanosBaseImage
+			c.LocalHost = "localhost"
+			c.EventRecorderFactory = operator.NewEventRecorderFactory(false)
+			require.NoError(t, c.Gates.UpdateFeatureGates(tc.gates))
+
+			p := &monitoringv1.Prometheus{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: monitoringv1.SchemeGroupVersion.String(),
+					Kind:       monitoringv1.PrometheusesKind,
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.PrometheusSpec{
+					CommonPrometheusFields: monitoringv1.CommonPrometheusFields{
+						Version:                "v3.5.0",
+						ServiceMonitorSelector: &metav1.LabelSelector{},
+						RemoteWriteSelector:    &metav1.LabelSelector{},
+					},
+					RuleSelector: &metav1.LabelSelector{},
+				},
+			}
+
+			objects, err := NewInMemoryObjects([]runtime.Object{
+				p,
+				&monitoringv1.ServiceMonitor{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: monitoringv1.SchemeGroupVersion.String(),
+						Kind:       monitoringv1.ServiceMonitorsKind,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.ServiceMonitorSpec{
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{"app": "app"},
+						},
+						Endpoints: []monitoringv1.Endpoint{{Port: "web"}},
+					},
+				},
+				&monitoringv1.PrometheusRule{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: monitoringv1.SchemeGroupVersion.String(),
+						Kind:       monitoringv1.PrometheusRuleKind,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "rules",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.PrometheusRuleSpec{
+						Groups: []monitoringv1.RuleGroup{{
+							Name: "group",
+							Rules: []monitoringv1.Rule{{
+								Alert: "AlwaysFiring",
+								Expr:  intstr.FromString("vector(1)"),
+							}},
+						}},
+					},
+				},
+				&monitoringv1alpha1.RemoteWrite{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+						Kind:       monitoringv1alpha1.RemoteWritesKind,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "remote",
+						Namespace: "default",
+					},
+					Spec: monitoringv1.RemoteWriteSpec{
+						URL: "http://remote.example.com/api/v1/write",
+					},
+				},
+			})
+			require.NoError(t, err)
+
+			rendered, err := Prometheus(t.Context(), slog.New(slog.DiscardHandler), c, p, objects)
+			require.NoError(t, err)
+
+			var b bytes.Buffer
+			require.NoError(t, rendered.Write(&b, "Prometheus default/test"))
+			golden.Assert(t, b.String(), tc.goldenFile)
+		})
+	}
+}
+
+func TestAlertmanager(t *testing.T) {
+	c := operator.DefaultConfig("100m", "50Mi")
+	c.ReloaderConfig = operator.DefaultReloaderTestConfig.ReloaderConfig
+	c.AlertmanagerDefaultBaseImage = operator.DefaultAlertmanagerBaseImage
+	c.EventRecorderFactory = operator.NewEventRecorderFactory(false)
+	c.LocalHost = "localhost"
+
+	am := &monitoringv1.Alertmanager{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.AlertmanagersKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.AlertmanagerSpec{
+			Version:                             "v0.28.1",
+			Replicas:                            new(int32(2)),
+			AlertmanagerConfigSelector:          &metav1.LabelSelector{},
+			AlertmanagerConfigNamespaceSelector: &metav1.LabelSelector{},
+		},
+	}
+
+	objects, err := NewInMemoryObjects([]runtime.Object{
+		am,
+		&monitoringv1alpha1.AlertmanagerConfig{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1alpha1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1alpha1.AlertmanagerConfigKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "team",
+				Namespace: "default",
+			},
+			Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+				Route: &monitoringv1alpha1.Route{
+					Receiver: "webhook",
+				},
+				Receivers: []monitoringv1alpha1.Receiver{{
+					Name: "webhook",
+					WebhookConfigs: []monitoringv1alpha1.WebhookConfig{{
+						URL: new("http://webhook.example.com/"),
+					}},
+				}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	rendered, err := Alertmanager(t.Context(), slog.New(slog.DiscardHandler), c, am, objects)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, rendered.Write(&b, "Alertmanager default/test"))
+	golden.Assert(t, b.String(), "alertmanager.golden")
+}
+
+func TestThanosRuler(t *testing.T) {
+	c := operator.DefaultConfig("100m", "50Mi")
+	c.ReloaderConfig = operator.DefaultReloaderTestConfig.ReloaderConfig
+	c.ThanosDefaultBaseImage = operator.DefaultThanosBaseImage
+	c.EventRecorderFactory = operator.NewEventRecorderFactory(false)
+	c.LocalHost = "localhost"
+
+	tr := &monitoringv1.ThanosRuler{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoringv1.SchemeGroupVersion.String(),
+			Kind:       monitoringv1.ThanosRulerKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: monitoringv1.ThanosRulerSpec{
+			Version:        new("v0.39.2"),
+			QueryEndpoints: []string{"dnssrv+_http._tcp.thanos-query.default.svc"},
+			RuleSelector:   &metav1.LabelSelector{},
+		},
+	}
+
+	objects, err := NewInMemoryObjects([]runtime.Object{
+		tr,
+		&monitoringv1.PrometheusRule{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: monitoringv1.SchemeGroupVersion.String(),
+				Kind:       monitoringv1.PrometheusRuleKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rules",
+				Namespace: "default",
+			},
+			Spec: monitoringv1.PrometheusRuleSpec{
+				Groups: []monitoringv1.RuleGroup{{
+					Name: "group",
+					Rules: []monitoringv1.Rule{{
+						Alert: "AlwaysFiring",
+						Expr:  intstr.FromString("vector(1)"),
+					}},
+				}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	rendered, err := ThanosRuler(t.Context(), slog.New(slog.DiscardHandler), c, tr, objects)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, rendered.Write(&b, "ThanosRuler default/test"))
+	golden.Assert(t, b.String(), "thanosruler.golden")
+}
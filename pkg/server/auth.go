@@ -0,0 +1,95 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// WithKubernetesAuth returns an HTTP handler which authenticates and
+// authorizes the requests before passing them to the next handler.
+//
+// The bearer token of the request is authenticated with the TokenReview API.
+// The authenticated user must be allowed to access the non-resource URL of
+// the request (with the lowercase HTTP method as the verb), which is checked
+// with the SubjectAccessReview API.
+func WithKubernetesAuth(logger *slog.Logger, kclient kubernetes.Interface, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if !found || token == "" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		tr, err := kclient.AuthenticationV1().TokenReviews().Create(
+			req.Context(),
+			&authenticationv1.TokenReview{
+				Spec: authenticationv1.TokenReviewSpec{Token: token},
+			},
+			metav1.CreateOptions{},
+		)
+		if err != nil {
+			logger.Error("failed to review the token", "err", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if !tr.Status.Authenticated {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		extra := make(map[string]authorizationv1.ExtraValue, len(tr.Status.User.Extra))
+		for k, v := range tr.Status.User.Extra {
+			extra[k] = authorizationv1.ExtraValue(v)
+		}
+
+		sar, err := kclient.AuthorizationV1().SubjectAccessReviews().Create(
+			req.Context(),
+			&authorizationv1.SubjectAccessReview{
+				Spec: authorizationv1.SubjectAccessReviewSpec{
+					User:   tr.Status.User.Username,
+					UID:    tr.Status.User.UID,
+					Groups: tr.Status.User.Groups,
+					Extra:  extra,
+					NonResourceAttributes: &authorizationv1.NonResourceAttributes{
+						Path: req.URL.Path,
+						Verb: strings.ToLower(req.Method),
+					},
+				},
+			},
+			metav1.CreateOptions{},
+		)
+		if err != nil {
+			logger.Error("failed to review the access", "err", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if !sar.Status.Allowed {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,90 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestWithKubernetesAuth(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		token string
+
+		expected int
+	}{
+		{
+			name:     "no token",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid token",
+			token:    "invalid",
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "unauthorized user",
+			token:    "bob",
+			expected: http.StatusForbidden,
+		},
+		{
+			name:     "authorized user",
+			token:    "alice",
+			expected: http.StatusOK,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			kclient := fake.NewClientset()
+			kclient.PrependReactor("create", "tokenreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				tr := action.(clienttesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+				if tr.Spec.Token != "invalid" {
+					tr.Status.Authenticated = true
+					tr.Status.User.Username = tr.Spec.Token
+				}
+				return true, tr, nil
+			})
+			kclient.PrependReactor("create", "subjectaccessreviews", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				sar := action.(clienttesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				require.Equal(t, "/debug/foo", sar.Spec.NonResourceAttributes.Path)
+				require.Equal(t, "get", sar.Spec.NonResourceAttributes.Verb)
+				sar.Status.Allowed = sar.Spec.User == "alice"
+				return true, sar, nil
+			})
+
+			h := WithKubernetesAuth(slog.New(slog.DiscardHandler), kclient, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/debug/foo", nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expected, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"gopkg.in/yaml.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const (
+	alertmanagersConfigFile = "alertmanagers.yaml"
+
+	// Values used to compute the DNS addresses of the Alertmanager pods
+	// managed by the operator.
+	alertmanagerOperatedServiceName = "alertmanager-operated"
+	alertmanagerWebPort             = 9093
+)
+
+var minAlertmanagersConfigVersion = semver.MustParse("0.10.0")
+
+// hasAlertmanagers returns true if the ThanosRuler resource defines typed
+// Alertmanager endpoints or selects Alertmanager resources.
+func hasAlertmanagers(tr *monitoringv1.ThanosRuler) bool {
+	return tr.Spec.AlertManagersConfig == nil &&
+		(len(tr.Spec.Alertmanagers) > 0 || tr.Spec.AlertmanagerSelector != nil)
+}
+
+// selectAlertmanagers returns the Alertmanager resources selected by the
+// ThanosRuler resource.
+func (o *Operator) selectAlertmanagers(tr *monitoringv1.ThanosRuler) ([]*monitoringv1.Alertmanager, error) {
+	if tr.Spec.AlertmanagerSelector == nil {
+		return nil, nil
+	}
+
+	if o.amInfs == nil {
+		return nil, errors.New("alertmanagerSelector: the operator isn't allowed to watch Alertmanager resources")
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(tr.Spec.AlertmanagerSelector)
+	if err != nil {
+		return nil, fmt.Errorf("alertmanagerSelector: %w", err)
+	}
+
+	var ams []*monitoringv1.Alertmanager
+	err = o.amInfs.ListAllByNamespace(tr.Namespace, selector, func(obj any) {
+		ams = append(ams, obj.(*monitoringv1.Alertmanager))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Alertmanager objects: %w", err)
+	}
+
+	return ams, nil
+}
+
+// validateAlertmanagerEndpoints checks that the endpoint only uses settings
+// supported by the Thanos configuration.
+func validateAlertmanagerEndpoints(am monitoringv1.AlertmanagerEndpoints) error {
+	var unsupported []string
+
+	//nolint:staticcheck // Ignore SA1019 this field is marked as deprecated.
+	if am.BearerTokenFile != "" {
+		unsupported = append(unsupported, "bearerTokenFile")
+	}
+
+	if am.Sigv4 != nil {
+		unsupported = append(unsupported, "sigv4")
+	}
+
+	if am.EnableHttp2 != nil {
+		unsupported = append(unsupported, "enableHttp2")
+	}
+
+	if len(am.RelabelConfigs) > 0 {
+		unsupported = append(unsupported, "relabelings")
+	}
+
+	if len(am.AlertRelabelConfigs) > 0 {
+		unsupported = append(unsupported, "alertRelabelings")
+	}
+
+	if am.NoProxy != nil {
+		unsupported = append(unsupported, "noProxy")
+	}
+
+	if am.ProxyFromEnvironment != nil {
+		unsupported = append(unsupported, "proxyFromEnvironment")
+	}
+
+	if len(am.ProxyConnectHeader) > 0 {
+		unsupported = append(unsupported, "proxyConnectHeader")
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("%s not supported by Thanos", strings.Join(unsupported, ", "))
+	}
+
+	var tlsConfig *monitoringv1.SafeTLSConfig
+	if am.TLSConfig != nil {
+		tlsConfig = &am.TLSConfig.SafeTLSConfig
+	}
+
+	if err := validateHTTPConfig(am.BasicAuth, am.Authorization, tlsConfig); err != nil {
+		return err
+	}
+
+	if am.APIVersion != nil {
+		switch *am.APIVersion {
+		case monitoringv1.AlertmanagerAPIVersion1, monitoringv1.AlertmanagerAPIVersion2:
+		default:
+			return fmt.Errorf("unknown apiVersion %q", *am.APIVersion)
+		}
+	}
+
+	return nil
+}
+
+// addAlertmanagersToStore validates the Alertmanager endpoints and loads the
+// referenced credentials and TLS assets into the store.
+func addAlertmanagersToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, ams []monitoringv1.AlertmanagerEndpoints) error {
+	for i, am := range ams {
+		if err := validateAlertmanagerEndpoints(am); err != nil {
+			return fmt.Errorf("alertmanagers[%d]: %w", i, err)
+		}
+
+		if err := store.AddBasicAuth(ctx, namespace, am.BasicAuth); err != nil {
+			return fmt.Errorf("alertmanagers[%d]: %w", i, err)
+		}
+
+		if err := store.AddSafeAuthorizationCredentials(ctx, namespace, am.Authorization); err != nil {
+			return fmt.Errorf("alertmanagers[%d]: %w", i, err)
+		}
+
+		if err := store.AddTLSConfig(ctx, namespace, am.TLSConfig); err != nil {
+			return fmt.Errorf("alertmanagers[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// alertmanagerEndpointsAddress returns the Thanos DNS service discovery
+// address for the Alertmanager endpoints.
+func alertmanagerEndpointsAddress(am monitoringv1.AlertmanagerEndpoints, defaultNamespace string) string {
+	host := fmt.Sprintf("%s.%s.svc", am.Name, ptr.Deref(am.Namespace, defaultNamespace))
+
+	if am.Port.Type == intstr.String {
+		return fmt.Sprintf("dnssrv+_%s._tcp.%s", am.Port.StrVal, host)
+	}
+
+	return fmt.Sprintf("dns+%s:%d", host, am.Port.IntVal)
+}
+
+// selectedAlertmanagerAddresses returns the Thanos DNS addresses of the
+// replicas of the Alertmanager resource. The pods are resolved individually
+// from their hostname under the governing service because the default
+// governing service is shared by all the Alertmanager resources of the
+// namespace and a custom governing service isn't guaranteed to expose a port
+// named after spec.portName.
+func selectedAlertmanagerAddresses(am *monitoringv1.Alertmanager) []string {
+	replicas := ptr.Deref(am.Spec.Replicas, 1)
+	addrs := make([]string, 0, max(replicas, 0))
+	for i := range replicas {
+		addrs = append(addrs, fmt.Sprintf(
+			"dns+alertmanager-%s-%d.%s.%s.svc:%d",
+			am.Name,
+			i,
+			ptr.Deref(am.Spec.ServiceName, alertmanagerOperatedServiceName),
+			am.Namespace,
+			alertmanagerWebPort,
+		))
+	}
+
+	return addrs
+}
+
+// makeAlertmanagersConfig validates the Alertmanager endpoints of the
+// ThanosRuler resource and returns the generated Thanos configuration. It
+// returns nil if the resource doesn't define typed endpoints nor selects
+// Alertmanager resources.
+func (o *Operator) makeAlertmanagersConfig(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler) ([]byte, error) {
+	if !hasAlertmanagers(tr) {
+		return nil, nil
+	}
+
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(tr.Spec.Version, ""), operator.DefaultThanosVersion)
+	version, err := semver.ParseTolerant(thanosVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Thanos Ruler version %q: %w", thanosVersion, err)
+	}
+
+	if version.LT(minAlertmanagersConfigVersion) {
+		return nil, fmt.Errorf("thanos alertmanagers configuration requires at least version %q: current version %q", minAlertmanagersConfigVersion, version)
+	}
+
+	if err := addAlertmanagersToStore(ctx, store, tr.Namespace, tr.Spec.Alertmanagers); err != nil {
+		return nil, err
+	}
+
+	selected, err := o.selectAlertmanagers(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	return generateAlertmanagersConfig(tr, selected, store.ForNamespace(tr.Namespace))
+}
+
+// generateAlertmanagersConfig returns the Thanos Ruler configuration for
+// sending alerts to the Alertmanager endpoints and the Alertmanager resources.
+// The credentials and TLS assets must have been loaded into the store before.
+func generateAlertmanagersConfig(tr *monitoringv1.ThanosRuler, selected []*monitoringv1.Alertmanager, store assets.StoreGetter) ([]byte, error) {
+	amConfigs := make([]yaml.MapSlice, 0, len(tr.Spec.Alertmanagers)+len(selected))
+
+	for i, am := range tr.Spec.Alertmanagers {
+		httpConfig, err := generateHTTPConfig(am.BasicAuth, am.Authorization, am.ProxyURL, am.TLSConfig, store)
+		if err != nil {
+			return nil, fmt.Errorf("alertmanagers[%d]: %w", i, err)
+		}
+
+		cfg := yaml.MapSlice{}
+		if len(httpConfig) > 0 {
+			cfg = append(cfg, yaml.MapItem{Key: "http_config", Value: httpConfig})
+		}
+
+		cfg = append(cfg,
+			yaml.MapItem{Key: "static_configs", Value: []string{alertmanagerEndpointsAddress(am, tr.Namespace)}},
+			yaml.MapItem{Key: "scheme", Value: schemeOrDefault(am.Scheme.String())},
+		)
+
+		if am.PathPrefix != nil {
+			cfg = append(cfg, yaml.MapItem{Key: "path_prefix", Value: *am.PathPrefix})
+		}
+
+		if am.Timeout != nil {
+			cfg = append(cfg, yaml.MapItem{Key: "timeout", Value: *am.Timeout})
+		}
+
+		if am.APIVersion != nil {
+			cfg = append(cfg, yaml.MapItem{Key: "api_version", Value: strings.ToLower(string(*am.APIVersion))})
+		}
+
+		amConfigs = append(amConfigs, cfg)
+	}
+
+	for _, am := range selected {
+		if am.Spec.ListenLocal {
+			continue
+		}
+
+		if am.Spec.Web != nil && am.Spec.Web.TLSConfig != nil {
+			return nil, fmt.Errorf("alertmanagerSelector: Alertmanager %s/%s: web TLS isn't supported, use alertmanagers instead", am.Namespace, am.Name)
+		}
+
+		addrs := selectedAlertmanagerAddresses(am)
+		if len(addrs) == 0 {
+			continue
+		}
+
+		cfg := yaml.MapSlice{
+			{Key: "static_configs", Value: addrs},
+			{Key: "scheme", Value: "http"},
+		}
+
+		if am.Spec.RoutePrefix != "" {
+			cfg = append(cfg, yaml.MapItem{Key: "path_prefix", Value: am.Spec.RoutePrefix})
+		}
+
+		cfg = append(cfg, yaml.MapItem{Key: "api_version", Value: "v2"})
+
+		amConfigs = append(amConfigs, cfg)
+	}
+
+	return yaml.Marshal(yaml.MapSlice{{Key: "alertmanagers", Value: amConfigs}})
+}
+
+func schemeOrDefault(scheme string) string {
+	if scheme == "" {
+		return "http"
+	}
+
+	return scheme
+}
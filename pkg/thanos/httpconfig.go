@@ -0,0 +1,129 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+)
+
+// validateHTTPConfig checks that the HTTP client settings are supported by
+// the Thanos HTTP client configuration.
+func validateHTTPConfig(basicAuth *monitoringv1.BasicAuth, authorization *monitoringv1.SafeAuthorization, tlsConfig *monitoringv1.SafeTLSConfig) error {
+	if basicAuth != nil && authorization != nil {
+		return errors.New("\"basicAuth\" and \"authorization\" can't be set at the same time, at most one of them must be defined")
+	}
+
+	if authorization != nil {
+		if t := strings.TrimSpace(authorization.Type); t != "" && !strings.EqualFold(t, "Bearer") {
+			return fmt.Errorf("authorization: type %q not supported by Thanos, only \"Bearer\" is", t)
+		}
+	}
+
+	if tlsConfig != nil && (tlsConfig.MinVersion != nil || tlsConfig.MaxVersion != nil) {
+		return errors.New("tlsConfig.minVersion, tlsConfig.maxVersion not supported by Thanos")
+	}
+
+	return nil
+}
+
+// generateHTTPConfig returns the Thanos HTTP client configuration. The
+// credentials and TLS assets must have been loaded into the store before.
+func generateHTTPConfig(basicAuth *monitoringv1.BasicAuth, authorization *monitoringv1.SafeAuthorization, proxyURL *string, tls *monitoringv1.TLSConfig, store assets.StoreGetter) (yaml.MapSlice, error) {
+	httpConfig := yaml.MapSlice{}
+
+	if basicAuth != nil {
+		username, err := store.GetSecretKey(basicAuth.Username)
+		if err != nil {
+			return nil, fmt.Errorf("basicAuth: %w", err)
+		}
+
+		password, err := store.GetSecretKey(basicAuth.Password)
+		if err != nil {
+			return nil, fmt.Errorf("basicAuth: %w", err)
+		}
+
+		httpConfig = append(httpConfig, yaml.MapItem{
+			Key: "basic_auth",
+			Value: yaml.MapSlice{
+				{Key: "username", Value: string(username)},
+				{Key: "password", Value: string(password)},
+			},
+		})
+	}
+
+	if authorization != nil && authorization.Credentials != nil {
+		credentials, err := store.GetSecretKey(*authorization.Credentials)
+		if err != nil {
+			return nil, fmt.Errorf("authorization: %w", err)
+		}
+
+		httpConfig = append(httpConfig, yaml.MapItem{Key: "bearer_token", Value: string(credentials)})
+	}
+
+	if proxyURL != nil {
+		httpConfig = append(httpConfig, yaml.MapItem{Key: "proxy_url", Value: *proxyURL})
+	}
+
+	if tls != nil {
+		if tlsConfig := generateTLSConfig(tls, store); len(tlsConfig) > 0 {
+			httpConfig = append(httpConfig, yaml.MapItem{Key: "tls_config", Value: tlsConfig})
+		}
+	}
+
+	return httpConfig, nil
+}
+
+func generateTLSConfig(tls *monitoringv1.TLSConfig, store assets.StoreGetter) yaml.MapSlice {
+	tlsConfig := yaml.MapSlice{}
+
+	switch {
+	case tls.CAFile != "":
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "ca_file", Value: tls.CAFile})
+	case tls.CA.Secret != nil || tls.CA.ConfigMap != nil:
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "ca_file", Value: path.Join(tlsAssetsDir, store.TLSAsset(tls.CA))})
+	}
+
+	switch {
+	case tls.CertFile != "":
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "cert_file", Value: tls.CertFile})
+	case tls.Cert.Secret != nil || tls.Cert.ConfigMap != nil:
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "cert_file", Value: path.Join(tlsAssetsDir, store.TLSAsset(tls.Cert))})
+	}
+
+	switch {
+	case tls.KeyFile != "":
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "key_file", Value: tls.KeyFile})
+	case tls.KeySecret != nil:
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "key_file", Value: path.Join(tlsAssetsDir, store.TLSAsset(tls.KeySecret))})
+	}
+
+	if tls.ServerName != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "server_name", Value: *tls.ServerName})
+	}
+
+	if tls.InsecureSkipVerify != nil {
+		tlsConfig = append(tlsConfig, yaml.MapItem{Key: "insecure_skip_verify", Value: *tls.InsecureSkipVerify})
+	}
+
+	return tlsConfig
+}
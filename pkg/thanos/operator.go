@@ -15,10 +15,14 @@
 package thanos
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -28,9 +32,11 @@ import (
 	"gopkg.in/yaml.v2"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/metadata"
@@ -72,22 +78,34 @@ type Operator struct {
 	logger   *slog.Logger
 	accessor *operator.Accessor
 
-	controllerID string
-	repairPolicy operator.RepairPolicy
+	controllerID  string
+	leaderElected <-chan struct{}
+	repairPolicy  operator.RepairPolicy
 
 	thanosRulerInfs *informers.ForResource
 	cmapInfs        *informers.ForResource
 	ruleInfs        *informers.ForResource
 	ssetInfs        *informers.ForResource
+	secrInfs        *informers.ForResource
+	amInfs          *informers.ForResource
+	pdbInfs         *informers.ForResource
+
+	exposureSyncer *operator.ExposureSyncer
 
 	rr *operator.ResourceReconciler
 
 	nsThanosRulerInf cache.SharedIndexInformer
 	nsRuleInf        cache.SharedIndexInformer
 
-	metrics             *operator.Metrics
-	reconciliations     *operator.ReconciliationTracker
-	canReadStorageClass bool
+	metrics                      *operator.Metrics
+	reconciliations              *operator.ReconciliationTracker
+	debugStore                   *operator.DebugStore
+	canReadStorageClass          bool
+	volumeExpansionEnabled       bool
+	podDisruptionBudgetSupported bool
+	ingressExposureSupported     bool
+	httpRouteExposureSupported   bool
+	canSelectAlertmanagers       bool
 
 	newEventRecorder operator.NewEventRecorderFunc
 
@@ -119,6 +137,46 @@ func WithStorageClassValidation() ControllerOption {
 	}
 }
 
+// WithVolumeExpansion tells that the controller should expand the persistent
+// volume claims when the storage size increases.
+func WithVolumeExpansion() ControllerOption {
+	return func(o *Operator) {
+		o.volumeExpansionEnabled = true
+	}
+}
+
+// WithPodDisruptionBudget tells that the controller can manage
+// PodDisruptionBudget objects.
+func WithPodDisruptionBudget() ControllerOption {
+	return func(o *Operator) {
+		o.podDisruptionBudgetSupported = true
+	}
+}
+
+// WithIngressExposure tells that the controller can manage Ingress objects
+// exposing the Thanos Ruler pods.
+func WithIngressExposure() ControllerOption {
+	return func(o *Operator) {
+		o.ingressExposureSupported = true
+	}
+}
+
+// WithHTTPRouteExposure tells that the controller can manage Gateway API
+// HTTPRoute objects exposing the Thanos Ruler pods.
+func WithHTTPRouteExposure() ControllerOption {
+	return func(o *Operator) {
+		o.httpRouteExposureSupported = true
+	}
+}
+
+// WithAlertmanagerSelection tells that the controller can watch Alertmanager
+// resources selected by the ThanosRuler spec.
+func WithAlertmanagerSelection() ControllerOption {
+	return func(o *Operator) {
+		o.canSelectAlertmanagers = true
+	}
+}
+
 // WithConfigResourceStatus tells that the controller can manage the status of
 // configuration resources.
 func WithConfigResourceStatus() ControllerOption {
@@ -127,6 +185,15 @@ func WithConfigResourceStatus() ControllerOption {
 	}
 }
 
+// WithDebugStore tells that the controller should record the debug
+// information of the last reconciliation of the ThanosRuler objects in the
+// store. Without a store, the controller doesn't compute it.
+func WithDebugStore(ds *operator.DebugStore) ControllerOption {
+	return func(o *Operator) {
+		o.debugStore = ds
+	}
+}
+
 // New creates a new controller.
 func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger *slog.Logger, r prometheus.Registerer, options ...ControllerOption) (*Operator, error) {
 	logger = logger.With("component", controllerName)
@@ -165,15 +232,10 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		newEventRecorder: c.EventRecorderFactory(client, controllerName),
 		reconciliations:  &operator.ReconciliationTracker{},
 		controllerID:     c.ControllerID,
+		leaderElected:    c.LeaderElected,
 		repairPolicy:     c.RepairPolicy,
-		config: Config{
-			ReloaderConfig:         c.ReloaderConfig,
-			ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
-			Annotations:            c.Annotations,
-			Labels:                 c.Labels,
-			LocalHost:              c.LocalHost,
-		},
-		finalizerSyncer: operator.NewNoopFinalizerSyncer(),
+		config:           newConfig(c),
+		finalizerSyncer:  operator.NewNoopFinalizerSyncer(),
 	}
 	for _, opt := range options {
 		opt(o)
@@ -261,6 +323,73 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 		return nil, fmt.Errorf("error creating statefulset informers: %w", err)
 	}
 
+	if o.podDisruptionBudgetSupported {
+		o.pdbInfs, err = informers.NewInformersForResourceWithTransform(
+			informers.NewMetadataInformerFactory(
+				c.Namespaces.ThanosRulerAllowList,
+				c.Namespaces.DenyList,
+				o.mdClient,
+				resyncPeriod,
+				func(options *metav1.ListOptions) {
+					options.LabelSelector = labelSelectorForStatefulSets()
+				},
+			),
+			policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets"),
+			informers.OwnedObjectMetadataStrip(operator.PodDisruptionBudgetGVK()),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating poddisruptionbudget informers: %w", err)
+		}
+	}
+
+	o.exposureSyncer, err = operator.NewExposureSyncer(
+		o.kclient,
+		o.dclient,
+		o.mdClient,
+		c.Namespaces.ThanosRulerAllowList,
+		c.Namespaces.DenyList,
+		resyncPeriod,
+		o.ingressExposureSupported,
+		o.httpRouteExposureSupported,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating exposure syncer: %w", err)
+	}
+
+	o.secrInfs, err = informers.NewInformersForResourceWithTransform(
+		informers.NewMetadataInformerFactory(
+			c.Namespaces.ThanosRulerAllowList,
+			c.Namespaces.DenyList,
+			o.mdClient,
+			resyncPeriod,
+			func(options *metav1.ListOptions) {
+				options.FieldSelector = c.SecretListWatchFieldSelector.String()
+				options.LabelSelector = c.SecretListWatchLabelSelector.String()
+			},
+		),
+		corev1.SchemeGroupVersion.WithResource(string(corev1.ResourceSecrets)),
+		informers.PartialObjectMetadataStrip(operator.SecretGVK()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating secrets informers: %w", err)
+	}
+
+	if o.canSelectAlertmanagers {
+		o.amInfs, err = informers.NewInformersForResource(
+			informers.NewMonitoringInformerFactories(
+				c.Namespaces.ThanosRulerAllowList,
+				c.Namespaces.DenyList,
+				mclient,
+				resyncPeriod,
+				nil,
+			),
+			monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error creating alertmanager informers: %w", err)
+		}
+	}
+
 	newNamespaceInformer := func(o *Operator, allowList map[string]struct{}) (cache.SharedIndexInformer, error) {
 		lw, privileged, err := listwatch.NewNamespaceListWatchFromClient(
 			ctx,
@@ -300,17 +429,41 @@ func New(ctx context.Context, restConfig *rest.Config, c operator.Config, logger
 	return o, nil
 }
 
+func newConfig(c operator.Config) Config {
+	return Config{
+		ReloaderConfig:         c.ReloaderConfig,
+		ThanosDefaultBaseImage: c.ThanosDefaultBaseImage,
+		Annotations:            c.Annotations,
+		Labels:                 c.Labels,
+		LocalHost:              c.LocalHost,
+	}
+}
+
 // waitForCacheSync waits for the informers' caches to be synced.
 func (o *Operator) waitForCacheSync(ctx context.Context) error {
-	for _, infs := range []struct {
+	type namedInformers struct {
 		name                 string
 		informersForResource *informers.ForResource
-	}{
+	}
+
+	infs := []namedInformers{
 		{"ThanosRuler", o.thanosRulerInfs},
 		{"ConfigMap", o.cmapInfs},
 		{"PrometheusRule", o.ruleInfs},
 		{"StatefulSet", o.ssetInfs},
-	} {
+		{"Secret", o.secrInfs},
+	}
+	if o.amInfs != nil {
+		infs = append(infs, namedInformers{"Alertmanager", o.amInfs})
+	}
+	if o.pdbInfs != nil {
+		infs = append(infs, namedInformers{"PodDisruptionBudget", o.pdbInfs})
+	}
+	for name, exposureInfs := range o.exposureSyncer.Informers() {
+		infs = append(infs, namedInformers{name, exposureInfs})
+	}
+
+	for _, infs := range infs {
 		for _, inf := range infs.informersForResource.GetInformers() {
 			if !operator.WaitForNamedCacheSync(ctx, "thanos", o.logger.With("informer", infs.name), inf.Informer()) {
 				return fmt.Errorf("failed to sync cache for %s informer", infs.name)
@@ -362,6 +515,32 @@ func (o *Operator) addHandlers() {
 		),
 	))
 
+	o.secrInfs.AddEventHandler(operator.NewEventHandler(
+		o.logger,
+		o.accessor,
+		o.metrics,
+		operator.SecretGVK().Kind,
+		o.enqueueForThanosRulerNamespace,
+		operator.WithFilter(operator.ResourceVersionChanged),
+		operator.WithFilter(operator.HasReferenceFunc(o.thanosRulerInfs, o.reconciliations)),
+	))
+
+	if o.amInfs != nil {
+		o.amInfs.AddEventHandler(operator.NewEventHandler(
+			o.logger,
+			o.accessor,
+			o.metrics,
+			monitoringv1.AlertmanagersKind,
+			o.enqueueForThanosRulerNamespace,
+			operator.WithFilter(
+				operator.AnyFilter(
+					operator.GenerationChanged,
+					operator.LabelsChanged,
+				),
+			),
+		))
+	}
+
 	// The controller needs to watch the namespaces in which the rules live
 	// because a label change on a namespace may trigger a configuration
 	// change.
@@ -385,9 +564,24 @@ func (o *Operator) Run(ctx context.Context) error {
 		go o.nsThanosRulerInf.Run(ctx.Done())
 	}
 	go o.ssetInfs.Start(ctx.Done())
+	go o.secrInfs.Start(ctx.Done())
+	if o.pdbInfs != nil {
+		go o.pdbInfs.Start(ctx.Done())
+	}
+	o.exposureSyncer.Start(ctx.Done())
+	if o.amInfs != nil {
+		go o.amInfs.Start(ctx.Done())
+	}
 	if err := o.waitForCacheSync(ctx); err != nil {
 		return err
 	}
+	o.metrics.Ready().Set(1)
+
+	// Wait for the leadership before reconciling the objects. The informers
+	// keep running in the meantime so that the caches are warm on failover.
+	if !operator.WaitForLeadership(ctx, o.leaderElected) {
+		return nil
+	}
 
 	// Refresh the status of the existing ThanosRuler objects.
 	_ = o.thanosRulerInfs.ListAll(labels.Everything(), func(obj any) {
@@ -399,7 +593,6 @@ func (o *Operator) Run(ctx context.Context) error {
 	// TODO(simonpasquier): watch for ThanosRuler pods instead of polling.
 	go operator.StatusPoller(ctx, o)
 
-	o.metrics.Ready().Set(1)
 	<-ctx.Done()
 	return nil
 }
@@ -418,9 +611,9 @@ func (o *Operator) RefreshStatusFor(obj metav1.Object) {
 	o.rr.EnqueueForStatus(obj)
 }
 
-func thanosKeyToStatefulSetKey(key string) string {
+func thanosKeyToStatefulSetKey(key string, shard int32) string {
 	keyParts := strings.Split(key, "/")
-	return keyParts[0] + "/thanos-ruler-" + keyParts[1]
+	return keyParts[0] + "/" + statefulSetName(keyParts[1], shard)
 }
 
 func (o *Operator) handleNamespaceUpdate(oldo, curo any) {
@@ -489,6 +682,7 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 
 	if tr == nil {
 		o.reconciliations.ForgetObject(key)
+		o.debugStore.ForgetObject(key)
 		// Dependent resources are cleaned up by K8s via OwnerReferences
 		return closure, nil
 	}
@@ -512,6 +706,7 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 	// Check if the Thanos instance is marked for deletion.
 	if o.rr.DeletionInProgress(tr) {
 		o.reconciliations.ForgetObject(key)
+		o.debugStore.ForgetObject(key)
 		return closure, nil
 	}
 
@@ -526,6 +721,14 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, err
 	}
 
+	if tr.Spec.PodDisruptionBudget != nil && !o.podDisruptionBudgetSupported {
+		return closure, errors.New("podDisruptionBudget: the operator isn't allowed to manage PodDisruptionBudget objects")
+	}
+
+	if err := o.exposureSyncer.Check(tr.Spec.Exposure); err != nil {
+		return closure, err
+	}
+
 	selectedRules, err := o.selectPrometheusRules(tr, logger)
 	if err != nil {
 		return closure, err
@@ -542,13 +745,35 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 	}
 
 	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(ctx, tr, selectedRules, logger)
+	// Record the debug information even if the reconciliation fails.
+	o.setDebugInfo(key, selectedRules, ruleConfigMapNames)
 	if err != nil {
 		return closure, err
 	}
 
 	assetStore := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
 
-	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr); err != nil {
+	// configFiles holds the configuration files generated from the typed
+	// fields of the ThanosRuler spec.
+	configFiles := map[string][]byte{}
+
+	amConfig, err := o.makeAlertmanagersConfig(ctx, assetStore, tr)
+	if err != nil {
+		return closure, fmt.Errorf("failed to generate alertmanagers config: %w", err)
+	}
+	if amConfig != nil {
+		configFiles[alertmanagersConfigFile] = amConfig
+	}
+
+	queryConfig, err := makeQueryConfig(ctx, assetStore, tr)
+	if err != nil {
+		return closure, fmt.Errorf("failed to generate query config: %w", err)
+	}
+	if queryConfig != nil {
+		configFiles[queryConfigFile] = queryConfig
+	}
+
+	if err := o.createOrUpdateRulerConfigSecret(ctx, assetStore, tr, configFiles); err != nil {
 		return closure, fmt.Errorf("failed to synchronize ruler config secret: %w", err)
 	}
 
@@ -557,6 +782,10 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		return closure, fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
 	}
 
+	// Track the referenced secrets and configmaps to trigger a reconciliation
+	// when they change.
+	o.reconciliations.UpdateReferenceTracker(key, assetStore.RefTracker())
+
 	if err := o.createOrUpdateWebConfigSecret(ctx, tr); err != nil {
 		return closure, fmt.Errorf("failed to synchronize web config secret: %w", err)
 	}
@@ -574,58 +803,167 @@ func (o *Operator) sync(ctx context.Context, key string) (func(context.Context)
 		}
 	}
 
-	// Ensure we have a StatefulSet running Thanos deployed.
-	existingStatefulSet, err := o.getStatefulSetFromThanosRulerKey(key)
-	if err != nil {
-		return closure, err
-	}
+	ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
+	pdbClient := o.kclient.PolicyV1().PodDisruptionBudgets(tr.Namespace)
 
-	shouldCreate := false
-	if existingStatefulSet == nil {
-		shouldCreate = true
-		existingStatefulSet = &appsv1.StatefulSet{}
-	}
+	// Ensure we have a StatefulSet running Thanos deployed for each shard.
+	expected := expectedStatefulSetNames(tr)
+	for shard, ssetName := range expected {
+		logger := logger.With("statefulset", ssetName, "shard", fmt.Sprintf("%d", shard))
 
-	if o.rr.DeletionInProgress(existingStatefulSet) {
-		return closure, nil
+		existingStatefulSet, err := o.getStatefulSetFromThanosRulerKey(key, int32(shard))
+		if err != nil {
+			return closure, err
+		}
+
+		shouldCreate := false
+		if existingStatefulSet == nil {
+			shouldCreate = true
+			existingStatefulSet = &appsv1.StatefulSet{}
+		}
+
+		if o.rr.DeletionInProgress(existingStatefulSet) {
+			continue
+		}
+
+		newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames[shard], configFiles, existingStatefulSet.Spec)
+		if err != nil {
+			return closure, err
+		}
+
+		sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames[shard], newSSetInputHash, tlsAssets, int32(shard))
+		if err != nil {
+			return closure, fmt.Errorf("failed to generate statefulset: %w", err)
+		}
+
+		operator.SanitizeSTS(sset)
+
+		if o.podDisruptionBudgetSupported && tr.Spec.PodDisruptionBudget != nil {
+			pdb := makePodDisruptionBudget(tr, sset)
+			if err := k8s.CreateOrUpdatePodDisruptionBudget(ctx, pdbClient, pdb); err != nil {
+				return closure, fmt.Errorf("failed to synchronize PodDisruptionBudget: %w", err)
+			}
+		}
+
+		if shouldCreate {
+			logger.Debug("creating statefulset")
+			if _, err := k8s.CreateStatefulSetOrPatchLabels(ctx, ssetClient, sset); err != nil {
+				return closure, fmt.Errorf("failed to create thanos statefulset: %w", err)
+			}
+
+			continue
+		}
+
+		if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
+			logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
+			continue
+		}
+
+		logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationKey])
+		if o.volumeExpansionEnabled {
+			expanded, err := operator.ExpandStatefulSetVolumes(ctx, o.kclient, existingStatefulSet, sset)
+			if err != nil {
+				return closure, err
+			}
+
+			if expanded {
+				o.metrics.StsDeleteCreateCounter().Inc()
+				logger.Info("recreating StatefulSet because the persistent volume claims have been expanded")
+				continue
+			}
+		}
+
+		if err = k8s.ForceUpdateStatefulSet(ctx, ssetClient, sset, func(reason string) {
+			o.metrics.StsDeleteCreateCounter().Inc()
+			logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", reason)
+		}); err != nil {
+			return closure, err
+		}
 	}
 
-	newSSetInputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames, existingStatefulSet.Spec)
-	if err != nil {
+	if err := o.exposureSyncer.Sync(ctx, makeExposedWorkload(tr), tr.Spec.Exposure,
+		operator.WithAnnotations(o.config.Annotations),
+		operator.WithLabels(o.config.Labels),
+		operator.WithOwner(tr),
+	); err != nil {
 		return closure, err
 	}
 
-	sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames, newSSetInputHash, tlsAssets)
-	if err != nil {
-		return closure, fmt.Errorf("failed to generate statefulset: %w", err)
+	selector := labels.SelectorFromSet(makeSelectorLabels(tr.Name))
+	if o.podDisruptionBudgetSupported {
+		// Delete the PodDisruptionBudgets of the removed shards (or all of
+		// them if the PodDisruptionBudget isn't configured anymore).
+		keep := sets.New[string]()
+		if tr.Spec.PodDisruptionBudget != nil {
+			keep.Insert(expected...)
+		}
+
+		var pdbs []metav1.Object
+		err := o.pdbInfs.ListAllByNamespace(tr.Namespace, selector, func(obj any) {
+			pdbs = append(pdbs, obj.(metav1.Object))
+		})
+		if err != nil {
+			return closure, fmt.Errorf("listing PodDisruptionBudget resources failed: %w", err)
+		}
+
+		if err := k8s.DeletePodDisruptionBudgets(ctx, pdbClient, tr, pdbs, keep); err != nil {
+			return closure, err
+		}
 	}
 
-	operator.SanitizeSTS(sset)
+	// Delete the statefulsets of the removed shards.
+	ssets := sets.New(expected...)
+	var deleteErrs []error
+	err = o.ssetInfs.ListAllByNamespace(tr.Namespace, selector, func(obj any) {
+		s := obj.(*appsv1.StatefulSet)
 
-	ssetClient := o.kclient.AppsV1().StatefulSets(tr.Namespace)
-	if shouldCreate {
-		logger.Debug("creating statefulset")
-		if _, err := k8s.CreateStatefulSetOrPatchLabels(ctx, ssetClient, sset); err != nil {
-			return closure, fmt.Errorf("failed to create thanos statefulset: %w", err)
+		if ssets.Has(s.Name) || o.rr.DeletionInProgress(s) {
+			return
 		}
 
-		return closure, nil
+		if err := ssetClient.Delete(ctx, s.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)}); err != nil && !apierrors.IsNotFound(err) {
+			deleteErrs = append(deleteErrs, fmt.Errorf("failed to delete StatefulSet %s: %w", s.GetName(), err))
+		}
+	})
+	if err != nil {
+		return closure, fmt.Errorf("listing StatefulSet resources failed: %w", err)
 	}
-
-	if newSSetInputHash == existingStatefulSet.Annotations[operator.InputHashAnnotationKey] {
-		logger.Debug("new statefulset generation inputs match current, skipping any actions", "hash", newSSetInputHash)
-		return closure, nil
+	if len(deleteErrs) > 0 {
+		return closure, fmt.Errorf("failed to clean up excess StatefulSets: %w", errors.Join(deleteErrs...))
 	}
 
-	logger.Debug("new hash differs from the existing value", "new", newSSetInputHash, "existing", existingStatefulSet.Annotations[operator.InputHashAnnotationKey])
-	if err = k8s.ForceUpdateStatefulSet(ctx, ssetClient, sset, func(reason string) {
-		o.metrics.StsDeleteCreateCounter().Inc()
-		logger.Info("recreating StatefulSet because the update operation wasn't possible", "reason", reason)
-	}); err != nil {
-		return closure, err
+	return closure, nil
+}
+
+// makeExposedWorkload returns the description of the Thanos Ruler pods
+// exposed by the operator.
+func makeExposedWorkload(tr *monitoringv1.ThanosRuler) operator.ExposedWorkload {
+	w := operator.ExposedWorkload{
+		Name:            prefixedName(tr.Name),
+		Namespace:       tr.Namespace,
+		ApplicationName: applicationNameLabelValue,
+		InstanceName:    tr.Name,
+		ExternalURL:     tr.Spec.ExternalPrefix,
+		RoutePrefix:     tr.Spec.RoutePrefix,
+		PortName:        cmp.Or(tr.Spec.PortName, defaultPortName),
+		Port:            10902,
+		Selector:        makeSelectorLabels(tr.Name),
+	}
+
+	for shard, ssetName := range expectedStatefulSetNames(tr) {
+		selector := makeSelectorLabels(tr.Name)
+		if shardsNumber(tr) > 1 {
+			selector[shardLabelName] = fmt.Sprintf("%d", shard)
+		}
+
+		w.Shards = append(w.Shards, operator.ExposedShard{
+			StatefulSetName: ssetName,
+			Replicas:        ptr.Deref(tr.Spec.Replicas, minReplicas),
+			Selector:        selector,
+		})
 	}
 
-	return closure, nil
+	return w
 }
 
 func (o *Operator) recordDeprecatedFields(key string, logger *slog.Logger, tr *monitoringv1.ThanosRuler) {
@@ -645,6 +983,21 @@ func (o *Operator) recordDeprecatedFields(key string, logger *slog.Logger, tr *m
 	}
 }
 
+// setDebugInfo records the selected PrometheusRule resources and the names of
+// the rule ConfigMaps (for all shards) in the debug store.
+func (o *Operator) setDebugInfo(key string, rules operator.PrometheusRuleSelection, ruleConfigMapNames [][]string) {
+	if o.debugStore == nil {
+		return
+	}
+
+	o.debugStore.Set(key, operator.WorkloadDebugInfo{
+		LastReconciliation: time.Now(),
+		Namespaces:         map[string][]string{monitoringv1.PrometheusRuleKind: rules.Namespaces()},
+		Resources:          map[string][]operator.ResourceDebugInfo{monitoringv1.PrometheusRuleKind: operator.NewResourcesDebugInfo(rules.Selected())},
+		RuleConfigMaps:     slices.Concat(ruleConfigMapNames...),
+	})
+}
+
 // updateConfigResourcesStatus updates the status of the selected configuration
 // resources (PrometheusRules).
 func (o *Operator) updateConfigResourcesStatus(ctx context.Context, tr *monitoringv1.ThanosRuler, rules operator.PrometheusRuleSelection) error {
@@ -681,10 +1034,10 @@ func (o *Operator) configResStatusCleanup(ctx context.Context, tr *monitoringv1.
 }
 
 // getStatefulSetFromThanosRulerKey returns a copy of the StatefulSet object
-// corresponding to the ThanosRuler object identified by key.
+// corresponding to the shard of the ThanosRuler object identified by key.
 // If the object is not found, it returns a nil pointer without error.
-func (o *Operator) getStatefulSetFromThanosRulerKey(key string) (*appsv1.StatefulSet, error) {
-	ssetName := thanosKeyToStatefulSetKey(key)
+func (o *Operator) getStatefulSetFromThanosRulerKey(key string, shard int32) (*appsv1.StatefulSet, error) {
+	ssetName := thanosKeyToStatefulSetKey(key, shard)
 
 	obj, err := o.ssetInfs.Get(ssetName)
 	if err != nil {
@@ -713,30 +1066,75 @@ func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 		return nil
 	}
 
-	sset, err := o.getStatefulSetFromThanosRulerKey(key)
-	if err != nil {
-		return fmt.Errorf("failed to get StatefulSet: %w", err)
-	}
+	var (
+		ssets    []*appsv1.StatefulSet
+		statuses []monitoringv1.ConditionStatus
+		reasons  []string
+		messages []string
+	)
+	tr.Status.Replicas, tr.Status.UpdatedReplicas, tr.Status.AvailableReplicas, tr.Status.UnavailableReplicas = 0, 0, 0, 0
+	for shard := range shardsNumber(tr) {
+		sset, err := o.getStatefulSetFromThanosRulerKey(key, shard)
+		if err != nil {
+			return fmt.Errorf("failed to get StatefulSet: %w", err)
+		}
 
-	if sset != nil && o.rr.DeletionInProgress(sset) {
-		return nil
-	}
+		if sset != nil && o.rr.DeletionInProgress(sset) {
+			return nil
+		}
 
-	stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
-	if err != nil {
-		return fmt.Errorf("failed to retrieve statefulset state: %w", err)
-	}
+		stsReporter, err := operator.NewStatefulSetReporter(ctx, o.kclient, sset)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve statefulset state: %w", err)
+		}
+
+		ready := len(stsReporter.ReadyPods())
+		tr.Status.Replicas += int32(len(stsReporter.Pods))
+		tr.Status.UpdatedReplicas += int32(len(stsReporter.UpdatedPods()))
+		tr.Status.AvailableReplicas += int32(ready)
+		tr.Status.UnavailableReplicas += int32(len(stsReporter.Pods) - ready)
+
+		status, reason := stsReporter.StatusAndReasonForAvailableCondition(tr.ExpectedReplicas())
+		statuses, reasons = append(statuses, status), append(reasons, reason)
+		if sset != nil {
+			ssets = append(ssets, sset)
+		}
+
+		if status == monitoringv1.ConditionTrue {
+			continue
+		}
+
+		for _, p := range stsReporter.Pods {
+			if m := p.Message(); m != "" {
+				messages = append(messages, fmt.Sprintf("pod %s: %s", p.Name, m))
+			}
+		}
 
-	availableCondition := stsReporter.Update(tr)
-	if availableCondition.Status != monitoringv1.ConditionTrue {
 		if err := stsReporter.Repair(ctx, o.logger, o.repairPolicy); err != nil {
 			o.logger.Warn("failed to repair statefulset", "err", err)
 		}
 	}
 
+	availableCondition := monitoringv1.Condition{
+		Type:    monitoringv1.Available,
+		Status:  operator.CombinedConditionStatus(statuses),
+		Reason:  operator.CombinedConditionReason(reasons),
+		Message: strings.Join(messages, "\n"),
+		LastTransitionTime: metav1.Time{
+			Time: time.Now().UTC(),
+		},
+		ObservedGeneration: tr.Generation,
+	}
+
 	reconciledCondition := o.reconciliations.GetCondition(key, tr.Generation)
-	tr.Status.Conditions = operator.UpdateConditions(tr.Status.Conditions, availableCondition, reconciledCondition)
+	conditions := []monitoringv1.Condition{availableCondition, reconciledCondition}
+	if cond := o.storageResizedCondition(ctx, tr, ssets); cond != nil {
+		conditions = append(conditions, *cond)
+	}
+	tr.Status.Conditions = operator.UpdateConditions(tr.Status.Conditions, conditions...)
 	tr.Status.Paused = tr.Spec.Paused
+	tr.Status.Shards = shardsNumber(tr)
+	tr.Status.Selector = labels.SelectorFromSet(makeSelectorLabels(tr.Name)).String()
 
 	if _, err = o.mclient.MonitoringV1().ThanosRulers(tr.Namespace).ApplyStatus(ctx, applyConfigurationFromThanosRuler(tr), metav1.ApplyOptions{FieldManager: k8s.PrometheusOperatorFieldManager, Force: true}); err != nil {
 		return fmt.Errorf("failed to apply status subresource: %w", err)
@@ -745,7 +1143,17 @@ func (o *Operator) UpdateStatus(ctx context.Context, key string) error {
 	return nil
 }
 
-func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *operator.ShardedSecret, ruleConfigMapNames []string, ss appsv1.StatefulSetSpec) (string, error) {
+// storageResizedCondition returns the StorageResized condition of the
+// ThanosRuler or nil if it shouldn't be reported.
+func (o *Operator) storageResizedCondition(ctx context.Context, tr *monitoringv1.ThanosRuler, ssets []*appsv1.StatefulSet) *monitoringv1.Condition {
+	if !o.volumeExpansionEnabled {
+		return nil
+	}
+
+	return operator.VolumeExpansionCondition(ctx, o.kclient, tr.Spec.Storage, ssets, tr.Status.Conditions, tr.Generation)
+}
+
+func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *operator.ShardedSecret, ruleConfigMapNames []string, configFiles map[string][]byte, ss appsv1.StatefulSetSpec) (string, error) {
 
 	// The controller should ignore any changes to RevisionHistoryLimit field because
 	// it may be modified by external actors.
@@ -760,6 +1168,10 @@ func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *opera
 		StatefulSetSpec        appsv1.StatefulSetSpec
 		RuleConfigMaps         []string `hash:"set"`
 		ShardedSecret          *operator.ShardedSecret
+		// Thanos Ruler doesn't reload the alertmanagers and query
+		// configuration files so the pods need to be restarted when they
+		// change.
+		ConfigFiles map[string][]byte
 	}{
 		ThanosRulerLabels:      tr.Labels,
 		ThanosRulerAnnotations: tr.Annotations,
@@ -768,6 +1180,7 @@ func createSSetInputHash(tr monitoringv1.ThanosRuler, c Config, tlsAssets *opera
 		StatefulSetSpec:        ss,
 		RuleConfigMaps:         ruleConfigMapNames,
 		ShardedSecret:          tlsAssets,
+		ConfigFiles:            configFiles,
 	},
 		nil,
 	)
@@ -873,7 +1286,9 @@ func applyConfigurationFromThanosRuler(a *monitoringv1.ThanosRuler) *monitoringv
 		WithReplicas(a.Status.Replicas).
 		WithAvailableReplicas(a.Status.AvailableReplicas).
 		WithUpdatedReplicas(a.Status.UpdatedReplicas).
-		WithUnavailableReplicas(a.Status.UnavailableReplicas)
+		WithUnavailableReplicas(a.Status.UnavailableReplicas).
+		WithShards(a.Status.Shards).
+		WithSelector(a.Status.Selector)
 
 	for _, condition := range a.Status.Conditions {
 		trac.WithConditions(
@@ -930,7 +1345,7 @@ func labelSelectorForStatefulSets() string {
 	)
 }
 
-func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler) error {
+func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler, configFiles map[string][]byte) error {
 	sClient := o.kclient.CoreV1().Secrets(tr.GetNamespace())
 
 	s := &corev1.Secret{
@@ -1078,6 +1493,8 @@ func (o *Operator) createOrUpdateRulerConfigSecret(ctx context.Context, store *a
 	}
 	s.Data[rwConfigFile] = rwConfig
 
+	maps.Copy(s.Data, configFiles)
+
 	if err = k8s.CreateOrUpdateSecret(ctx, sClient, s); err != nil {
 		return err
 	}
@@ -23,14 +23,32 @@ import (
 	"gotest.tools/v3/golden"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	monitoringfake "github.com/prometheus-operator/prometheus-operator/pkg/client/versioned/fake"
+	"github.com/prometheus-operator/prometheus-operator/pkg/informers"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 )
 
+const caCert = `-----BEGIN CERTIFICATE-----
+MIIB4zCCAY2gAwIBAgIUf+9T+SQuY7RzRfLrT/m3ZLZa/nswDQYJKoZIhvcNAQEL
+BQAwRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUxITAfBgNVBAoM
+GEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDAgFw0yMDEwMTkxMzA1MDlaGA8yMTIw
+MDkyNTEzMDUwOVowRTELMAkGA1UEBhMCQVUxEzARBgNVBAgMClNvbWUtU3RhdGUx
+ITAfBgNVBAoMGEludGVybmV0IFdpZGdpdHMgUHR5IEx0ZDBcMA0GCSqGSIb3DQEB
+AQUAA0sAMEgCQQDbXwmz6fkHnfs3p5dirgW/m5G1eOSddS8atIwhOzaYSNG03/Z4
+P6HWCGDCgUg77fOsX+tzYWkXy0T+GwQrTLDdAgMBAAGjUzBRMB0GA1UdDgQWBBTC
+CNvaPTFE1Xt5WUREDoF/mTOg7DAfBgNVHSMEGDAWgBTCCNvaPTFE1Xt5WUREDoF/
+mTOg7DAPBgNVHRMBAf8EBTADAQH/MA0GCSqGSIb3DQEBCwUAA0EAzhzA2n5nSnka
+k9iw9ZHayRBSgnGAYKFdiGyvceKPzR3LJ8vMdGeYh/TSHHgZ4QSam/J7vHWCkJmc
+7c98vpkIaw==
+-----END CERTIFICATE-----`
+
 func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -125,7 +143,7 @@ func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
 				},
 			)
 
-			err := o.createOrUpdateRulerConfigSecret(context.Background(), sb, tr)
+			err := o.createOrUpdateRulerConfigSecret(context.Background(), sb, tr, nil)
 			if tc.expectErr {
 				require.Error(t, err)
 				return
@@ -138,3 +156,386 @@ func TestCreateOrUpdateRulerConfigSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeAlertmanagersConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		version       string
+		alertmanagers []monitoringv1.AlertmanagerEndpoints
+		selector      *metav1.LabelSelector
+		golden        string
+		expectErr     bool
+	}{
+		{
+			name: "endpoints with auth and TLS",
+			alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+				{
+					Name: "alertmanager-operated",
+					Port: intstr.FromString("web"),
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "am-auth"},
+							Key:                  "username",
+						},
+						Password: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "am-auth"},
+							Key:                  "password",
+						},
+					},
+					TLSConfig: &monitoringv1.TLSConfig{
+						SafeTLSConfig: monitoringv1.SafeTLSConfig{
+							CA: monitoringv1.SecretOrConfigMap{
+								ConfigMap: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "am-tls"},
+									Key:                  "ca.crt",
+								},
+							},
+							ServerName: ptr.To("alertmanager.example.com"),
+						},
+					},
+					Scheme:     ptr.To(monitoringv1.SchemeHTTPS),
+					PathPrefix: ptr.To("/am"),
+					Timeout:    ptr.To(monitoringv1.Duration("10s")),
+					APIVersion: ptr.To(monitoringv1.AlertmanagerAPIVersion2),
+				},
+				{
+					Namespace: ptr.To("monitoring"),
+					Name:      "alertmanager",
+					Port:      intstr.FromInt(9093),
+					Authorization: &monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "am-auth"},
+							Key:                  "token",
+						},
+					},
+					ProxyConfig: monitoringv1.ProxyConfig{
+						ProxyURL: ptr.To("http://proxy.example.com"),
+					},
+				},
+			},
+			golden: "alertmanagers_endpoints_config.golden",
+		},
+		{
+			name:     "selected alertmanager among others in the namespace",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			golden:   "alertmanagers_selector_config.golden",
+		},
+		{
+			name:     "selected alertmanager with custom governing service",
+			selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "c"}},
+			golden:   "alertmanagers_selector_custom_service_config.golden",
+		},
+		{
+			name:      "selected alertmanager with web TLS",
+			selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"team": "d"}},
+			expectErr: true,
+		},
+		{
+			name: "missing secret",
+			alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+				{
+					Name: "alertmanager-operated",
+					Port: intstr.FromString("web"),
+					Authorization: &monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unsupported sigv4",
+			alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+				{
+					Name:  "alertmanager-operated",
+					Port:  intstr.FromString("web"),
+					Sigv4: &monitoringv1.Sigv4{},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unsupported authorization type",
+			alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+				{
+					Name: "alertmanager-operated",
+					Port: intstr.FromString("web"),
+					Authorization: &monitoringv1.SafeAuthorization{
+						Type: "Basic",
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "am-auth"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:    "unsupported version",
+			version: "v0.9.0",
+			alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+				{
+					Name: "alertmanager-operated",
+					Port: intstr.FromString("web"),
+				},
+			},
+			expectErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			amInfs, err := informers.NewInformersForResource(
+				informers.NewMonitoringInformerFactories(
+					map[string]struct{}{"default": {}},
+					nil,
+					monitoringfake.NewClientset(
+						&monitoringv1.Alertmanager{
+							ObjectMeta: metav1.ObjectMeta{Name: "main", Namespace: "default", Labels: map[string]string{"team": "a"}},
+							Spec: monitoringv1.AlertmanagerSpec{
+								Replicas:    ptr.To(int32(2)),
+								RoutePrefix: "/alertmanager",
+							},
+						},
+						&monitoringv1.Alertmanager{
+							ObjectMeta: metav1.ObjectMeta{Name: "local", Namespace: "default", Labels: map[string]string{"team": "a"}},
+							Spec: monitoringv1.AlertmanagerSpec{
+								ListenLocal: true,
+							},
+						},
+						&monitoringv1.Alertmanager{
+							ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", Labels: map[string]string{"team": "b"}},
+						},
+						&monitoringv1.Alertmanager{
+							ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "default", Labels: map[string]string{"team": "c"}},
+							Spec: monitoringv1.AlertmanagerSpec{
+								ServiceName: ptr.To("alertmanager-custom"),
+								PortName:    "http-web",
+							},
+						},
+						&monitoringv1.Alertmanager{
+							ObjectMeta: metav1.ObjectMeta{Name: "tls", Namespace: "default", Labels: map[string]string{"team": "d"}},
+							Spec: monitoringv1.AlertmanagerSpec{
+								Web: &monitoringv1.AlertmanagerWebSpec{
+									WebConfigFileFields: monitoringv1.WebConfigFileFields{
+										TLSConfig: &monitoringv1.WebTLSConfig{},
+									},
+								},
+							},
+						},
+					),
+					0,
+					nil,
+				),
+				monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName),
+			)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			amInfs.Start(ctx.Done())
+			require.True(t, cache.WaitForCacheSync(ctx.Done(), amInfs.HasSynced))
+
+			o := &Operator{logger: slog.Default(), amInfs: amInfs}
+			tr := &monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ThanosRulerSpec{
+					Version:              new(operator.StringValOrDefault(tc.version, operator.DefaultThanosVersion)),
+					Alertmanagers:        tc.alertmanagers,
+					AlertmanagerSelector: tc.selector,
+				},
+			}
+			cs := fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-auth",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+						"token":    []byte("token"),
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "am-tls",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"ca.crt": caCert,
+					},
+				},
+			)
+			sb := assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1())
+
+			amConfig, err := o.makeAlertmanagersConfig(ctx, sb, tr)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			golden.Assert(t, string(amConfig), tc.golden)
+
+			if len(tc.alertmanagers) > 0 {
+				require.NotEmpty(t, sb.TLSAssets())
+			}
+		})
+	}
+}
+
+func TestMakeQueryConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		version   string
+		endpoints []monitoringv1.ThanosQueryEndpoint
+		golden    string
+		expectErr bool
+	}{
+		{
+			name: "endpoints with auth and TLS",
+			endpoints: []monitoringv1.ThanosQueryEndpoint{
+				{
+					Name: "thanos-query",
+					Port: intstr.FromString("http"),
+					BasicAuth: &monitoringv1.BasicAuth{
+						Username: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "query-auth"},
+							Key:                  "username",
+						},
+						Password: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "query-auth"},
+							Key:                  "password",
+						},
+					},
+					TLSConfig: &monitoringv1.SafeTLSConfig{
+						CA: monitoringv1.SecretOrConfigMap{
+							ConfigMap: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "query-tls"},
+								Key:                  "ca.crt",
+							},
+						},
+					},
+					Scheme:     ptr.To(monitoringv1.SchemeHTTPS),
+					PathPrefix: ptr.To("/thanos"),
+				},
+				{
+					Namespace: ptr.To("monitoring"),
+					Name:      "thanos-query",
+					Port:      intstr.FromInt(10902),
+					Authorization: &monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "query-auth"},
+							Key:                  "token",
+						},
+					},
+				},
+				{
+					Name:         "thanos-query-headless",
+					Port:         intstr.FromString("http"),
+					DNSDiscovery: ptr.To(monitoringv1.ThanosDNSDiscoverySRVNoA),
+				},
+			},
+			golden: "query_config.golden",
+		},
+		{
+			name: "A discovery with named port",
+			endpoints: []monitoringv1.ThanosQueryEndpoint{
+				{
+					Name:         "thanos-query",
+					Port:         intstr.FromString("http"),
+					DNSDiscovery: ptr.To(monitoringv1.ThanosDNSDiscoveryA),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "SRV discovery with numeric port",
+			endpoints: []monitoringv1.ThanosQueryEndpoint{
+				{
+					Name:         "thanos-query",
+					Port:         intstr.FromInt(10902),
+					DNSDiscovery: ptr.To(monitoringv1.ThanosDNSDiscoverySRV),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing secret",
+			endpoints: []monitoringv1.ThanosQueryEndpoint{
+				{
+					Name: "thanos-query",
+					Port: intstr.FromString("http"),
+					Authorization: &monitoringv1.SafeAuthorization{
+						Credentials: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:    "unsupported version",
+			version: "v0.10.0",
+			endpoints: []monitoringv1.ThanosQueryEndpoint{
+				{
+					Name: "thanos-query",
+					Port: intstr.FromString("http"),
+				},
+			},
+			expectErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewClientset(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "query-auth",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"username": []byte("user"),
+						"password": []byte("pass"),
+						"token":    []byte("token"),
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "query-tls",
+						Namespace: "default",
+					},
+					Data: map[string]string{
+						"ca.crt": caCert,
+					},
+				},
+			)
+			sb := assets.NewStoreBuilder(cs.CoreV1(), cs.CoreV1())
+
+			tr := &monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "default",
+				},
+				Spec: monitoringv1.ThanosRulerSpec{
+					Version:           new(operator.StringValOrDefault(tc.version, operator.DefaultThanosVersion)),
+					QueryAPIEndpoints: tc.endpoints,
+				},
+			}
+
+			queryConfig, err := makeQueryConfig(context.Background(), sb, tr)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			golden.Assert(t, string(queryConfig), tc.golden)
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+const queryConfigFile = "query.yaml"
+
+var minQueryConfigVersion = semver.MustParse("0.11.0")
+
+// hasQueryAPIEndpoints returns true if the operator generates the Thanos query
+// configuration for the ThanosRuler resource.
+func hasQueryAPIEndpoints(tr *monitoringv1.ThanosRuler) bool {
+	return tr.Spec.QueryConfig == nil && len(tr.Spec.QueryAPIEndpoints) > 0
+}
+
+// dnsDiscoveryMode returns the DNS discovery mode of the endpoint, defaulting
+// to SRV for named ports and A for numeric ports.
+func dnsDiscoveryMode(ep monitoringv1.ThanosQueryEndpoint) monitoringv1.ThanosDNSDiscoveryMode {
+	if ep.DNSDiscovery != nil {
+		return *ep.DNSDiscovery
+	}
+
+	if ep.Port.Type == intstr.String {
+		return monitoringv1.ThanosDNSDiscoverySRV
+	}
+
+	return monitoringv1.ThanosDNSDiscoveryA
+}
+
+// queryEndpointAddress returns the Thanos DNS service discovery address of
+// the Thanos Query endpoint.
+func queryEndpointAddress(ep monitoringv1.ThanosQueryEndpoint, defaultNamespace string) (string, error) {
+	host := fmt.Sprintf("%s.%s.svc", ep.Name, ptr.Deref(ep.Namespace, defaultNamespace))
+
+	switch mode := dnsDiscoveryMode(ep); mode {
+	case monitoringv1.ThanosDNSDiscoveryA:
+		if ep.Port.Type == intstr.String {
+			return "", fmt.Errorf("dnsDiscovery %q requires a numeric port, got %q", mode, ep.Port.StrVal)
+		}
+
+		return fmt.Sprintf("dns+%s:%d", host, ep.Port.IntVal), nil
+
+	case monitoringv1.ThanosDNSDiscoverySRV, monitoringv1.ThanosDNSDiscoverySRVNoA:
+		if ep.Port.Type != intstr.String {
+			return "", fmt.Errorf("dnsDiscovery %q requires a named port, got %d", mode, ep.Port.IntVal)
+		}
+
+		prefix := "dnssrv+"
+		if mode == monitoringv1.ThanosDNSDiscoverySRVNoA {
+			prefix = "dnssrvnoa+"
+		}
+
+		return fmt.Sprintf("%s_%s._tcp.%s", prefix, ep.Port.StrVal, host), nil
+
+	default:
+		return "", fmt.Errorf("unknown dnsDiscovery %q", mode)
+	}
+}
+
+// addQueryAPIEndpointsToStore validates the Thanos Query endpoints and loads
+// the referenced credentials and TLS assets into the store.
+func addQueryAPIEndpointsToStore(ctx context.Context, store *assets.StoreBuilder, namespace string, eps []monitoringv1.ThanosQueryEndpoint) error {
+	for i, ep := range eps {
+		if _, err := queryEndpointAddress(ep, namespace); err != nil {
+			return fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+
+		if err := validateHTTPConfig(ep.BasicAuth, ep.Authorization, ep.TLSConfig); err != nil {
+			return fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+
+		if err := store.AddBasicAuth(ctx, namespace, ep.BasicAuth); err != nil {
+			return fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+
+		if err := store.AddSafeAuthorizationCredentials(ctx, namespace, ep.Authorization); err != nil {
+			return fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+
+		if err := store.AddSafeTLSConfig(ctx, namespace, ep.TLSConfig); err != nil {
+			return fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// makeQueryConfig validates the Thanos Query endpoints of the ThanosRuler
+// resource and returns the generated Thanos query configuration. It returns
+// nil if the resource doesn't define typed endpoints.
+func makeQueryConfig(ctx context.Context, store *assets.StoreBuilder, tr *monitoringv1.ThanosRuler) ([]byte, error) {
+	if !hasQueryAPIEndpoints(tr) {
+		return nil, nil
+	}
+
+	thanosVersion := operator.StringValOrDefault(ptr.Deref(tr.Spec.Version, ""), operator.DefaultThanosVersion)
+	version, err := semver.ParseTolerant(thanosVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse Thanos Ruler version %q: %w", thanosVersion, err)
+	}
+
+	if version.LT(minQueryConfigVersion) {
+		return nil, fmt.Errorf("thanos query configuration requires at least version %q: current version %q", minQueryConfigVersion, version)
+	}
+
+	if err := addQueryAPIEndpointsToStore(ctx, store, tr.Namespace, tr.Spec.QueryAPIEndpoints); err != nil {
+		return nil, err
+	}
+
+	return generateQueryConfig(tr, store.ForNamespace(tr.Namespace))
+}
+
+// generateQueryConfig returns the Thanos Ruler configuration for querying
+// the Thanos Query endpoints. The credentials and TLS assets must have been
+// loaded into the store before.
+func generateQueryConfig(tr *monitoringv1.ThanosRuler, store assets.StoreGetter) ([]byte, error) {
+	queryConfigs := make([]yaml.MapSlice, 0, len(tr.Spec.QueryAPIEndpoints))
+
+	for i, ep := range tr.Spec.QueryAPIEndpoints {
+		address, err := queryEndpointAddress(ep, tr.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+
+		var tlsConfig *monitoringv1.TLSConfig
+		if ep.TLSConfig != nil {
+			tlsConfig = &monitoringv1.TLSConfig{SafeTLSConfig: *ep.TLSConfig}
+		}
+
+		httpConfig, err := generateHTTPConfig(ep.BasicAuth, ep.Authorization, nil, tlsConfig, store)
+		if err != nil {
+			return nil, fmt.Errorf("queryAPIEndpoints[%d]: %w", i, err)
+		}
+
+		cfg := yaml.MapSlice{}
+		if len(httpConfig) > 0 {
+			cfg = append(cfg, yaml.MapItem{Key: "http_config", Value: httpConfig})
+		}
+
+		cfg = append(cfg,
+			yaml.MapItem{Key: "static_configs", Value: []string{address}},
+			yaml.MapItem{Key: "scheme", Value: schemeOrDefault(ep.Scheme.String())},
+		)
+
+		if ep.PathPrefix != nil {
+			cfg = append(cfg, yaml.MapItem{Key: "path_prefix", Value: *ep.PathPrefix})
+		}
+
+		queryConfigs = append(queryConfigs, cfg)
+	}
+
+	return yaml.Marshal(queryConfigs)
+}
@@ -0,0 +1,142 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thanos
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus-operator/prometheus-operator/pkg/assets"
+	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
+)
+
+// Render generates the configuration, the rule files and the statefulsets of
+// the ThanosRuler resource from the given objects, without interacting with
+// a Kubernetes API server.
+//
+// When the ThanosRuler resource has more than one shard, the rule files are
+// prefixed by the shard's directory (e.g. "shard-1/").
+func Render(ctx context.Context, logger *slog.Logger, c operator.Config, tr *monitoringv1.ThanosRuler, objects operator.RenderObjects) (files, ruleFiles map[string]string, ssets []*appsv1.StatefulSet, err error) {
+	o := &Operator{
+		kclient:  objects.KubeClient(),
+		mclient:  objects.MonitoringClient(),
+		logger:   logger,
+		accessor: operator.NewAccessor(logger),
+		config:   newConfig(c),
+
+		nsThanosRulerInf: objects.NamespaceInformer(),
+		nsRuleInf:        objects.NamespaceInformer(),
+
+		metrics:                operator.NewMetrics(prometheus.NewRegistry()),
+		reconciliations:        &operator.ReconciliationTracker{},
+		newEventRecorder:       c.EventRecorderFactory(objects.KubeClient(), controllerName),
+		canSelectAlertmanagers: true,
+	}
+
+	o.ruleInfs, err = objects.NewInformersForResource(ctx, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.PrometheusRuleName))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create prometheusrule informers: %w", err)
+	}
+
+	o.amInfs, err = objects.NewInformersForResource(ctx, monitoringv1.SchemeGroupVersion.WithResource(monitoringv1.AlertmanagerName))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create alertmanager informers: %w", err)
+	}
+
+	selectedRules, err := o.selectPrometheusRules(tr, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ruleConfigMapNames, err := o.createOrUpdateRuleConfigMaps(ctx, tr, selectedRules, logger)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	shardedRules, err := selectedRules.ShardedRuleFiles(int(shardsNumber(tr)))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	store := assets.NewStoreBuilder(o.kclient.CoreV1(), o.kclient.CoreV1())
+	configFiles := map[string][]byte{}
+
+	amConfig, err := o.makeAlertmanagersConfig(ctx, store, tr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate alertmanagers config: %w", err)
+	}
+	if amConfig != nil {
+		configFiles[alertmanagersConfigFile] = amConfig
+	}
+
+	queryConfig, err := makeQueryConfig(ctx, store, tr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate query config: %w", err)
+	}
+	if queryConfig != nil {
+		configFiles[queryConfigFile] = queryConfig
+	}
+
+	if err := o.createOrUpdateRulerConfigSecret(ctx, store, tr, configFiles); err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to generate ruler config secret: %w", err)
+	}
+
+	s, err := o.kclient.CoreV1().Secrets(tr.Namespace).Get(ctx, rulerConfigSecretName(tr.Name), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tlsAssets, err := operator.ReconcileShardedSecret(ctx, store.TLSAssets(), o.kclient, newTLSAssetSecret(tr, o.config))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to reconcile the TLS secrets: %w", err)
+	}
+
+	files = make(map[string]string, len(s.Data))
+	for k, v := range s.Data {
+		files[k] = string(v)
+	}
+
+	ruleFiles = map[string]string{}
+
+	for shard := range shardsNumber(tr) {
+		for k, v := range shardedRules[shard] {
+			if shardsNumber(tr) > 1 {
+				k = fmt.Sprintf("shard-%d/%s", shard, k)
+			}
+			ruleFiles[k] = v
+		}
+
+		inputHash, err := createSSetInputHash(*tr, o.config, tlsAssets, ruleConfigMapNames[shard], configFiles, appsv1.StatefulSetSpec{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		sset, err := makeStatefulSet(tr, o.config, ruleConfigMapNames[shard], inputHash, tlsAssets, shard)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to generate statefulset: %w", err)
+		}
+		operator.SanitizeSTS(sset)
+
+		ssets = append(ssets, sset)
+	}
+
+	return files, ruleFiles, ssets, nil
+}
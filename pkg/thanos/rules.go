@@ -21,7 +21,10 @@ import (
 	"strings"
 
 	"github.com/prometheus/prometheus/promql/parser"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring"
@@ -83,24 +86,66 @@ func (o *Operator) selectPrometheusRules(t *monitoringv1.ThanosRuler, logger *sl
 	return rules, nil
 }
 
-func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler, rules operator.PrometheusRuleSelection, logger *slog.Logger) ([]string, error) {
-	// Update the corresponding ConfigMap resources.
-	prs := operator.NewPrometheusRuleSyncer(
-		logger,
-		fmt.Sprintf("thanos-ruler-%s", t.Name),
-		o.kclient.CoreV1().ConfigMaps(t.Namespace),
-		labels.Set{labelThanosRulerName: t.Name},
-		[]operator.ObjectOption{
-			operator.WithAnnotations(o.config.Annotations),
-			operator.WithLabels(o.config.Labels),
-			operator.WithManagingOwner(t),
-		},
+// createOrUpdateRuleConfigMaps synchronizes the ConfigMaps holding the rule
+// files of each shard and returns the ConfigMap names indexed by shard.
+func (o *Operator) createOrUpdateRuleConfigMaps(ctx context.Context, t *monitoringv1.ThanosRuler, rules operator.PrometheusRuleSelection, logger *slog.Logger) ([][]string, error) {
+	shards := shardsNumber(t)
+
+	shardedRules, err := rules.ShardedRuleFiles(int(shards))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		cmClient   = o.kclient.CoreV1().ConfigMaps(t.Namespace)
+		configMaps = make([][]string, shards)
+		desired    = sets.New[string]()
 	)
+	for shard := range shards {
+		selector := labels.Set{labelThanosRulerName: t.Name}
+		if shards > 1 {
+			selector[shardLabelName] = fmt.Sprintf("%d", shard)
+		}
 
-	configMaps, err := prs.Sync(ctx, rules.RuleFiles())
+		// Update the corresponding ConfigMap resources.
+		prs := operator.NewPrometheusRuleSyncer(
+			logger.With("shard", shard),
+			statefulSetName(t.Name, shard),
+			cmClient,
+			selector,
+			[]operator.ObjectOption{
+				operator.WithAnnotations(o.config.Annotations),
+				operator.WithLabels(o.config.Labels),
+				operator.WithManagingOwner(t),
+			},
+		)
+
+		names, err := prs.Sync(ctx, shardedRules[shard])
+		if err != nil {
+			return nil, err
+		}
+
+		configMaps[shard] = names
+		desired.Insert(names...)
+	}
+
+	// Delete the ConfigMaps which aren't used anymore (e.g. after a change
+	// of the number of shards).
+	cmList, err := cmClient.List(ctx, metav1.ListOptions{LabelSelector: labels.Set{labelThanosRulerName: t.Name}.String()})
 	if err != nil {
 		return nil, err
 	}
 
+	for _, cm := range cmList.Items {
+		if desired.Has(cm.Name) {
+			continue
+		}
+
+		logger.Debug("deleting excess ConfigMap for PrometheusRule", "configmap", cm.Name)
+		if err := cmClient.Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete excess ConfigMap %q: %w", cm.Name, err)
+		}
+	}
+
 	return configMaps, nil
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/blang/semver/v4"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -49,6 +50,11 @@ const (
 	defaultRetention          = "24h"
 	defaultEvaluationInterval = "15s"
 	defaultReplicaLabelName   = "thanos_ruler_replica"
+	defaultShardLabelName     = "thanos_ruler_shard"
+
+	// shardLabelName is the label identifying the ThanosRuler's shard on
+	// the statefulsets and pods.
+	shardLabelName = "operator.prometheus.io/shard"
 
 	defaultTerminationGracePeriodSeconds = int64(120)
 )
@@ -57,7 +63,21 @@ var (
 	minReplicas int32 = 1
 )
 
-func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, inputHash string, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSet, error) {
+// makePodDisruptionBudget returns the PodDisruptionBudget of the given
+// statefulset. Because the shard label isn't part of the statefulset
+// selector, it is added to the PodDisruptionBudget selector to avoid pods
+// being covered by the PodDisruptionBudgets of all shards.
+func makePodDisruptionBudget(tr *monitoringv1.ThanosRuler, sset *appsv1.StatefulSet) *policyv1.PodDisruptionBudget {
+	pdb := operator.MakePodDisruptionBudget(sset, *tr.Spec.PodDisruptionBudget, operator.WithManagingOwner(tr))
+
+	if shard, found := sset.Spec.Template.Labels[shardLabelName]; found {
+		pdb.Spec.Selector.MatchLabels[shardLabelName] = shard
+	}
+
+	return pdb
+}
+
+func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, inputHash string, tlsSecrets *operator.ShardedSecret, shard int32) (*appsv1.StatefulSet, error) {
 
 	if tr.Spec.Resources.Requests == nil {
 		tr.Spec.Resources.Requests = corev1.ResourceList{}
@@ -66,7 +86,7 @@ func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapN
 		tr.Spec.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("200Mi")
 	}
 
-	spec, err := makeStatefulSetSpec(tr, config, ruleConfigMapNames, tlsSecrets)
+	spec, err := makeStatefulSetSpec(tr, config, ruleConfigMapNames, tlsSecrets, shard)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +94,7 @@ func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapN
 	statefulset := &appsv1.StatefulSet{Spec: *spec}
 	operator.UpdateObject(
 		statefulset,
-		operator.WithName(prefixedName(tr.Name)),
+		operator.WithName(statefulSetName(tr.Name, shard)),
 		operator.WithAnnotations(tr.GetAnnotations()),
 		operator.WithAnnotations(config.Annotations),
 		operator.WithInputHashAnnotation(inputHash),
@@ -137,8 +157,8 @@ func makeStatefulSet(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapN
 	return statefulset, nil
 }
 
-func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, tlsSecrets *operator.ShardedSecret) (*appsv1.StatefulSetSpec, error) {
-	if tr.Spec.QueryConfig == nil && len(tr.Spec.QueryEndpoints) < 1 {
+func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfigMapNames []string, tlsSecrets *operator.ShardedSecret, shard int32) (*appsv1.StatefulSetSpec, error) {
+	if tr.Spec.QueryConfig == nil && len(tr.Spec.QueryAPIEndpoints) < 1 && len(tr.Spec.QueryEndpoints) < 1 {
 		return nil, errors.New(tr.GetName() + ": thanos ruler requires query config or at least one query endpoint to be specified")
 	}
 
@@ -203,6 +223,10 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	}
 
 	trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="$(POD_NAME)"`, defaultReplicaLabelName)})
+	if shardsNumber(tr) > 1 {
+		// The shard label identifies the shard which evaluated the rules.
+		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="%d"`, defaultShardLabelName, shard)})
+	}
 	labels := operator.Map(tr.Spec.Labels)
 	for _, k := range labels.SortedKeys() {
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "label", Value: fmt.Sprintf(`%s="%s"`, k, labels[k])})
@@ -258,6 +282,19 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	if tr.Spec.QueryConfig != nil {
 		trVolumes, trVolumeMounts, fullPath = mountSecretKey(trVolumes, trVolumeMounts, tr.Spec.QueryConfig, "query-config")
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "query.config-file", Value: fullPath})
+	} else if hasQueryAPIEndpoints(tr) {
+		trVolumes, trVolumeMounts, fullPath = mountSecretKey(
+			trVolumes,
+			trVolumeMounts,
+			&corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: rulerConfigSecretName(tr.Name),
+				},
+				Key: queryConfigFile,
+			},
+			"query-api-config",
+		)
+		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "query.config-file", Value: fullPath})
 	} else if len(tr.Spec.QueryEndpoints) > 0 {
 		for _, endpoint := range tr.Spec.QueryEndpoints {
 			trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "query", Value: endpoint})
@@ -267,6 +304,19 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	if tr.Spec.AlertManagersConfig != nil {
 		trVolumes, trVolumeMounts, fullPath = mountSecretKey(trVolumes, trVolumeMounts, tr.Spec.AlertManagersConfig, "alertmanager-config")
 		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.config-file", Value: fullPath})
+	} else if hasAlertmanagers(tr) {
+		trVolumes, trVolumeMounts, fullPath = mountSecretKey(
+			trVolumes,
+			trVolumeMounts,
+			&corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: rulerConfigSecretName(tr.Name),
+				},
+				Key: alertmanagersConfigFile,
+			},
+			"alertmanagers-config",
+		)
+		trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.config-file", Value: fullPath})
 	} else if len(tr.Spec.AlertManagersURL) > 0 {
 		for _, url := range tr.Spec.AlertManagersURL {
 			trCLIArgs = append(trCLIArgs, monitoringv1.Argument{Name: "alertmanagers.url", Value: url})
@@ -431,6 +481,16 @@ func makeStatefulSetSpec(tr *monitoringv1.ThanosRuler, config Config, ruleConfig
 	maps.Copy(podLabels, makeSelectorLabels(tr.Name))
 	selectorLabels := maps.Clone(podLabels)
 
+	// The shard label is a pod label only: adding it to the selector would
+	// change the immutable selector of the first statefulset whenever the
+	// number of shards goes from 1 to more (and back). The statefulsets of
+	// the different shards don't fight over the pods because the pods have
+	// a controller owner reference while the PodDisruptionBudgets add the
+	// shard label to their selector (see makePodDisruptionBudget).
+	if shardsNumber(tr) > 1 {
+		podLabels[shardLabelName] = fmt.Sprintf("%d", shard)
+	}
+
 	podLabels[operator.ApplicationVersionLabelKey] = version.String()
 	podAnnotations[operator.DefaultContainerAnnotationKey] = "thanos-ruler"
 
@@ -582,6 +642,33 @@ func prefixedName(name string) string {
 	return fmt.Sprintf("thanos-ruler-%s", name)
 }
 
+// statefulSetName returns the name of the statefulset for the given shard.
+// The first shard keeps the name used before sharding was introduced.
+func statefulSetName(name string, shard int32) string {
+	if shard == 0 {
+		return prefixedName(name)
+	}
+
+	return fmt.Sprintf("%s-shard-%d", prefixedName(name), shard)
+}
+
+// expectedStatefulSetNames returns the names of the statefulsets indexed by
+// shard.
+func expectedStatefulSetNames(tr *monitoringv1.ThanosRuler) []string {
+	shards := shardsNumber(tr)
+	names := make([]string, 0, shards)
+	for shard := range shards {
+		names = append(names, statefulSetName(tr.Name, shard))
+	}
+
+	return names
+}
+
+// shardsNumber returns the number of shards of the ThanosRuler resource.
+func shardsNumber(tr *monitoringv1.ThanosRuler) int32 {
+	return max(ptr.Deref(tr.Spec.Shards, 1), 1)
+}
+
 func volumeName(name string) string {
 	return fmt.Sprintf("%s-data", prefixedName(name))
 }
@@ -24,6 +24,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
@@ -125,7 +126,7 @@ func TestStatefulSetLabelsAndAnnotations(t *testing.T) {
 				},
 			},
 		},
-	}, testConfig, nil, "abc", &operator.ShardedSecret{})
+	}, testConfig, nil, "abc", &operator.ShardedSecret{}, 0)
 
 	require.NoError(t, err)
 
@@ -145,7 +146,7 @@ func TestThanosDefaultBaseImageFlag(t *testing.T) {
 
 	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
 		Spec: monitoringv1.ThanosRulerSpec{QueryEndpoints: emptyQueryEndpoints},
-	}, thanosBaseImageConfig, nil, "", &operator.ShardedSecret{})
+	}, thanosBaseImageConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	image := sset.Spec.Template.Spec.Containers[0].Image
@@ -278,7 +279,7 @@ func TestStatefulSetVolumes(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, []string{"rules-configmap-one"}, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, []string{"rules-configmap-one"}, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 	require.Equal(t, expected.Spec.Template.Spec.Volumes, sset.Spec.Template.Spec.Volumes)
 	require.Equal(t, expected.Spec.Template.Spec.Containers[0].VolumeMounts, sset.Spec.Template.Spec.Containers[0].VolumeMounts)
@@ -304,7 +305,7 @@ func TestTracing(t *testing.T) {
 				Key: secretKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
@@ -352,7 +353,7 @@ func TestTracingFile(t *testing.T) {
 				Key: testKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	{
@@ -396,7 +397,7 @@ func TestObjectStorage(t *testing.T) {
 				Key: secretKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
@@ -444,7 +445,7 @@ func TestObjectStorageFile(t *testing.T) {
 				Key: testKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	{
@@ -488,7 +489,7 @@ func TestAlertRelabel(t *testing.T) {
 				Key: secretKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
@@ -523,6 +524,86 @@ func TestAlertRelabel(t *testing.T) {
 	}
 }
 
+func TestAlertmanagers(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		spec        monitoringv1.ThanosRulerSpec
+		expectedArg string
+	}{
+		{
+			name: "alertmanagers",
+			spec: monitoringv1.ThanosRulerSpec{
+				Alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+					{Name: "alertmanager-operated", Port: intstr.FromString("web")},
+				},
+			},
+			expectedArg: "--alertmanagers.config-file=/etc/thanos/config/alertmanagers-config/alertmanagers.yaml",
+		},
+		{
+			name: "alertmanager selector",
+			spec: monitoringv1.ThanosRulerSpec{
+				AlertmanagerSelector: &metav1.LabelSelector{},
+				AlertManagersURL:     []string{"http://alertmanager:9093"},
+			},
+			expectedArg: "--alertmanagers.config-file=/etc/thanos/config/alertmanagers-config/alertmanagers.yaml",
+		},
+		{
+			name: "alertmanagersConfig takes precedence",
+			spec: monitoringv1.ThanosRulerSpec{
+				Alertmanagers: []monitoringv1.AlertmanagerEndpoints{
+					{Name: "alertmanager-operated", Port: intstr.FromString("web")},
+				},
+				AlertManagersConfig: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "alertmanagers"},
+					Key:                  "config.yaml",
+				},
+			},
+			expectedArg: "--alertmanagers.config-file=/etc/thanos/config/alertmanager-config/config.yaml",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.spec.QueryEndpoints = emptyQueryEndpoints
+			sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec:       tc.spec,
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
+			require.NoError(t, err)
+
+			args := sset.Spec.Template.Spec.Containers[0].Args
+			require.Contains(t, args, tc.expectedArg)
+			for _, arg := range args {
+				require.NotContains(t, arg, "--alertmanagers.url")
+			}
+		})
+	}
+}
+
+func TestQueryAPIEndpoints(t *testing.T) {
+	sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: monitoringv1.ThanosRulerSpec{
+			QueryAPIEndpoints: []monitoringv1.ThanosQueryEndpoint{
+				{Name: "thanos-query", Port: intstr.FromString("http")},
+			},
+			QueryEndpoints: []string{"thanos-query:10902"},
+		},
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
+	require.NoError(t, err)
+
+	args := sset.Spec.Template.Spec.Containers[0].Args
+	require.Contains(t, args, "--query.config-file=/etc/thanos/config/query-api-config/query.yaml")
+	require.NotContains(t, args, "--query=thanos-query:10902")
+
+	var found bool
+	for _, v := range sset.Spec.Template.Spec.Volumes {
+		if v.Name == "query-api-config" {
+			require.Equal(t, "thanos-ruler-foo-config", v.Secret.SecretName)
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
 func TestAlertRelabelFile(t *testing.T) {
 	testPath := "/vault/secret/config.yaml"
 	testKey := "thanos-alertrelabel-config-secret"
@@ -536,7 +617,7 @@ func TestAlertRelabelFile(t *testing.T) {
 				Key: testKey,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	{
@@ -631,7 +712,7 @@ func TestLabelsAndAlertDropLabels(t *testing.T) {
 					Labels:          tc.Labels,
 					AlertDropLabels: tc.AlertDropLabels,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 			require.NoError(t, err)
 
 			ruler := sset.Spec.Template.Spec.Containers[0]
@@ -654,7 +735,7 @@ func TestAdditionalContainers(t *testing.T) {
 	// The base to compare everything against
 	baseSet, err := makeStatefulSet(&monitoringv1.ThanosRuler{
 		Spec: monitoringv1.ThanosRulerSpec{QueryEndpoints: emptyQueryEndpoints},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	// Add an extra container
@@ -667,7 +748,7 @@ func TestAdditionalContainers(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Len(t, addSset.Spec.Template.Spec.Containers, len(baseSet.Spec.Template.Spec.Containers)+1)
@@ -685,7 +766,7 @@ func TestAdditionalContainers(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Equal(t, len(baseSet.Spec.Template.Spec.Containers), len(modSset.Spec.Template.Spec.Containers))
@@ -709,7 +790,7 @@ func TestRetention(t *testing.T) {
 					Retention:      tc.specRetention,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -737,7 +818,7 @@ func TestThanosGrpcArguments(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 	require.NoError(t, err)
 
@@ -785,7 +866,7 @@ func TestGRPCServerTLSCipherSuites(t *testing.T) {
 						CipherSuites: tc.cipherSuites,
 					},
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -833,7 +914,7 @@ func TestGRPCServerTLSCurves(t *testing.T) {
 						Curves: tc.curves,
 					},
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -911,7 +992,7 @@ func TestPodTemplateConfig(t *testing.T) {
 			SchedulerName:      schedulerName,
 			HostUsers:          new(true),
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Equal(t, nodeSelector, sset.Spec.Template.Spec.NodeSelector)
@@ -940,7 +1021,7 @@ func TestExternalQueryURL(t *testing.T) {
 			AlertQueryURL:  "https://example.com/",
 			QueryEndpoints: emptyQueryEndpoints,
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 	require.Equal(t, containerName, sset.Spec.Template.Spec.Containers[0].Name)
 
@@ -964,7 +1045,7 @@ func TestSidecarResources(t *testing.T) {
 		}
 		// thanos-ruler sset will only have a configReloader side car
 		// if it has to mount a ConfigMap
-		sset, err := makeStatefulSet(tr, testConfig, []string{"my-configmap"}, "", &operator.ShardedSecret{})
+		sset, err := makeStatefulSet(tr, testConfig, []string{"my-configmap"}, "", &operator.ShardedSecret{}, 0)
 		require.NoError(t, err)
 		return sset
 	})
@@ -978,13 +1059,13 @@ func TestStatefulSetMinReadySeconds(t *testing.T) {
 		},
 	}
 
-	statefulSet, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{})
+	statefulSet, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 	require.Equal(t, int32(0), statefulSet.MinReadySeconds)
 
 	// assert set correctly if not nil
 	tr.Spec.MinReadySeconds = new(int32(5))
-	statefulSet, err = makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{})
+	statefulSet, err = makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 	require.Equal(t, int32(5), statefulSet.MinReadySeconds)
 }
@@ -998,7 +1079,7 @@ func TestStatefulSetServiceName(t *testing.T) {
 
 	// assert set correctly
 	expect := governingServiceName
-	spec, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{})
+	spec, err := makeStatefulSetSpec(&tr, defaultTestConfig, nil, &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 	require.Equal(t, expect, spec.ServiceName)
 }
@@ -1034,7 +1115,7 @@ func TestStatefulSetPVC(t *testing.T) {
 				VolumeClaimTemplate: pvc,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 	require.NoError(t, err)
 	ssetPvc := sset.Spec.VolumeClaimTemplates[0]
@@ -1064,7 +1145,7 @@ func TestStatefulEmptyDir(t *testing.T) {
 				EmptyDir: &emptyDir,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 	require.NoError(t, err)
 	ssetVolumes := sset.Spec.Template.Spec.Volumes
@@ -1102,7 +1183,7 @@ func TestStatefulSetEphemeral(t *testing.T) {
 				Ephemeral: &ephemeral,
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 	require.NoError(t, err)
 	ssetVolumes := sset.Spec.Template.Spec.Volumes
@@ -1129,7 +1210,7 @@ func TestThanosVersion(t *testing.T) {
 					QueryEndpoints: emptyQueryEndpoints,
 					Version:        new(tc.version),
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			if tc.expectedError {
 				require.Error(t, err)
@@ -1160,7 +1241,7 @@ func TestStatefulSetDNSPolicyAndDNSConfig(t *testing.T) {
 				},
 			},
 		},
-	}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+	}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 	require.NoError(t, err)
 
 	require.Equal(t, corev1.DNSClusterFirst, sset.Spec.Template.Spec.DNSPolicy, "expected DNS policy to match")
@@ -1193,7 +1274,7 @@ func TestStatefulSetenableServiceLinks(t *testing.T) {
 				QueryEndpoints:     emptyQueryEndpoints,
 				EnableServiceLinks: test.enableServiceLinks,
 			},
-		}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+		}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 		require.NoError(t, err)
 
 		if test.expectedEnableServiceLinks != nil {
@@ -1240,7 +1321,7 @@ func TestRuleQueryOffset(t *testing.T) {
 					RuleQueryOffset: ts.ruleQueryOffset,
 					QueryEndpoints:  emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -1294,7 +1375,7 @@ func TestRuleConcurrentEval(t *testing.T) {
 					RuleConcurrentEval: ts.ruleConcurrentEval,
 					QueryEndpoints:     emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -1348,7 +1429,7 @@ func TestRuleOutageTolerance(t *testing.T) {
 					RuleOutageTolerance: ts.ruleOutageTolerance,
 					QueryEndpoints:      emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -1402,7 +1483,7 @@ func TestRuleGracePeriod(t *testing.T) {
 					RuleGracePeriod: ts.ruleGracePeriod,
 					QueryEndpoints:  emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -1444,7 +1525,7 @@ func TestRuleResendDelay(t *testing.T) {
 					ResendDelay:    ts.resendDelay,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -1506,7 +1587,7 @@ func TestEnableFeatures(t *testing.T) {
 					EnableFeatures: ts.enableFeatures,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 
@@ -1554,7 +1635,7 @@ func TestStatefulSetPodManagementPolicy(t *testing.T) {
 					PodManagementPolicy: tc.podManagementPolicy,
 					QueryEndpoints:      emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 			require.Equal(t, tc.exp, sset.Spec.PodManagementPolicy)
@@ -1610,10 +1691,137 @@ func TestStatefulSetUpdateStrategy(t *testing.T) {
 					UpdateStrategy: tc.updateStrategy,
 					QueryEndpoints: emptyQueryEndpoints,
 				},
-			}, defaultTestConfig, nil, "", &operator.ShardedSecret{})
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, 0)
 
 			require.NoError(t, err)
 			require.Equal(t, tc.exp, sset.Spec.UpdateStrategy)
 		})
 	}
 }
+
+func TestStatefulSetShards(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		shards *int32
+		shard  int32
+
+		expectedName  string
+		expectedLabel string
+		expectedArg   string
+	}{
+		{
+			name:         "no sharding",
+			expectedName: "thanos-ruler-test",
+		},
+		{
+			name:         "single shard",
+			shards:       ptr.To(int32(1)),
+			expectedName: "thanos-ruler-test",
+		},
+		{
+			name:          "first shard",
+			shards:        ptr.To(int32(2)),
+			expectedName:  "thanos-ruler-test",
+			expectedLabel: "0",
+			expectedArg:   `--label=thanos_ruler_shard="0"`,
+		},
+		{
+			name:          "second shard",
+			shards:        ptr.To(int32(2)),
+			shard:         1,
+			expectedName:  "thanos-ruler-test-shard-1",
+			expectedLabel: "1",
+			expectedArg:   `--label=thanos_ruler_shard="1"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sset, err := makeStatefulSet(&monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: monitoringv1.ThanosRulerSpec{
+					Shards:         tc.shards,
+					QueryEndpoints: emptyQueryEndpoints,
+				},
+			}, defaultTestConfig, nil, "", &operator.ShardedSecret{}, tc.shard)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedName, sset.Name)
+
+			// The selector must not depend on the number of shards.
+			require.NotContains(t, sset.Spec.Selector.MatchLabels, shardLabelName)
+			require.Equal(t, makeSelectorLabels("test"), sset.Spec.Selector.MatchLabels)
+
+			label, found := sset.Spec.Template.Labels[shardLabelName]
+			require.Equal(t, tc.expectedLabel != "", found)
+			require.Equal(t, tc.expectedLabel, label)
+
+			var args []string
+			for _, c := range sset.Spec.Template.Spec.Containers {
+				if c.Name == containerName {
+					args = c.Args
+				}
+			}
+
+			shardArgs := slices.DeleteFunc(slices.Clone(args), func(arg string) bool {
+				return !strings.Contains(arg, defaultShardLabelName)
+			})
+			if tc.expectedArg == "" {
+				require.Empty(t, shardArgs)
+				return
+			}
+			require.Equal(t, []string{tc.expectedArg}, shardArgs)
+		})
+	}
+}
+
+func TestPodDisruptionBudgetShards(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		shards *int32
+	}{
+		{
+			name: "no sharding",
+		},
+		{
+			name:   "2 shards",
+			shards: ptr.To(int32(2)),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tr := &monitoringv1.ThanosRuler{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: monitoringv1.ThanosRulerSpec{
+					Shards:         tc.shards,
+					QueryEndpoints: emptyQueryEndpoints,
+					PodDisruptionBudget: &monitoringv1.PodDisruptionBudgetSpec{
+						MaxUnavailable: ptr.To(intstr.FromInt(1)),
+					},
+				},
+			}
+
+			var (
+				selectors []labels.Selector
+				podLabels []labels.Set
+			)
+			for shard := range shardsNumber(tr) {
+				sset, err := makeStatefulSet(tr, defaultTestConfig, nil, "", &operator.ShardedSecret{}, shard)
+				require.NoError(t, err)
+
+				pdb := makePodDisruptionBudget(tr, sset)
+				require.Equal(t, sset.Name, pdb.Name)
+
+				selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+				require.NoError(t, err)
+
+				selectors = append(selectors, selector)
+				podLabels = append(podLabels, labels.Set(sset.Spec.Template.Labels))
+			}
+
+			// Each PodDisruptionBudget must select the pods of its shard only.
+			for i, selector := range selectors {
+				for j, pl := range podLabels {
+					require.Equal(t, i == j, selector.Matches(pl), "PodDisruptionBudget %d, pods of shard %d", i, j)
+				}
+			}
+		})
+	}
+}
@@ -477,6 +477,13 @@ func TestGatedFeatures(t *testing.T) {
 		"GarbageCollectionOfPromRuleBindingForThanosRuler":     testGarbageCollectionOfPromRuleBindingForThanosRuler,
 		"RmPromeRuleBindingDuringWorkloadDeleteForThanosRuler": testRmPromeRuleBindingDuringWorkloadDeleteForThanosRuler,
 		"PrometheusTopologySharding":                           testPrometheusTopologySharding,
+		"FinalizerForAlertmanagerWhenStatusForConfigRes":       testFinalizerForAlertmanagerWhenStatusForConfigResEnabled,
+		"AlertmanagerConfigStatusSubresource":                  testAlertmanagerConfigStatusSubresource,
+		"GarbageCollectionOfAlertmanagerConfigBinding":         testGarbageCollectionOfAlertmanagerConfigBinding,
+		"RmAlertmanagerConfigBindingDuringWorkloadDelete":      testRmAlertmanagerConfigBindingDuringWorkloadDelete,
+		"ServiceMonitorStatusSubresourceForPromAgent":          testServiceMonitorStatusSubresourceForPromAgent,
+		"RmServiceMonitorBindingDuringPromAgentDelete":         testRmServiceMonitorBindingDuringPromAgentDelete,
+		"PodMonitorStatusSubresourceForPromAgentDaemonSet":     testPodMonitorStatusSubresourceForPromAgentDaemonSet,
 	}
 
 	for name, f := range testFuncs {
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringv1alpha1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1alpha1"
 	"github.com/prometheus-operator/prometheus-operator/pkg/operator"
 	testFramework "github.com/prometheus-operator/prometheus-operator/test/framework"
 )
@@ -1304,3 +1305,368 @@ func testRmPromeRuleBindingDuringWorkloadDeleteForThanosRuler(t *testing.T) {
 	_, err = framework.WaitForRuleWorkloadBindingCleanup(ctx, pr, tr, monitoringv1.ThanosRulerName, 1*time.Minute)
 	require.NoError(t, err)
 }
+
+// testFinalizerForAlertmanagerWhenStatusForConfigResEnabled tests the adding/removing of status-cleanup finalizer for Alertmanager when StatusForConfigurationResourcesFeature is enabled.
+func testFinalizerForAlertmanagerWhenStatusForConfigResEnabled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:           ns,
+			AllowedNamespaces:   []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{operator.StatusForConfigurationResourcesFeature},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "am-status-finalizer"
+
+	am := framework.MakeBasicAlertmanager(ns, name, 1)
+	_, err = framework.CreateAlertmanagerAndWaitUntilReady(ctx, am)
+	require.NoError(t, err)
+
+	am, err = framework.MonClientV1.Alertmanagers(ns).Get(ctx, name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	finalizers := am.GetFinalizers()
+	require.NotEmpty(t, finalizers)
+
+	err = framework.DeleteAlertmanagerAndWaitUntilGone(ctx, ns, name)
+	require.NoError(t, err)
+}
+
+// testAlertmanagerConfigStatusSubresource validates AlertmanagerConfig status updates upon Alertmanager selection.
+func testAlertmanagerConfigStatusSubresource(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:           ns,
+			AllowedNamespaces:   []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{operator.StatusForConfigurationResourcesFeature},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "amcfg-status-subres-test"
+
+	am := framework.MakeBasicAlertmanager(ns, name, 1)
+	am.Spec.AlertmanagerConfigSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"group": name,
+		},
+	}
+
+	am, err = framework.CreateAlertmanagerAndWaitUntilReady(ctx, am)
+	require.NoError(t, err)
+
+	// Create a first AlertmanagerConfig to check that the operator only updates the binding when needed.
+	amc1, err := framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Create(ctx, makeBasicAlertmanagerConfig(ns, "amcfg1", name), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	// Record the lastTransitionTime value.
+	amc1, err = framework.WaitForAlertmanagerConfigCondition(ctx, amc1, am, monitoringv1.AlertmanagerName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 3*time.Minute)
+	require.NoError(t, err)
+	binding, err := framework.GetWorkloadBinding(amc1.Status.Bindings, am, monitoringv1.AlertmanagerName)
+	require.NoError(t, err)
+	cond, err := framework.GetConfigResourceCondition(binding.Conditions, monitoringv1.Accepted)
+	require.NoError(t, err)
+	ts := cond.LastTransitionTime.String()
+	require.NotEqual(t, "", ts)
+
+	// Create a second AlertmanagerConfig to check that the operator updates the binding when the condition changes.
+	amc2, err := framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Create(ctx, makeBasicAlertmanagerConfig(ns, "amcfg2", name), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	amc2, err = framework.WaitForAlertmanagerConfigCondition(ctx, amc2, am, monitoringv1.AlertmanagerName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 1*time.Minute)
+	require.NoError(t, err)
+
+	// Update the labels of the first AlertmanagerConfig. A label update
+	// doesn't change the status of the AlertmanagerConfig and the observed
+	// timestamp should be the same as before.
+	amc1.Labels["test"] = "test"
+	amc1, err = framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Update(ctx, amc1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// Update the second AlertmanagerConfig to reference a missing secret.
+	amc2.Spec.Receivers[0].WebhookConfigs = []monitoringv1alpha1.WebhookConfig{
+		{
+			URLSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: "non-existing-secret",
+				},
+				Key: "url",
+			},
+		},
+	}
+	amc2, err = framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Update(ctx, amc2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// The second AlertmanagerConfig should change to Accepted=False.
+	amc2, err = framework.WaitForAlertmanagerConfigCondition(ctx, amc2, am, monitoringv1.AlertmanagerName, monitoringv1.Accepted, monitoringv1.ConditionFalse, 1*time.Minute)
+	require.NoError(t, err)
+	binding, err = framework.GetWorkloadBinding(amc2.Status.Bindings, am, monitoringv1.AlertmanagerName)
+	require.NoError(t, err)
+	cond, err = framework.GetConfigResourceCondition(binding.Conditions, monitoringv1.Accepted)
+	require.NoError(t, err)
+	require.Equal(t, operator.InvalidConfiguration, cond.Reason)
+	require.NotEmpty(t, cond.Message)
+
+	// The first AlertmanagerConfig should remain unchanged.
+	amc1, err = framework.WaitForAlertmanagerConfigCondition(ctx, amc1, am, monitoringv1.AlertmanagerName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 1*time.Minute)
+	require.NoError(t, err)
+	binding, err = framework.GetWorkloadBinding(amc1.Status.Bindings, am, monitoringv1.AlertmanagerName)
+	require.NoError(t, err)
+	cond, err = framework.GetConfigResourceCondition(binding.Conditions, monitoringv1.Accepted)
+	require.NoError(t, err)
+	require.Equal(t, ts, cond.LastTransitionTime.String())
+}
+
+// testGarbageCollectionOfAlertmanagerConfigBinding validates that the operator removes the reference to the Alertmanager resource when the AlertmanagerConfig isn't selected anymore by the workload.
+func testGarbageCollectionOfAlertmanagerConfigBinding(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:           ns,
+			AllowedNamespaces:   []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{operator.StatusForConfigurationResourcesFeature},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "amcfg-status-binding-cleanup"
+
+	am := framework.MakeBasicAlertmanager(ns, name, 1)
+	am.Spec.AlertmanagerConfigSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"group": name,
+		},
+	}
+
+	am, err = framework.CreateAlertmanagerAndWaitUntilReady(ctx, am)
+	require.NoError(t, err)
+
+	amc, err := framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Create(ctx, makeBasicAlertmanagerConfig(ns, "amcfg", name), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	amc, err = framework.WaitForAlertmanagerConfigCondition(ctx, amc, am, monitoringv1.AlertmanagerName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 3*time.Minute)
+	require.NoError(t, err)
+
+	amc.Labels = map[string]string{}
+	amc, err = framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Update(ctx, amc, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, err = framework.WaitForAlertmanagerConfigWorkloadBindingCleanup(ctx, amc, am, monitoringv1.AlertmanagerName, 1*time.Minute)
+	require.NoError(t, err)
+}
+
+// testRmAlertmanagerConfigBindingDuringWorkloadDelete validates that the operator removes the reference to the Alertmanager resource when workload is deleted.
+func testRmAlertmanagerConfigBindingDuringWorkloadDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:           ns,
+			AllowedNamespaces:   []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{operator.StatusForConfigurationResourcesFeature},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "amcfg-status-binding-rm"
+
+	am := framework.MakeBasicAlertmanager(ns, name, 1)
+	am.Spec.AlertmanagerConfigSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			"group": name,
+		},
+	}
+
+	am, err = framework.CreateAlertmanagerAndWaitUntilReady(ctx, am)
+	require.NoError(t, err)
+
+	amc, err := framework.MonClientV1alpha1.AlertmanagerConfigs(ns).Create(ctx, makeBasicAlertmanagerConfig(ns, "amcfg", name), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	amc, err = framework.WaitForAlertmanagerConfigCondition(ctx, amc, am, monitoringv1.AlertmanagerName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 3*time.Minute)
+	require.NoError(t, err)
+
+	err = framework.DeleteAlertmanagerAndWaitUntilGone(ctx, ns, name)
+	require.NoError(t, err)
+
+	_, err = framework.WaitForAlertmanagerConfigWorkloadBindingCleanup(ctx, amc, am, monitoringv1.AlertmanagerName, 1*time.Minute)
+	require.NoError(t, err)
+}
+
+func makeBasicAlertmanagerConfig(ns, name, group string) *monitoringv1alpha1.AlertmanagerConfig {
+	return &monitoringv1alpha1.AlertmanagerConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      name,
+			Labels: map[string]string{
+				"group": group,
+			},
+		},
+		Spec: monitoringv1alpha1.AlertmanagerConfigSpec{
+			Route: &monitoringv1alpha1.Route{
+				Receiver: "null",
+			},
+			Receivers: []monitoringv1alpha1.Receiver{
+				{
+					Name: "null",
+				},
+			},
+		},
+	}
+}
+
+// testServiceMonitorStatusSubresourceForPromAgent validates ServiceMonitor status updates upon PrometheusAgent selection.
+func testServiceMonitorStatusSubresourceForPromAgent(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	framework.SetupPrometheusRBAC(ctx, t, testCtx, ns)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:           ns,
+			AllowedNamespaces:   []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{operator.StatusForConfigurationResourcesFeature},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "smon-status-subres-agent-test"
+	p := framework.MakeBasicPrometheusAgent(ns, name, name, 1)
+
+	p, err = framework.CreatePrometheusAgentAndWaitUntilReady(ctx, ns, p)
+	require.NoError(t, err)
+
+	sm := framework.MakeBasicServiceMonitor(name)
+	sm, err = framework.MonClientV1.ServiceMonitors(ns).Create(ctx, sm, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	sm, err = framework.WaitForServiceMonitorCondition(ctx, sm, p, monitoringv1alpha1.PrometheusAgentName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 1*time.Minute)
+	require.NoError(t, err)
+
+	// Update the ServiceMonitor to reference a non-existing Secret.
+	sm.Spec.Endpoints[0].BasicAuth = &monitoringv1.BasicAuth{
+		Username: corev1.SecretKeySelector{
+			Key: "username",
+			LocalObjectReference: corev1.LocalObjectReference{
+				Name: name,
+			},
+		},
+	}
+	sm, err = framework.MonClientV1.ServiceMonitors(ns).Update(ctx, sm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	sm, err = framework.WaitForServiceMonitorCondition(ctx, sm, p, monitoringv1alpha1.PrometheusAgentName, monitoringv1.Accepted, monitoringv1.ConditionFalse, 1*time.Minute)
+	require.NoError(t, err)
+
+	// Update the ServiceMonitor's labels, PrometheusAgent doesn't select the resource anymore.
+	sm.Labels = map[string]string{}
+	sm, err = framework.MonClientV1.ServiceMonitors(ns).Update(ctx, sm, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	_, err = framework.WaitForServiceMonitorWorkloadBindingCleanup(ctx, sm, p, monitoringv1alpha1.PrometheusAgentName, 1*time.Minute)
+	require.NoError(t, err)
+}
+
+// testRmServiceMonitorBindingDuringPromAgentDelete validates that the operator removes the reference to the PrometheusAgent resource when workload is deleted.
+func testRmServiceMonitorBindingDuringPromAgentDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	framework.SetupPrometheusRBAC(ctx, t, testCtx, ns)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:           ns,
+			AllowedNamespaces:   []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{operator.StatusForConfigurationResourcesFeature},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "smon-status-binding-rm-agent-test"
+	p := framework.MakeBasicPrometheusAgent(ns, name, name, 1)
+
+	p, err = framework.CreatePrometheusAgentAndWaitUntilReady(ctx, ns, p)
+	require.NoError(t, err)
+
+	sm := framework.MakeBasicServiceMonitor(name)
+	sm, err = framework.MonClientV1.ServiceMonitors(ns).Create(ctx, sm, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	sm, err = framework.WaitForServiceMonitorCondition(ctx, sm, p, monitoringv1alpha1.PrometheusAgentName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 1*time.Minute)
+	require.NoError(t, err)
+
+	err = framework.DeletePrometheusAgentAndWaitUntilGone(ctx, ns, name)
+	require.NoError(t, err)
+
+	_, err = framework.WaitForServiceMonitorWorkloadBindingCleanup(ctx, sm, p, monitoringv1alpha1.PrometheusAgentName, 1*time.Minute)
+	require.NoError(t, err)
+}
+
+// testPodMonitorStatusSubresourceForPromAgentDaemonSet validates PodMonitor status updates upon PrometheusAgent selection in DaemonSet mode.
+func testPodMonitorStatusSubresourceForPromAgentDaemonSet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	testCtx := framework.NewTestCtx(t)
+	defer testCtx.Cleanup(t)
+
+	ns := framework.CreateNamespace(ctx, t, testCtx)
+	framework.SetupPrometheusRBAC(ctx, t, testCtx, ns)
+	_, err := framework.CreateOrUpdatePrometheusOperatorWithOpts(
+		ctx, testFramework.PrometheusOperatorOpts{
+			Namespace:         ns,
+			AllowedNamespaces: []string{ns},
+			EnabledFeatureGates: []operator.FeatureGateName{
+				operator.PrometheusAgentDaemonSetFeature,
+				operator.StatusForConfigurationResourcesFeature,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	name := "pmon-status-subres-agent-ds-test"
+	p := framework.MakeBasicPrometheusAgentDaemonSet(ns, name)
+
+	p, err = framework.CreatePrometheusAgentAndWaitUntilReady(ctx, ns, p)
+	require.NoError(t, err)
+
+	pm := framework.MakeBasicPodMonitor(name)
+	pm, err = framework.MonClientV1.PodMonitors(ns).Create(ctx, pm, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	pm, err = framework.WaitForPodMonitorCondition(ctx, pm, p, monitoringv1alpha1.PrometheusAgentName, monitoringv1.Accepted, monitoringv1.ConditionTrue, 1*time.Minute)
+	require.NoError(t, err)
+
+	err = framework.DeletePrometheusAgentDSAndWaitUntilGone(ctx, p, ns, name)
+	require.NoError(t, err)
+
+	_, err = framework.WaitForPodMonitorWorkloadBindingCleanup(ctx, pm, p, monitoringv1alpha1.PrometheusAgentName, 1*time.Minute)
+	require.NoError(t, err)
+}
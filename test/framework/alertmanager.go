@@ -131,6 +131,52 @@ func (f *Framework) CreateAlertmanagerConfig(ctx context.Context, ns, name strin
 	return f.MonClientV1alpha1.AlertmanagerConfigs(ns).Create(ctx, amConfig, metav1.CreateOptions{})
 }
 
+func (f *Framework) WaitForAlertmanagerConfigCondition(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, workload metav1.Object, resource string, conditionType monitoringv1.ConditionType, conditionStatus monitoringv1.ConditionStatus, timeout time.Duration) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	var current *monitoringv1alpha1.AlertmanagerConfig
+
+	if err := f.WaitForConfigResourceCondition(
+		ctx,
+		func(ctx context.Context) ([]monitoringv1.WorkloadBinding, error) {
+			var err error
+			current, err = f.MonClientV1alpha1.AlertmanagerConfigs(amc.Namespace).Get(ctx, amc.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return current.Status.Bindings, nil
+		},
+		workload,
+		resource,
+		conditionType,
+		conditionStatus,
+		timeout,
+	); err != nil {
+		return nil, fmt.Errorf("alertmanagerConfig status %v/%v failed to reach expected condition: %w", amc.Namespace, amc.Name, err)
+	}
+	return current, nil
+}
+
+func (f *Framework) WaitForAlertmanagerConfigWorkloadBindingCleanup(ctx context.Context, amc *monitoringv1alpha1.AlertmanagerConfig, workload metav1.Object, resource string, timeout time.Duration) (*monitoringv1alpha1.AlertmanagerConfig, error) {
+	var current *monitoringv1alpha1.AlertmanagerConfig
+
+	if err := f.WaitForConfigResWorkloadBindingCleanup(
+		ctx,
+		func(ctx context.Context) ([]monitoringv1.WorkloadBinding, error) {
+			var err error
+			current, err = f.MonClientV1alpha1.AlertmanagerConfigs(amc.Namespace).Get(ctx, amc.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return current.Status.Bindings, nil
+		},
+		workload,
+		resource,
+		timeout,
+	); err != nil {
+		return nil, fmt.Errorf("alertmanagerConfig status %v/%v failed to reach expected condition: %w", amc.Namespace, amc.Name, err)
+	}
+	return current, nil
+}
+
 func (f *Framework) MakeAlertmanagerService(name, group string, serviceType corev1.ServiceType) *corev1.Service {
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{